@@ -16,6 +16,7 @@ import (
 	"github.com/baiweiguo/gohbase/region"
 	"github.com/baiweiguo/gohbase/zk"
 	log "github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/proto"
 )
 
 const (
@@ -41,6 +42,36 @@ type AdminClient interface {
 	// MoveRegion moves a region to a different RegionServer
 	MoveRegion(mr *hrpc.MoveRegion) error
 	CreateNamespace(t *hrpc.CreateNamespace) error
+	// GetTableState returns the state of the table with the given fully
+	// qualified name, e.g. to check that it's enabled before using it, or an
+	// error matching TableNotFound if it doesn't exist
+	GetTableState(table string) (pb.Table_State, error)
+	// ServerVersion returns the version of HBase the cluster runs, e.g. to pass
+	// it to the ServerVersion option of clients
+	ServerVersion() (hrpc.Version, error)
+	// CompactTable asks the RegionServers of the table with the given name,
+	// qualified with its namespace unless it's the default one, to compact
+	// all of its regions, with major compactions if major is true.
+	// The regions are compacted asynchronously.
+	CompactTable(ctx context.Context, table string, major bool) error
+	// CompactRegion asks the RegionServer of the region with the given full
+	// name to compact it, see CompactTable
+	CompactRegion(ctx context.Context, regionName []byte, major bool) error
+	// MergeRegions asks the master to merge the regions with the given full
+	// names, which must be adjacent regions of the same table unless forcible
+	// is true, and waits for the merge to complete.
+	MergeRegions(ctx context.Context, regionA, regionB []byte, forcible bool) error
+	// RegionServerCoprocessorServiceAll invokes method of the coprocessor
+	// service loaded in every live RegionServer with request, the serialized
+	// protobuf message the method takes, and returns the results keyed by
+	// the addresses of the RegionServers.
+	RegionServerCoprocessorServiceAll(ctx context.Context, service, method string,
+		request []byte) (map[string]hrpc.CoprocessorResult, error)
+	// RegionServerMetrics invokes the hrpc.MetricsMethod of the
+	// hrpc.MetricsService coprocessor endpoint of every live RegionServer,
+	// decodes their responses with decode and aggregates the metrics.
+	RegionServerMetrics(ctx context.Context,
+		decode hrpc.MetricsDecoder) (*hrpc.CoprocessorMetrics, error)
 }
 
 // NewAdminClient creates an admin HBase client.
@@ -64,11 +95,16 @@ func newAdminClient(zkquorum string, options ...Option) AdminClient {
 		regionLookupTimeout: region.DefaultLookupTimeout,
 		regionReadTimeout:   region.DefaultReadTimeout,
 		newRegionClientFn:   region.NewClient,
+		done:                make(chan struct{}),
+		ctx:                 context.Background(),
 	}
 	for _, option := range options {
 		option(c)
 	}
-	c.zkClient = zk.NewClient(zkquorum, c.zkTimeout)
+	if c.zkClient == nil {
+		c.zkClient = zk.NewClient(zkquorum, c.zkTimeout)
+	}
+	c.initContext()
 	return c
 }
 
@@ -87,6 +123,15 @@ func (c *client) ClusterStatus() (*pb.ClusterStatus, error) {
 	return r.GetClusterStatus(), nil
 }
 
+// ServerVersion returns the version of HBase reported in the cluster status
+func (c *client) ServerVersion() (hrpc.Version, error) {
+	status, err := c.ClusterStatus()
+	if err != nil {
+		return hrpc.Version{}, err
+	}
+	return hrpc.ParseVersion(status.GetHbaseVersion().GetVersion())
+}
+
 func (c *client) CreateTable(t *hrpc.CreateTable) error {
 	pbmsg, err := c.SendRPC(t)
 	if err != nil {
@@ -157,6 +202,59 @@ func (c *client) DisableTable(t *hrpc.DisableTable) error {
 	return c.checkProcedureWithBackoff(t.Context(), r.GetProcId())
 }
 
+// GetTableState returns the state of the table as recorded by the master
+// in the table:state column of hbase:meta, which HBase 2 and later keep the
+// states of tables in: pb.Table_ENABLED, pb.Table_DISABLED, pb.Table_ENABLING
+// or pb.Table_DISABLING. An error matching TableNotFound is returned if the
+// table has no state, i.e. it doesn't exist.
+func (c *client) GetTableState(table string) (pb.Table_State, error) {
+	ctx, cancel := context.WithTimeout(c.ctx, c.regionLookupTimeout)
+	defer cancel()
+	addr, err := c.zkLookup(ctx, zk.Meta)
+	if err != nil {
+		return 0, fmt.Errorf("failed to locate hbase:meta: %w", err)
+	}
+	rc, err := c.dialRegionServer(ctx, addr, region.RegionClient)
+	if err != nil {
+		return 0, err
+	}
+	defer rc.Close()
+
+	get, err := hrpc.NewGet(ctx, metaTableName, []byte(table),
+		hrpc.Families(map[string][]string{"table": {"state"}}))
+	if err != nil {
+		return 0, err
+	}
+	get.SetRegion(region.NewInfo(0, []byte("hbase"), []byte("meta"),
+		[]byte("hbase:meta,,1"), nil, nil))
+	res, err := sendBlocking(ctx, rc, get)
+	if err == nil {
+		err = res.Error
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to read the state of table %q: %w", table, err)
+	}
+	r, ok := res.Msg.(*pb.GetResponse)
+	if !ok {
+		return 0, fmt.Errorf("sendRPC returned not a GetResponse")
+	}
+	cells := r.GetResult().GetCell()
+	if len(cells) == 0 {
+		return 0, fmt.Errorf("%w: no state of table %q in hbase:meta", TableNotFound, table)
+	}
+	return decodeTableState(cells[0].GetValue())
+}
+
+// decodeTableState decodes the value of the table:state column of hbase:meta,
+// a TableState protobuf, which is encoded the same way as pb.Table.
+func decodeTableState(value []byte) (pb.Table_State, error) {
+	state := &pb.Table{}
+	if err := proto.Unmarshal(value, state); err != nil {
+		return 0, fmt.Errorf("failed to deserialize the state of table: %w", err)
+	}
+	return state.GetState(), nil
+}
+
 func (c *client) checkProcedureWithBackoff(ctx context.Context, procID uint64) error {
 	backoff := backoffStart
 	for {
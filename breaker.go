@@ -0,0 +1,136 @@
+// Copyright (C) 2026  The GoHBase Authors.  All rights reserved.
+// This file is part of GoHBase.
+// Use of this source code is governed by the Apache License 2.0
+// that can be found in the COPYING file.
+
+package gohbase
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/baiweiguo/gohbase/hrpc"
+)
+
+// ErrCircuitOpen is returned for RPCs to regions of a RegionServer whose
+// circuit breaker is open, see CircuitBreaker option.
+var ErrCircuitOpen = errors.New("circuit breaker of the RegionServer is open")
+
+// circuitBreakers tracks consecutive failures of RegionServers and opens their
+// circuit breakers once they reach threshold. A nil circuitBreakers is disabled.
+type circuitBreakers struct {
+	threshold int
+	cooldown  time.Duration
+
+	m sync.RWMutex
+	// servers are the circuits of the RegionServers that failed, by address
+	servers map[string]*circuit
+	// regions are the regions not established because the circuit of their
+	// RegionServer was open, along with its address
+	regions map[hrpc.RegionInfo]string
+}
+
+type circuit struct {
+	failures int
+	// openedAt is when the circuit was opened, zero if it's closed
+	openedAt time.Time
+}
+
+func newCircuitBreakers(threshold int, cooldown time.Duration) *circuitBreakers {
+	return &circuitBreakers{
+		threshold: threshold,
+		cooldown:  cooldown,
+		servers:   make(map[string]*circuit),
+		regions:   make(map[hrpc.RegionInfo]string),
+	}
+}
+
+// failure records a failure of the RegionServer at addr. The circuit is opened
+// once failures reach the threshold, including when a trial fails afterwards.
+func (cb *circuitBreakers) failure(addr string) {
+	if cb == nil {
+		return
+	}
+	cb.m.Lock()
+	defer cb.m.Unlock()
+	c, ok := cb.servers[addr]
+	if !ok {
+		c = &circuit{}
+		cb.servers[addr] = c
+	}
+	c.failures++
+	if c.failures >= cb.threshold {
+		c.openedAt = time.Now()
+	}
+}
+
+// success closes the circuit of the RegionServer at addr.
+func (cb *circuitBreakers) success(addr string) {
+	if cb == nil {
+		return
+	}
+	cb.m.RLock()
+	_, ok := cb.servers[addr]
+	cb.m.RUnlock()
+	if !ok {
+		return
+	}
+	cb.m.Lock()
+	defer cb.m.Unlock()
+	delete(cb.servers, addr)
+	for reg, a := range cb.regions {
+		if a == addr {
+			delete(cb.regions, reg)
+		}
+	}
+}
+
+// isOpen returns true if the circuit of the RegionServer at addr is open and
+// its cooldown isn't over. Once it is, the circuit is half-open, allowing to
+// try the RegionServer again.
+func (cb *circuitBreakers) isOpen(addr string) bool {
+	if cb == nil {
+		return false
+	}
+	cb.m.RLock()
+	defer cb.m.RUnlock()
+	return cb.isOpenLocked(addr)
+}
+
+func (cb *circuitBreakers) isOpenLocked(addr string) bool {
+	c, ok := cb.servers[addr]
+	return ok && !c.openedAt.IsZero() && time.Since(c.openedAt) < cb.cooldown
+}
+
+// skip records that reg wasn't established because the circuit
+// of its RegionServer at addr is open.
+func (cb *circuitBreakers) skip(reg hrpc.RegionInfo, addr string) {
+	cb.m.Lock()
+	cb.regions[reg] = addr
+	cb.m.Unlock()
+}
+
+// established forgets that reg was skipped, if it was.
+func (cb *circuitBreakers) established(reg hrpc.RegionInfo) {
+	if cb == nil {
+		return
+	}
+	cb.m.Lock()
+	delete(cb.regions, reg)
+	cb.m.Unlock()
+}
+
+// check returns ErrCircuitOpen if reg was skipped
+// and the circuit of its RegionServer is still open.
+func (cb *circuitBreakers) check(reg hrpc.RegionInfo) error {
+	if cb == nil {
+		return nil
+	}
+	cb.m.RLock()
+	defer cb.m.RUnlock()
+	if addr, ok := cb.regions[reg]; ok && cb.isOpenLocked(addr) {
+		return ErrCircuitOpen
+	}
+	return nil
+}
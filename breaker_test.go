@@ -0,0 +1,138 @@
+// Copyright (C) 2026  The GoHBase Authors.  All rights reserved.
+// This file is part of GoHBase.
+// Use of this source code is governed by the Apache License 2.0
+// that can be found in the COPYING file.
+
+package gohbase
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/baiweiguo/gohbase/hrpc"
+	"github.com/baiweiguo/gohbase/pb"
+	"github.com/baiweiguo/gohbase/region"
+)
+
+// singleRegionResolver serves every key of every table from a single region
+// of a single RegionServer.
+type singleRegionResolver struct{}
+
+func (singleRegionResolver) ResolveRegion(ctx context.Context,
+	table, key []byte) (hrpc.RegionInfo, string, error) {
+	name := []byte(string(table) + ",,1434573235908.56f833d5569a27c7a43fbf547b4924a4.")
+	return region.NewInfo(0, nil, table, name, nil, nil), "regionserver:1", nil
+}
+
+// flakyServer is a RegionServer that can't be connected to while it's down.
+type flakyServer struct {
+	m     sync.Mutex
+	down  bool
+	dials int
+}
+
+func (s *flakyServer) setDown(down bool) {
+	s.m.Lock()
+	s.down = down
+	s.m.Unlock()
+}
+
+func (s *flakyServer) dialCount() int {
+	s.m.Lock()
+	defer s.m.Unlock()
+	return s.dials
+}
+
+type flakyRegionClient struct {
+	addr string
+	srv  *flakyServer
+}
+
+func (c *flakyRegionClient) Dial(context.Context) error {
+	c.srv.m.Lock()
+	defer c.srv.m.Unlock()
+	c.srv.dials++
+	if c.srv.down {
+		return errors.New("connection refused")
+	}
+	return nil
+}
+
+func (c *flakyRegionClient) Close()         {}
+func (c *flakyRegionClient) Addr() string   { return c.addr }
+func (c *flakyRegionClient) String() string { return c.addr }
+
+func (c *flakyRegionClient) QueueRPC(call hrpc.Call) {
+	call.ResultChan() <- hrpc.RPCResult{Msg: &pb.GetResponse{Result: &pb.Result{}}}
+}
+
+func (c *flakyRegionClient) QueueBatch(ctx context.Context, calls []hrpc.Call) {
+	for _, call := range calls {
+		c.QueueRPC(call)
+	}
+}
+
+func TestCircuitBreaker(t *testing.T) {
+	const cooldown = 200 * time.Millisecond
+	srv := &flakyServer{down: true}
+	c := NewClientWithResolver(singleRegionResolver{},
+		func(addr string) hrpc.RegionClient { return &flakyRegionClient{addr: addr, srv: srv} },
+		CircuitBreaker(3, cooldown))
+	defer c.Close()
+
+	get := func() error {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		g, err := hrpc.NewGetStr(ctx, "test", "row")
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = c.Get(g)
+		return err
+	}
+
+	// the breaker trips after 3 failures to connect
+	if err := get(); err != ErrCircuitOpen {
+		t.Fatalf("expected %v, got %v", ErrCircuitOpen, err)
+	}
+	if n := srv.dialCount(); n != 3 {
+		t.Errorf("expected 3 dials, got %d", n)
+	}
+
+	// while it's open, rpcs fail without connecting
+	start := time.Now()
+	if err := get(); err != ErrCircuitOpen {
+		t.Fatalf("expected %v, got %v", ErrCircuitOpen, err)
+	}
+	if d := time.Since(start); d >= cooldown {
+		t.Errorf("expected the rpc to fail right away, took %v", d)
+	}
+	if n := srv.dialCount(); n != 3 {
+		t.Errorf("expected no more dials, got %d", n)
+	}
+
+	// once half-open, a single trial is made and reopens it if it fails
+	time.Sleep(cooldown)
+	if err := get(); err != ErrCircuitOpen {
+		t.Fatalf("expected %v, got %v", ErrCircuitOpen, err)
+	}
+	if n := srv.dialCount(); n != 4 {
+		t.Errorf("expected a single trial dial, got %d dials", n-3)
+	}
+
+	// and closes it if it succeeds
+	srv.setDown(false)
+	time.Sleep(cooldown)
+	if err := get(); err != nil {
+		t.Fatalf("expected the rpc to succeed, got %v", err)
+	}
+	if err := get(); err != nil {
+		t.Fatalf("expected the rpc to succeed, got %v", err)
+	}
+	if n := srv.dialCount(); n != 5 {
+		t.Errorf("expected 5 dials, got %d", n)
+	}
+}
@@ -0,0 +1,114 @@
+// Copyright (C) 2026  The GoHBase Authors.  All rights reserved.
+// This file is part of GoHBase.
+// Use of this source code is governed by the Apache License 2.0
+// that can be found in the COPYING file.
+
+package gohbase
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/baiweiguo/gohbase/hrpc"
+)
+
+// FlushError is returned by Flush when some of the buffered mutations
+// couldn't be flushed. They are kept buffered and sent again by the next Flush.
+type FlushError struct {
+	// Mutations are the mutations that couldn't be flushed.
+	Mutations []*hrpc.Mutate
+	// Errors are the errors of Mutations, in the same order.
+	Errors []error
+	// Err is the error of the context of Flush if it's done,
+	// or the first of Errors otherwise.
+	Err error
+}
+
+func (e *FlushError) Error() string {
+	return fmt.Sprintf("failed to flush %d mutations: %s", len(e.Mutations), e.Err)
+}
+
+func (e *FlushError) Unwrap() error {
+	return e.Err
+}
+
+// BufferedMutator buffers mutations and sends them to HBase in batches
+// when Flush is called, e.g. in ingestion pipelines. It's safe for
+// concurrent use. Mutations must be batchable.
+type BufferedMutator struct {
+	c Client
+
+	m   sync.Mutex
+	buf []*hrpc.Mutate
+}
+
+// NewBufferedMutator returns a BufferedMutator sending mutations with c.
+func NewBufferedMutator(c Client) *BufferedMutator {
+	return &BufferedMutator{c: c}
+}
+
+// Mutate adds m to the buffered mutations.
+func (bm *BufferedMutator) Mutate(m *hrpc.Mutate) {
+	bm.m.Lock()
+	bm.buf = append(bm.buf, m)
+	bm.m.Unlock()
+}
+
+// Len returns the number of buffered mutations.
+func (bm *BufferedMutator) Len() int {
+	bm.m.Lock()
+	defer bm.m.Unlock()
+	return len(bm.buf)
+}
+
+// Flush sends the buffered mutations and waits for them until ctx is done.
+// The mutations that couldn't be flushed, e.g. because their regions are
+// unavailable, are reported with a FlushError and kept buffered for the next
+// Flush, so that none of them is lost. Mutations buffered while flushing are
+// sent by the next Flush.
+func (bm *BufferedMutator) Flush(ctx context.Context) error {
+	bm.m.Lock()
+	muts := bm.buf
+	bm.buf = nil
+	bm.m.Unlock()
+	if len(muts) == 0 {
+		return nil
+	}
+
+	batch := make([]hrpc.Call, len(muts))
+	for i, m := range muts {
+		// drop the result of a previous attempt delivered
+		// after the previous Flush gave up on it
+		select {
+		case <-m.ResultChan():
+		default:
+		}
+		batch[i] = m
+	}
+
+	res, ok := bm.c.SendBatch(ctx, batch)
+	if ok {
+		return nil
+	}
+	failed := &FlushError{}
+	for i, r := range res {
+		if r.Error == nil {
+			continue
+		}
+		failed.Mutations = append(failed.Mutations, muts[i])
+		failed.Errors = append(failed.Errors, r.Error)
+	}
+	if len(failed.Mutations) == 0 {
+		return nil
+	}
+	if failed.Err = ctx.Err(); failed.Err == nil {
+		failed.Err = failed.Errors[0]
+	}
+
+	bm.m.Lock()
+	bm.buf = append(failed.Mutations[:len(failed.Mutations):len(failed.Mutations)],
+		bm.buf...)
+	bm.m.Unlock()
+	return failed
+}
@@ -0,0 +1,111 @@
+// Copyright (C) 2026  The GoHBase Authors.  All rights reserved.
+// This file is part of GoHBase.
+// Use of this source code is governed by the Apache License 2.0
+// that can be found in the COPYING file.
+
+package gohbase
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/baiweiguo/gohbase/hrpc"
+	"github.com/baiweiguo/gohbase/pb"
+	"github.com/baiweiguo/gohbase/region"
+	"github.com/baiweiguo/gohbase/test"
+	mockRegion "github.com/baiweiguo/gohbase/test/mock/region"
+	"github.com/golang/mock/gomock"
+)
+
+func TestBufferedMutatorFlushTimeout(t *testing.T) {
+	ctrl := test.NewController(t)
+	defer ctrl.Finish()
+	c := newMockClient(nil)
+
+	var (
+		sentM sync.Mutex
+		sent  []string
+	)
+	newRegionClient := func(addr string) hrpc.RegionClient {
+		rc := mockRegion.NewMockRegionClient(ctrl)
+		rc.EXPECT().Addr().Return(addr).AnyTimes()
+		rc.EXPECT().String().Return(addr).AnyTimes()
+		rc.EXPECT().QueueBatch(gomock.Any(), gomock.Any()).AnyTimes().Do(
+			func(ctx context.Context, rpcs []hrpc.Call) {
+				for _, rpc := range rpcs {
+					sentM.Lock()
+					sent = append(sent, string(rpc.Key()))
+					sentM.Unlock()
+					rpc.ResultChan() <- hrpc.RPCResult{Msg: &pb.MutateResponse{}}
+				}
+			})
+		return rc
+	}
+
+	up := region.NewInfo(0, nil, []byte("test"),
+		[]byte("test,,1434573235908.56f833d5569a27c7a43fbf547b4924a4."), nil, []byte("m"))
+	rc := newRegionClient("regionserver:1")
+	c.regions.put(up)
+	c.clients.put("regionserver:1", up, func() hrpc.RegionClient { return rc })
+	up.SetClient(rc)
+
+	// the region is being established and won't be in time
+	down := region.NewInfo(0, nil, []byte("test"),
+		[]byte("test,m,1434573235908.56f833d5569a27c7a43fbf547b4924a5."), []byte("m"), nil)
+	c.regions.put(down)
+	down.MarkUnavailable()
+
+	bm := NewBufferedMutator(c)
+	var muts []*hrpc.Mutate
+	for _, key := range []string{"a", "x", "b"} {
+		m, err := hrpc.NewPutStr(context.Background(), "test", key,
+			map[string]map[string][]byte{"cf": {"q": []byte("v")}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		bm.Mutate(m)
+		muts = append(muts, m)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	err := bm.Flush(ctx)
+	var ferr *FlushError
+	if !errors.As(err, &ferr) {
+		t.Fatalf("expected a FlushError, got %v", err)
+	}
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected the error to be a timeout, got %v", err)
+	}
+	// the batch isn't sent unless all of its regions are available
+	if !reflect.DeepEqual(muts, ferr.Mutations) {
+		t.Errorf("expected mutations %v to be reported, got %v", muts, ferr.Mutations)
+	}
+	if len(ferr.Errors) != len(ferr.Mutations) {
+		t.Errorf("expected an error per mutation, got %v", ferr.Errors)
+	}
+	if n := bm.Len(); n != len(muts) {
+		t.Errorf("expected %d mutations to stay buffered, got %d", len(muts), n)
+	}
+
+	// once the region is up, the retained mutations are flushed
+	rc2 := newRegionClient("regionserver:2")
+	c.clients.put("regionserver:2", down, func() hrpc.RegionClient { return rc2 })
+	down.SetClient(rc2)
+	down.MarkAvailable()
+	if err := bm.Flush(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if n := bm.Len(); n != 0 {
+		t.Errorf("expected no buffered mutations, got %d", n)
+	}
+	sentM.Lock()
+	defer sentM.Unlock()
+	if len(sent) != len(muts) {
+		t.Errorf("expected %d mutations to be sent, got %v", len(muts), sent)
+	}
+}
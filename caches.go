@@ -7,9 +7,12 @@ package gohbase
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"io"
+	"math/rand"
 	"sync"
+	"time"
 
 	"github.com/baiweiguo/gohbase/hrpc"
 	log "github.com/sirupsen/logrus"
@@ -22,6 +25,9 @@ type clientRegionCache struct {
 	m sync.RWMutex
 
 	regions map[hrpc.RegionClient]map[hrpc.RegionInfo]struct{}
+
+	// regionsPerClient is the initial capacity of the regions of a new client
+	regionsPerClient int
 }
 
 // put associates a region with client for provided addrss. It returns the client if it's already
@@ -51,13 +57,32 @@ func (rcc *clientRegionCache) put(addr string, r hrpc.RegionInfo,
 
 	// no such client yet
 	c := newClient()
-	rcc.regions[c] = map[hrpc.RegionInfo]struct{}{r: struct{}{}}
+	regions := make(map[hrpc.RegionInfo]struct{}, rcc.regionsPerClient)
+	regions[r] = struct{}{}
+	rcc.regions[c] = regions
 	rcc.m.Unlock()
 
 	log.WithField("client", c).Info("added new region client")
 	return c
 }
 
+// client returns the client of the regionserver at addr in cache, or puts the
+// one instantiated by calling newClient in cache, without any region, if there's none.
+func (rcc *clientRegionCache) client(addr string,
+	newClient func() hrpc.RegionClient) hrpc.RegionClient {
+	rcc.m.Lock()
+	defer rcc.m.Unlock()
+	for existingClient := range rcc.regions {
+		if addr == existingClient.Addr() {
+			return existingClient
+		}
+	}
+	c := newClient()
+	rcc.regions[c] = make(map[hrpc.RegionInfo]struct{}, rcc.regionsPerClient)
+	log.WithField("client", c).Info("added new region client")
+	return c
+}
+
 func (rcc *clientRegionCache) del(r hrpc.RegionInfo) {
 	rcc.m.Lock()
 	c := r.Client()
@@ -93,6 +118,44 @@ func (rcc *clientRegionCache) clientDown(c hrpc.RegionClient) map[hrpc.RegionInf
 	return downregions
 }
 
+// remove removes c from cache and unsets it as the client of its regions,
+// so that the next rpcs to them establish them again.
+func (rcc *clientRegionCache) remove(c hrpc.RegionClient) {
+	rcc.m.Lock()
+	for r := range rcc.regions[c] {
+		if r.Client() == c {
+			r.SetClient(nil)
+		}
+	}
+	delete(rcc.regions, c)
+	rcc.m.Unlock()
+}
+
+// regionsOf returns the regions of c.
+func (rcc *clientRegionCache) regionsOf(c hrpc.RegionClient) []hrpc.RegionInfo {
+	rcc.m.RLock()
+	defer rcc.m.RUnlock()
+	regions := make([]hrpc.RegionInfo, 0, len(rcc.regions[c]))
+	for r := range rcc.regions[c] {
+		regions = append(regions, r)
+	}
+	return regions
+}
+
+// activity returns the statistics of the cached region clients that keep
+// them, which change whenever they're used.
+func (rcc *clientRegionCache) activity() map[hrpc.RegionClient]hrpc.ConnStats {
+	activity := make(map[hrpc.RegionClient]hrpc.ConnStats)
+	rcc.m.RLock()
+	for client := range rcc.regions {
+		if cs, ok := client.(interface{ ConnStats() hrpc.ConnStats }); ok {
+			activity[client] = cs.ConnStats()
+		}
+	}
+	rcc.m.RUnlock()
+	return activity
+}
+
 // Collects information about the clientRegion cache and appends them to the two maps to reduce
 // duplication of data. We do this in one function to avoid running the iterations twice
 func (rcc *clientRegionCache) debugInfo(
@@ -123,6 +186,27 @@ func (rcc *clientRegionCache) debugInfo(
 	return clientRegionCacheMap
 }
 
+// connStats returns statistics of connections of the cached region clients
+// that keep them, summed up per address of the RegionServer.
+func (rcc *clientRegionCache) connStats() map[string]hrpc.ConnStats {
+	stats := map[string]hrpc.ConnStats{}
+	rcc.m.RLock()
+	for client := range rcc.regions {
+		cs, ok := client.(interface{ ConnStats() hrpc.ConnStats })
+		if !ok {
+			continue
+		}
+		s, total := cs.ConnStats(), stats[client.Addr()]
+		total.BytesSent += s.BytesSent
+		total.BytesReceived += s.BytesReceived
+		total.RPCsCompleted += s.RPCsCompleted
+		total.RPCsFailed += s.RPCsFailed
+		stats[client.Addr()] = total
+	}
+	rcc.m.RUnlock()
+	return stats
+}
+
 // key -> region cache.
 type keyRegionCache struct {
 	m sync.RWMutex
@@ -181,6 +265,31 @@ func (krc *keyRegionCache) debugInfo(
 	return regionCacheMap
 }
 
+// sample returns up to n regions picked from the cache at random.
+func (krc *keyRegionCache) sample(n int) []hrpc.RegionInfo {
+	var sample []hrpc.RegionInfo
+	krc.m.RLock()
+	defer krc.m.RUnlock()
+	enum, err := krc.regions.SeekFirst()
+	if err != nil {
+		return nil
+	}
+	defer enum.Close()
+	for i := 0; ; i++ {
+		_, v, err := enum.Next()
+		if err == io.EOF {
+			break
+		}
+		// reservoir sampling
+		if len(sample) < n {
+			sample = append(sample, v)
+		} else if j := rand.Intn(i + 1); j < n {
+			sample[j] = v
+		}
+	}
+	return sample
+}
+
 func isRegionOverlap(regA, regB hrpc.RegionInfo) bool {
 	// if region's stop key is empty, it's assumed to be the greatest key
 	return bytes.Equal(regA.Namespace(), regB.Namespace()) &&
@@ -312,6 +421,37 @@ func (krc *keyRegionCache) put(reg hrpc.RegionInfo) (overlaps []hrpc.RegionInfo,
 	return
 }
 
+// delTable removes all the regions of the table and returns them.
+func (krc *keyRegionCache) delTable(table []byte) []hrpc.RegionInfo {
+	// names of regions of the table start with the table name and a comma,
+	// the one of the first region is followed by another comma for empty start key
+	prefix := append(append([]byte(nil), table...), ',')
+	var regions []hrpc.RegionInfo
+	krc.m.Lock()
+	enum, _ := krc.regions.Seek(append(prefix, ','))
+	for {
+		k, v, err := enum.Next()
+		if err != nil || !bytes.HasPrefix(k, prefix) {
+			break
+		}
+		regions = append(regions, v)
+	}
+	enum.Close()
+	for _, reg := range regions {
+		krc.regions.Delete(reg.Name())
+	}
+	krc.m.Unlock()
+
+	for _, reg := range regions {
+		// let region establishers know that they can give up
+		reg.MarkDead()
+		log.WithFields(log.Fields{
+			"region": reg,
+		}).Debug("removed region")
+	}
+	return regions
+}
+
 func (krc *keyRegionCache) del(reg hrpc.RegionInfo) bool {
 	krc.m.Lock()
 	success := krc.regions.Delete(reg.Name())
@@ -324,3 +464,181 @@ func (krc *keyRegionCache) del(reg hrpc.RegionInfo) bool {
 	}).Debug("removed region")
 	return success
 }
+
+// regionInFlight counts the rpcs queued or awaiting a response per region.
+// The zero value is ready to use.
+type regionInFlight struct {
+	m      sync.Mutex
+	counts map[hrpc.RegionInfo]int
+	// closing are the region clients being closed as idle, no rpc is
+	// acquired for them
+	closing map[hrpc.RegionClient]struct{}
+}
+
+// acquire adds an rpc in flight to reg, to be sent with rc, unless rc is being
+// closed or isn't the client of reg anymore, in which case it returns false
+// and the region and its client must be looked up again.
+func (rif *regionInFlight) acquire(reg hrpc.RegionInfo, rc hrpc.RegionClient) bool {
+	rif.m.Lock()
+	defer rif.m.Unlock()
+	if _, ok := rif.closing[rc]; ok || reg.Client() != rc {
+		return false
+	}
+	if rif.counts == nil {
+		rif.counts = make(map[hrpc.RegionInfo]int)
+	}
+	rif.counts[reg]++
+	return true
+}
+
+// startClosing marks rc as being closed and returns true, unless any of its
+// regions has rpcs in flight. No rpc is acquired for rc until doneClosing.
+func (rif *regionInFlight) startClosing(rc hrpc.RegionClient,
+	regions []hrpc.RegionInfo) bool {
+	rif.m.Lock()
+	defer rif.m.Unlock()
+	for _, reg := range regions {
+		if rif.counts[reg] > 0 {
+			return false
+		}
+	}
+	if rif.closing == nil {
+		rif.closing = make(map[hrpc.RegionClient]struct{})
+	}
+	rif.closing[rc] = struct{}{}
+	return true
+}
+
+// doneClosing unmarks rc once it's closed and none of its regions uses it.
+func (rif *regionInFlight) doneClosing(rc hrpc.RegionClient) {
+	rif.m.Lock()
+	defer rif.m.Unlock()
+	delete(rif.closing, rc)
+}
+
+// add adds n to the count of reg.
+func (rif *regionInFlight) add(reg hrpc.RegionInfo, n int) {
+	rif.m.Lock()
+	defer rif.m.Unlock()
+	if rif.counts == nil {
+		rif.counts = make(map[hrpc.RegionInfo]int)
+	}
+	if count := rif.counts[reg] + n; count > 0 {
+		rif.counts[reg] = count
+	} else {
+		delete(rif.counts, reg)
+	}
+}
+
+// snapshot returns the counts keyed by region names.
+func (rif *regionInFlight) snapshot() map[string]int {
+	rif.m.Lock()
+	defer rif.m.Unlock()
+	counts := make(map[string]int, len(rif.counts))
+	for reg, count := range rif.counts {
+		counts[string(reg.Name())] += count
+	}
+	return counts
+}
+
+// regionBackoffs tracks the backoffs of the regions being established, so that
+// they can be inspected and reset. The zero value is ready to use.
+type regionBackoffs struct {
+	m        sync.Mutex
+	backoffs map[hrpc.RegionInfo]*regionBackoff
+}
+
+type regionBackoff struct {
+	backoff time.Duration
+	// cancel cuts the backoff short when it's reset
+	cancel context.CancelFunc
+	reset  bool
+}
+
+// sleep sleeps for backoff of reg and returns the next one like
+// sleepAndIncreaseBackoff, unless the backoff of reg is reset meanwhile,
+// in which case it returns right away and backoffs start over.
+func (rb *regionBackoffs) sleep(ctx context.Context, reg hrpc.RegionInfo,
+	backoff time.Duration) (time.Duration, error) {
+	if backoff == 0 {
+		return sleepAndIncreaseBackoff(ctx, backoff)
+	}
+	sleepCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	b := &regionBackoff{backoff: backoff, cancel: cancel}
+	rb.m.Lock()
+	if rb.backoffs == nil {
+		rb.backoffs = make(map[hrpc.RegionInfo]*regionBackoff)
+	}
+	rb.backoffs[reg] = b
+	rb.m.Unlock()
+
+	next, err := sleepAndIncreaseBackoff(sleepCtx, backoff)
+
+	rb.m.Lock()
+	if rb.backoffs[reg] == b {
+		delete(rb.backoffs, reg)
+	}
+	reset := b.reset
+	rb.m.Unlock()
+	if err != nil && reset && ctx.Err() == nil {
+		return backoffStart, nil
+	}
+	return next, err
+}
+
+// reset resets the backoff of the region named regionName, or of all
+// regions if it's nil, and returns the number of regions reset.
+func (rb *regionBackoffs) reset(regionName []byte) int {
+	rb.m.Lock()
+	defer rb.m.Unlock()
+	var n int
+	for reg, b := range rb.backoffs {
+		if regionName != nil && !bytes.Equal(reg.Name(), regionName) {
+			continue
+		}
+		b.reset = true
+		b.cancel()
+		n++
+	}
+	return n
+}
+
+// snapshot returns the backoffs keyed by region names.
+func (rb *regionBackoffs) snapshot() map[string]time.Duration {
+	rb.m.Lock()
+	defer rb.m.Unlock()
+	backoffs := make(map[string]time.Duration, len(rb.backoffs))
+	for reg, b := range rb.backoffs {
+		backoffs[string(reg.Name())] = b.backoff
+	}
+	return backoffs
+}
+
+// loadedAddrs are the addresses of the RegionServers of regions loaded
+// in cache with LoadRegionCache, until they're established.
+type loadedAddrs struct {
+	m     sync.Mutex
+	addrs map[hrpc.RegionInfo]string
+}
+
+func (la *loadedAddrs) put(reg hrpc.RegionInfo, addr string) {
+	la.m.Lock()
+	defer la.m.Unlock()
+	if la.addrs == nil {
+		la.addrs = make(map[hrpc.RegionInfo]string)
+	}
+	la.addrs[reg] = addr
+}
+
+// take returns the loaded address of reg and forgets it,
+// or an empty string if it wasn't loaded.
+func (la *loadedAddrs) take(reg hrpc.RegionInfo) string {
+	la.m.Lock()
+	defer la.m.Unlock()
+	addr, ok := la.addrs[reg]
+	if ok {
+		delete(la.addrs, reg)
+	}
+	return addr
+}
@@ -6,14 +6,22 @@
 package gohbase
 
 import (
+	"bytes"
 	"context"
 	"encoding/binary"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"net"
+	"sort"
+	"strconv"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/baiweiguo/gohbase/compression"
+	"github.com/baiweiguo/gohbase/filter"
 	"github.com/baiweiguo/gohbase/hrpc"
 	"github.com/baiweiguo/gohbase/pb"
 	"github.com/baiweiguo/gohbase/region"
@@ -29,6 +37,10 @@ const (
 	defaultZkRoot        = "/hbase"
 	defaultZkTimeout     = 30 * time.Second
 	defaultEffectiveUser = "root"
+
+	defaultMetaLookupMaxStaleness = 10 * time.Second
+
+	defaultCoprocessorConcurrency = 16
 )
 
 // Client a regular HBase client
@@ -45,6 +57,137 @@ type Client interface {
 	Close()
 }
 
+// The following interfaces are implemented by the clients returned by NewClient
+// in addition to Client, which is kept as is so that its implementations and
+// mocks don't break. Type-assert a Client to use them, e.g.:
+//
+//	if sc, ok := c.(gohbase.StatsClient); ok {
+//		stats := sc.Stats()
+//	}
+
+// ScanClient scans in other ways than Scan.
+type ScanClient interface {
+	// ScanRegion scans only the region with the given name.
+	ScanRegion(ctx context.Context, regionName []byte,
+		options ...func(hrpc.Call) error) hrpc.Scanner
+	// ScanCallback scans like Scan and calls fn with every row until it returns
+	// false or the scan ends, e.g. to push rows to event loops. It returns the
+	// error the scan failed with, if any.
+	ScanCallback(s *hrpc.Scan, fn func(*hrpc.Result) bool) error
+}
+
+// CheckAndMutateClient performs conditional mutations beyond CheckAndPut.
+type CheckAndMutateClient interface {
+	CheckAndPutCompare(p *hrpc.Mutate, family string, qualifier string,
+		compareOp filter.CompareType, comparator filter.Comparator) (bool, error)
+	// CheckAndMutate atomically performs the mutations of a row if comparing
+	// the value of the comparator with the one of the cell using compareOp is
+	// true, see hrpc.NewCheckAndMutate.
+	CheckAndMutate(mutations []*hrpc.Mutate, family string, qualifier string,
+		compareOp filter.CompareType, comparator filter.Comparator) (bool, error)
+}
+
+// CoprocessorClient invokes coprocessor services.
+type CoprocessorClient interface {
+	// CoprocessorService invokes method of the coprocessor service loaded in
+	// the region of table holding row with request, a serialized protobuf
+	// message, and returns the serialized response of the method.
+	CoprocessorService(ctx context.Context, table, row []byte,
+		service, method string, request []byte) ([]byte, error)
+	// CoprocessorServiceAll invokes method of the coprocessor service in every
+	// region of table like CoprocessorService and returns the results keyed by
+	// the names of the regions. The failure of some regions is reported in their
+	// results. An error is returned only if the regions couldn't be looked up.
+	CoprocessorServiceAll(ctx context.Context, table []byte,
+		service, method string, request []byte) (map[string]hrpc.CoprocessorResult, error)
+}
+
+// BatchClient sends batches with options.
+type BatchClient interface {
+	// SendBatchWithOptions sends batch like SendBatch with the given options.
+	SendBatchWithOptions(ctx context.Context, batch []hrpc.Call,
+		options ...hrpc.BatchOption) (res []hrpc.RPCResult, allOK bool)
+}
+
+// TableClient configures and inspects tables.
+type TableClient interface {
+	// RegisterTableDefaults registers options applied to Get, Scan and mutation
+	// calls against the table before their own options.
+	RegisterTableDefaults(table string, options ...func(hrpc.Call) error)
+	// EstimateRange estimates the number of rows and cells of table from start
+	// included to stop excluded, and their size, without reading their values.
+	EstimateRange(ctx context.Context, table, start, stop []byte) (hrpc.RangeEstimate, error)
+	// WatchTableRegions returns a channel of the changes of the regions of table,
+	// which are polled every RegionWatchInterval until ctx is done.
+	WatchTableRegions(ctx context.Context, table []byte) <-chan hrpc.TableRegionsEvent
+}
+
+// RegionCacheClient manages the cached regions and region clients.
+type RegionCacheClient interface {
+	// InvalidateTableRegions removes the cached regions of the table.
+	InvalidateTableRegions(table string)
+	// LoadRegionCache puts regions located out-of-band in cache, e.g. by other
+	// clients, so that they don't have to be looked up. Their RegionServers are
+	// connected to once they're used. An error is returned without loading any
+	// region if some of them overlap.
+	LoadRegionCache(locations []hrpc.RegionLocation) error
+	// RegionClientFor returns the cached region client connected to the
+	// RegionServer at host and port, which the client sends the RPCs of its
+	// regions over, connecting a new one if there's none, e.g. to send custom
+	// RPCs. It's meant for advanced uses: RPCs sent over it bypass region lookups
+	// and retries, and closing it, or sending it RPCs of regions the RegionServer
+	// doesn't serve, can make the client route RPCs to a closed connection or to
+	// the wrong RegionServer until their regions are looked up again.
+	RegionClientFor(ctx context.Context, host string, port int) (hrpc.RegionClient, error)
+	// PrewarmRegions looks up all the regions of table at once and connects to
+	// their RegionServers ahead of the first RPCs, e.g. when a service starts,
+	// at most as many at once as set with PrewarmConcurrency option. The regions
+	// the RegionServers of which couldn't be connected to are cached anyway and
+	// established once used, and an error is returned with the summary.
+	PrewarmRegions(ctx context.Context, table []byte) (hrpc.PrewarmSummary, error)
+}
+
+// StatsClient reports statistics of the client.
+type StatsClient interface {
+	// ConnStats returns a snapshot of statistics of connections to RegionServers
+	// keyed by their addresses.
+	ConnStats() map[string]hrpc.ConnStats
+	// Stats returns a snapshot of statistics of the client over its lifetime,
+	// such as the number of region lookups and of retries.
+	Stats() hrpc.ClientStats
+	// InFlightRPCs returns the number of RPCs queued or awaiting a response
+	// for each region keyed by its name, e.g. for adaptive load-shedding.
+	InFlightRPCs() map[string]int
+	// RegionBackoffs returns how long each region being reestablished, keyed by
+	// its name, waits before its next attempt.
+	RegionBackoffs() map[string]time.Duration
+	// ResetRegionBackoff makes the region named regionName, or every region if
+	// it's nil, retry to be reestablished right away with backoffs starting over,
+	// e.g. once the cause of its failures is fixed. It returns the number of
+	// regions whose backoff has been reset.
+	ResetRegionBackoff(regionName []byte) int
+}
+
+// ClusterClient exposes the cluster the client connects to and its lifetime.
+type ClusterClient interface {
+	// ClusterID returns the ID of the HBase cluster the client connects to.
+	ClusterID() (string, error)
+	// Context returns a context that is cancelled when the client is closed,
+	// so that goroutines using contexts derived from it stop with the client.
+	Context() context.Context
+}
+
+var (
+	_ ScanClient           = (*client)(nil)
+	_ CheckAndMutateClient = (*client)(nil)
+	_ CoprocessorClient    = (*client)(nil)
+	_ BatchClient          = (*client)(nil)
+	_ TableClient          = (*client)(nil)
+	_ RegionCacheClient    = (*client)(nil)
+	_ StatsClient          = (*client)(nil)
+	_ ClusterClient        = (*client)(nil)
+)
+
 // RPCClient is core client of gohbase. It's exposed for testing.
 type RPCClient interface {
 	SendRPC(rpc hrpc.Call) (proto.Message, error)
@@ -55,6 +198,9 @@ type Option func(*client)
 
 // A Client provides access to an HBase cluster.
 type client struct {
+	// stats is first to be 64-bit aligned for atomic operations
+	stats hrpc.ClientStats
+
 	clientType region.ClientType
 
 	regions keyRegionCache
@@ -63,6 +209,12 @@ type client struct {
 	// serves it.
 	clients clientRegionCache
 
+	// inFlight counts the rpcs queued or awaiting a response per region
+	inFlight regionInFlight
+
+	// backoffs tracks the backoffs of the regions being established
+	backoffs regionBackoffs
+
 	metaRegionInfo hrpc.RegionInfo
 
 	adminRegionInfo hrpc.RegionInfo
@@ -93,13 +245,112 @@ type client struct {
 	// regionReadTimeout is the maximum amount of time to wait for regionserver reply
 	regionReadTimeout time.Duration
 
+	// metaLookupMaxStaleness is how long to keep retrying a region lookup
+	// while hbase:meta returns a region that doesn't contain the key.
+	metaLookupMaxStaleness time.Duration
+
+	// masterLookupMaxWait is how long to keep retrying to look up the master
+	// before failing admin operations. Zero means retrying forever.
+	masterLookupMaxWait time.Duration
+
+	// maxRegionLookupDuration is how long to keep retrying to look up a region
+	// in total, or zero to retry until the context of the rpc is done
+	maxRegionLookupDuration time.Duration
+
+	// maxCellSize is the maximum size of a cell of mutations in bytes
+	maxCellSize int64
+
+	// noAutoReconnect makes rpcs fail instead of waiting for unavailable
+	// regions to be reestablished in background
+	noAutoReconnect bool
+
+	// revalidationInterval is how often a sample of revalidationSample
+	// cached regions is checked against hbase:meta. Zero disables it.
+	revalidationInterval time.Duration
+	revalidationSample   int
+	// after is time.After, replaced in tests
+	after func(time.Duration) <-chan time.Time
+
+	// serverVersion is the version of HBase set with ServerVersion option,
+	// nil if features aren't gated by version
+	serverVersion *hrpc.Version
+
+	// idleTimeout is how long region clients can go unused before being
+	// closed, see IdleTimeout option. Zero keeps them open.
+	idleTimeout time.Duration
+
+	// readRPCTimeout and writeRPCTimeout limit how long reads and writes
+	// can take if their contexts have no deadline. Zero means no limit.
+	readRPCTimeout  time.Duration
+	writeRPCTimeout time.Duration
+	// attemptTimeout limits how long each attempt at sending a Get, or an
+	// increment or an append with a nonce, waits for its response, see
+	// AttemptTimeout option
+	attemptTimeout time.Duration
+
 	done      chan struct{}
 	closeOnce sync.Once
 
+	// ctx is the parent context of all background goroutines of the client,
+	// such as the ones reestablishing regions. It's cancelled on Close.
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	// breakers are the circuit breakers of RegionServers, nil if disabled
+	breakers *circuitBreakers
+
+	// nsreStorm spreads out reconnecting regions during bursts of
+	// NotServingRegionError, nil if disabled
+	nsreStorm *nsreStorm
+
+	// regionWatchInterval is how often WatchTableRegions polls regions
+	regionWatchInterval time.Duration
+
+	// scanMemory caps the memory of batches held by scanners, nil if unlimited
+	scanMemory *scanMemory
+
+	// clockSkewThreshold is the clock skew between the client and a regionserver
+	// above which a warning is reported. Zero disables the check.
+	clockSkewThreshold time.Duration
+
 	newRegionClientFn func(string, region.ClientType, int, time.Duration,
-		string, time.Duration, compression.Codec) hrpc.RegionClient
+		string, time.Duration, compression.Codec, ...region.ClientOption) hrpc.RegionClient
+
+	// regionClientOptions are passed to region clients when they are created
+	regionClientOptions []region.ClientOption
+
+	// rewriteHost maps the hosts of looked up addresses before they're dialed
+	rewriteHost func(host string) string
+
+	// loadedAddrs are the addresses of the regions put in cache with
+	// LoadRegionCache until they're established
+	loadedAddrs loadedAddrs
+
+	// dialObserver is called with the outcome of each attempt to connect
+	// to a RegionServer, see ObserveDials option
+	dialObserver func(addr string, duration time.Duration, err error)
 
 	compressionCodec compression.Codec
+
+	// tableDefaults are the options registered with RegisterTableDefaults
+	// keyed by table name
+	tableDefaultsM sync.RWMutex
+	tableDefaults  map[string][]func(hrpc.Call) error
+
+	// clusterID is the ID of the cluster once it's been read from zookeeper
+	clusterIDM sync.Mutex
+	clusterID  string
+
+	// resolver looks up regions instead of hbase:meta if set
+	resolver RegionResolver
+
+	// coprocessorConcurrency is how many regions CoprocessorServiceAll
+	// invokes coprocessor endpoints of at once
+	coprocessorConcurrency int
+
+	// prewarmConcurrency is how many RegionServers PrewarmRegions
+	// connects to at once
+	prewarmConcurrency int
 }
 
 // NewClient creates a new HBase client.
@@ -107,6 +358,24 @@ func NewClient(zkquorum string, options ...Option) Client {
 	return newClient(zkquorum, options...)
 }
 
+// NewClientWithClusterID creates a new HBase client like NewClient, but first
+// checks that the cluster in ZooKeeper has the expected ID, so that a client
+// of a tool working with several clusters doesn't connect to the wrong one.
+// An error matching ErrClusterIDMismatch is returned if the ID is different.
+func NewClientWithClusterID(zkquorum, clusterID string, options ...Option) (Client, error) {
+	c := newClient(zkquorum, options...)
+	id, err := c.ClusterID()
+	if err != nil {
+		c.Close()
+		return nil, err
+	}
+	if id != clusterID {
+		c.Close()
+		return nil, fmt.Errorf("%w: expected %q, got %q", ErrClusterIDMismatch, clusterID, id)
+	}
+	return c, nil
+}
+
 func newClient(zkquorum string, options ...Option) *client {
 	log.WithFields(log.Fields{
 		"Host": zkquorum,
@@ -133,6 +402,13 @@ func newClient(zkquorum string, options ...Option) *client {
 		regionReadTimeout:   region.DefaultReadTimeout,
 		done:                make(chan struct{}),
 		newRegionClientFn:   region.NewClient,
+		ctx:                 context.Background(),
+		after:               time.After,
+
+		metaLookupMaxStaleness: defaultMetaLookupMaxStaleness,
+		coprocessorConcurrency: defaultCoprocessorConcurrency,
+		prewarmConcurrency:     defaultPrewarmConcurrency,
+		regionWatchInterval:    defaultRegionWatchInterval,
 	}
 	for _, option := range options {
 		option(c)
@@ -140,11 +416,36 @@ func newClient(zkquorum string, options ...Option) *client {
 
 	//Have to create the zkClient after the Options have been set
 	//since the zkTimeout could be changed as an option
-	c.zkClient = zk.NewClient(zkquorum, c.zkTimeout)
+	if c.zkClient == nil {
+		c.zkClient = zk.NewClient(zkquorum, c.zkTimeout)
+	}
+	c.initContext()
+	if c.revalidationInterval > 0 {
+		go c.revalidateRegions()
+	}
+	if c.idleTimeout > 0 {
+		go c.closeIdleClients()
+	}
 
 	return c
 }
 
+// initContext derives the context of background goroutines from the parent
+// context provided with ParentContext option. The client is closed as soon
+// as the parent context is done.
+func (c *client) initContext() {
+	parent := c.ctx
+	c.ctx, c.cancel = context.WithCancel(parent)
+	if parent.Done() == nil {
+		// parent is never done
+		return
+	}
+	go func() {
+		<-c.ctx.Done()
+		c.Close()
+	}()
+}
+
 // DebugState information about the clients keyRegionCache, and clientRegionCache
 func DebugState(client Client) ([]byte, error) {
 
@@ -230,6 +531,22 @@ func ZookeeperTimeout(to time.Duration) Option {
 	}
 }
 
+// RegionCacheCapacity will return an option that pre-sizes the cache of region
+// clients for the given number of regions spread over the given number of
+// RegionServers, to avoid growing it repeatedly when targeting tables with
+// thousands of regions. The cache of regions by key is a B-tree that grows
+// by nodes and doesn't need to be pre-sized.
+func RegionCacheCapacity(regions, regionServers int) Option {
+	return func(c *client) {
+		if regions <= 0 || regionServers <= 0 {
+			return
+		}
+		c.clients.regions = make(map[hrpc.RegionClient]map[hrpc.RegionInfo]struct{},
+			regionServers)
+		c.clients.regionsPerClient = (regions + regionServers - 1) / regionServers
+	}
+}
+
 // RegionLookupTimeout will return an option that sets the region lookup timeout
 func RegionLookupTimeout(to time.Duration) Option {
 	return func(c *client) {
@@ -244,6 +561,98 @@ func RegionReadTimeout(to time.Duration) Option {
 	}
 }
 
+// MasterLookupMaxWait will return an option that sets for how long looking up
+// the master is retried before admin operations fail with ErrMasterUnavailable.
+// By default the lookup is retried until the context of the operation is done.
+func MasterLookupMaxWait(d time.Duration) Option {
+	return func(c *client) {
+		c.masterLookupMaxWait = d
+	}
+}
+
+// MaxCellSize will return an option that makes the client reject mutations
+// with a cell larger than size bytes with ErrCellTooLarge instead of sending
+// them. It's meant to be set to hbase.server.keyvalue.maxsize of the cluster,
+// which is 10MB by default. Cells aren't limited by default. The lengths of the
+// values of a single request can be limited with hrpc.MaxLengths option.
+func MaxCellSize(size int64) Option {
+	return func(c *client) {
+		c.maxCellSize = size
+	}
+}
+
+// MaxRegionLookupDuration will return an option that sets for how long looking
+// up a region, including the retries, may take in total before RPCs fail with
+// ErrRegionLookupTimeout. It bounds the latency of RPCs to regions that can't
+// be located independently of their deadlines. By default the lookup is retried
+// until the context of the RPC is done.
+func MaxRegionLookupDuration(d time.Duration) Option {
+	return func(c *client) {
+		c.maxRegionLookupDuration = d
+	}
+}
+
+// NoAutoReconnect will return an option that makes the client fail fast when
+// a region is unavailable, e.g. for short-lived command line tools. RPCs return
+// errors of regions and regionservers instead of retrying them, and regions are
+// not reestablished in background. The next RPC to such region tries to
+// reestablish it once and returns ErrRegionUnavailable if it fails.
+func NoAutoReconnect() Option {
+	return func(c *client) {
+		c.noAutoReconnect = true
+	}
+}
+
+// RegionCacheRevalidation will return an option that makes the client check
+// up to sample cached regions against hbase:meta about every interval, and evict
+// the ones that don't exist anymore or have moved to another regionserver,
+// rather than finding out when an RPC fails. The interval is jittered
+// by up to a half in both directions. It's disabled by default.
+func RegionCacheRevalidation(interval time.Duration, sample int) Option {
+	return func(c *client) {
+		c.revalidationInterval = interval
+		c.revalidationSample = sample
+	}
+}
+
+// ReadRPCTimeout will return an option that sets the timeout of Get and Scan RPCs,
+// including their retries, whose contexts have no deadline.
+func ReadRPCTimeout(to time.Duration) Option {
+	return func(c *client) {
+		c.readRPCTimeout = to
+	}
+}
+
+// WriteRPCTimeout will return an option that sets the timeout of mutation RPCs,
+// including their retries, whose contexts have no deadline.
+func WriteRPCTimeout(to time.Duration) Option {
+	return func(c *client) {
+		c.writeRPCTimeout = to
+	}
+}
+
+// AttemptTimeout will return an option that limits how long each attempt at
+// sending a Get, or an increment or an append with a nonce, to a RegionServer
+// waits for its response. Attempts timing out are retried with backoff until
+// the context of the RPC is done, or its ReadRPCTimeout or WriteRPCTimeout is
+// over, e.g. to work around slow RegionServers with short attempts within a
+// larger budget. Other RPCs, which could be applied more than once, aren't
+// limited. Attempts aren't limited by default.
+func AttemptTimeout(to time.Duration) Option {
+	return func(c *client) {
+		c.attemptTimeout = to
+	}
+}
+
+// MetaLookupMaxStaleness will return an option that sets for how long a region lookup
+// is retried while hbase:meta returns a region that doesn't contain the looked up key,
+// which happens briefly after a region split. After that ErrStaleMeta is returned.
+func MetaLookupMaxStaleness(d time.Duration) Option {
+	return func(c *client) {
+		c.metaLookupMaxStaleness = d
+	}
+}
+
 // EffectiveUser will return an option that will set the user used when accessing regions.
 func EffectiveUser(user string) Option {
 	return func(c *client) {
@@ -251,6 +660,19 @@ func EffectiveUser(user string) Option {
 	}
 }
 
+// ProxyUser will return an option that makes the client run RPCs on behalf of
+// user, e.g. the end user of a secure gateway, by impersonating it as realUser,
+// the user of the gateway. RPCs are authorized by HBase as user, which must
+// allow realUser to impersonate others, see the hadoop.proxyuser.<realUser>.*
+// settings. Since the users are set on connections to RegionServers, a client
+// impersonates a single user, which is set like with EffectiveUser option.
+func ProxyUser(realUser, user string) Option {
+	return func(c *client) {
+		c.effectiveUser = user
+		c.regionClientOptions = append(c.regionClientOptions, region.WithRealUser(realUser))
+	}
+}
+
 // FlushInterval will return an option that will set the timeout for flushing
 // the RPC queues used in a given client
 func FlushInterval(interval time.Duration) Option {
@@ -268,9 +690,333 @@ func CompressionCodec(codec string) Option {
 	}
 }
 
+// CompressionThreshold will return an option that sends mutations whose cells
+// add up to less than size bytes as plain protobuf rather than compressed
+// cellblocks, so that only large mutations are compressed. It only applies
+// if compression is enabled with CompressionCodec. Default is 0, which
+// compresses every mutation.
+func CompressionThreshold(size uint32) Option {
+	return func(c *client) {
+		c.regionClientOptions = append(c.regionClientOptions,
+			region.WithCompressionThreshold(size))
+	}
+}
+
+// ParentContext will return an option that sets the parent context of
+// all goroutines the client runs in background, such as the ones
+// reconnecting to regions. Cancelling it stops them and closes the client.
+func ParentContext(ctx context.Context) Option {
+	return func(c *client) {
+		c.ctx = ctx
+	}
+}
+
+// CoalesceIncrements will return an option that makes region clients sum up
+// increments of the same cell batched within a flush interval and send them
+// as a single increment. Each Increment call still returns the value the cell
+// would have had if the increments were sent one by one. It only has effect
+// on increments that are batched, see RpcQueueSize and FlushInterval.
+func CoalesceIncrements() Option {
+	return func(c *client) {
+		c.regionClientOptions = append(c.regionClientOptions, region.CoalesceIncrements())
+	}
+}
+
+// DNSCacheTTL will return an option that makes region clients cache resolved
+// addresses of RegionServer hostnames for the given duration instead of resolving
+// them on every connection. The addresses are resolved again after a failed
+// connection attempt.
+func DNSCacheTTL(ttl time.Duration) Option {
+	return func(c *client) {
+		c.regionClientOptions = append(c.regionClientOptions,
+			region.WithDNSCache(region.NewDNSCache(ttl)))
+	}
+}
+
+// Dialer will return an option that makes region clients connect to RegionServers
+// with the given function instead of net.Dialer, for example to tunnel the
+// connections or to connect to an in-process server in tests.
+func Dialer(dialer func(ctx context.Context, network, addr string) (net.Conn, error)) Option {
+	return func(c *client) {
+		c.regionClientOptions = append(c.regionClientOptions, region.WithDialer(dialer))
+	}
+}
+
+// ContextSocketDeadlines will return an option that sets the write and read
+// deadlines of the sockets of connections to RegionServers from the deadlines
+// of the contexts of RPCs, so that a stalled connection is closed once an RPC
+// is over rather than after RegionReadTimeout and its RPCs are retried on a new
+// one. A slow response to an RPC whose context is over closes the connection
+// all the same, unless other RPCs awaiting a response have later deadlines.
+func ContextSocketDeadlines() Option {
+	return func(c *client) {
+		c.regionClientOptions = append(c.regionClientOptions, region.WithContextDeadlines())
+	}
+}
+
+// SocketBuffers will return an option that sets the sizes of the receive and
+// send buffers of the sockets of connections to RegionServers, e.g. larger ones
+// for high-throughput scans. Sizes of zero or less leave the defaults of the
+// operating system, which are used by default.
+func SocketBuffers(read, write int) Option {
+	return func(c *client) {
+		c.regionClientOptions = append(c.regionClientOptions,
+			region.WithSocketBuffers(read, write))
+	}
+}
+
+// TCPNoDelay will return an option that sets TCP_NODELAY on the connections
+// to RegionServers, which is enabled by default.
+func TCPNoDelay(noDelay bool) Option {
+	return func(c *client) {
+		c.regionClientOptions = append(c.regionClientOptions, region.WithNoDelay(noDelay))
+	}
+}
+
+// WireTap will return an option that makes the clients of RegionServers call
+// tap with the serialized frames of the rpcs they send and receive, for
+// debugging protocol issues. See region.Tap for the details of the frames.
+func WireTap(tap region.Tap) Option {
+	return func(c *client) {
+		c.regionClientOptions = append(c.regionClientOptions, region.WithTap(tap))
+	}
+}
+
+// CircuitBreaker will return an option that opens the circuit breaker of
+// a RegionServer after the given number of consecutive failures, such as
+// failures to connect to it or connections failing while sending RPCs to it.
+// While the circuit is open, the client doesn't try to reconnect to the
+// RegionServer and RPCs to its regions fail with ErrCircuitOpen. Once the
+// cooldown is over, the next RPC to one of its regions tries to reconnect:
+// the circuit is closed if the RPC succeeds and opened again if it fails.
+// Circuit breakers are disabled by default.
+func CircuitBreaker(failures int, cooldown time.Duration) Option {
+	return func(c *client) {
+		if failures > 0 {
+			c.breakers = newCircuitBreakers(failures, cooldown)
+		}
+	}
+}
+
+// NotServingRegionStorm will return an option that detects storms of
+// NotServingRegionError, such as during rolling restarts of RegionServers,
+// when at least the given number of regions fail with it within window.
+// Regions failing during a storm wait for a shared backoff before reconnecting,
+// and then reconnect at a rate of at most regions per backoff, instead of all
+// reconnecting at once and adding to the load of the restarting cluster.
+// Storm detection is disabled by default.
+func NotServingRegionStorm(regions int, window, backoff time.Duration) Option {
+	return func(c *client) {
+		if regions > 0 {
+			c.nsreStorm = newNSREStorm(regions, window, backoff)
+		}
+	}
+}
+
+// ScanMemoryLimit will return an option that caps the total number of bytes
+// of the batches of results held by the scanners of the client. Before fetching
+// a batch, a scanner reserves its MaxResultSize, or the limit if it's lower,
+// and waits until its context is done for other scanners to release enough
+// bytes, which they do once their batch is consumed or they are closed.
+// The bytes in use are reported by Stats. Memory is unlimited by default.
+func ScanMemoryLimit(limit int64) Option {
+	return func(c *client) {
+		if limit > 0 {
+			c.scanMemory = newScanMemory(limit)
+		}
+	}
+}
+
+// MaxRequestSize will return an option that caps the estimated size of the
+// requests sent to RegionServers, which should be at most their
+// hbase.ipc.max.request.size. Batches that would exceed it are split into
+// several requests, see region.WithMaxRequestSize. The default is
+// region.DefaultMaxRequestSize, zero or less disables the limit.
+func MaxRequestSize(size int64) Option {
+	return func(c *client) {
+		c.regionClientOptions = append(c.regionClientOptions, region.WithMaxRequestSize(size))
+	}
+}
+
+// RPCCodec will return an option that makes the clients of RegionServers
+// encode requests and decode responses with codec instead of the default
+// region.ProtobufCodec, e.g. to support another revision of the RPC protocol.
+func RPCCodec(codec region.RPCCodec) Option {
+	return func(c *client) {
+		c.regionClientOptions = append(c.regionClientOptions, region.WithRPCCodec(codec))
+	}
+}
+
+// RewriteHost will return an option that maps the hosts of the RegionServers
+// and the master found in ZooKeeper or hbase:meta with rewrite before they're
+// connected to, for example when the cluster publishes internal short hostnames
+// the client can't resolve. The port is kept as is.
+func RewriteHost(rewrite func(host string) string) Option {
+	return func(c *client) {
+		c.rewriteHost = rewrite
+	}
+}
+
+// ObserveDials will return an option that makes the client call observe with
+// the host:port, duration and error of each attempt to connect to a RegionServer
+// or the master, e.g. to monitor connection churn. The error is nil if the
+// connection succeeded. Regions waiting for the same connection to be made
+// each report it. observe must not block. Dials aren't observed by default.
+func ObserveDials(observe func(addr string, duration time.Duration, err error)) Option {
+	return func(c *client) {
+		c.dialObserver = observe
+	}
+}
+
+// ZookeeperClient will return an option that makes the client locate
+// hbase:meta and the master with the given client instead of connecting
+// to the ZooKeeper quorum.
+func ZookeeperClient(zkc zk.Client) Option {
+	return func(c *client) {
+		c.zkClient = zkc
+	}
+}
+
+// ClockSkewThreshold will return an option that enables estimating the clock skew
+// between the client and regionservers from the server timestamps of cells returned
+// by Increment and Append. A warning is logged and gohbase_clock_skew_exceeded_total
+// metric is incremented when the estimate exceeds the threshold.
+func ClockSkewThreshold(threshold time.Duration) Option {
+	return func(c *client) {
+		c.clockSkewThreshold = threshold
+	}
+}
+
+// CoprocessorConcurrency will return an option that sets how many regions
+// CoprocessorServiceAll invokes coprocessor endpoints of at once.
+// The default is 16.
+func CoprocessorConcurrency(n int) Option {
+	return func(c *client) {
+		if n > 0 {
+			c.coprocessorConcurrency = n
+		}
+	}
+}
+
+func (c *client) ConnStats() map[string]hrpc.ConnStats {
+	return c.clients.connStats()
+}
+
+func (c *client) Stats() hrpc.ClientStats {
+	return hrpc.ClientStats{
+		RegionLookups:     atomic.LoadUint64(&c.stats.RegionLookups),
+		CacheHits:         atomic.LoadUint64(&c.stats.CacheHits),
+		Reconnects:        atomic.LoadUint64(&c.stats.Reconnects),
+		Retries:           atomic.LoadUint64(&c.stats.Retries),
+		DeadlinesExceeded: atomic.LoadUint64(&c.stats.DeadlinesExceeded),
+		ScanMemoryInUse:   uint64(c.scanMemory.inUse()),
+	}
+}
+
+func (c *client) InFlightRPCs() map[string]int {
+	return c.inFlight.snapshot()
+}
+
+func (c *client) RegionBackoffs() map[string]time.Duration {
+	return c.backoffs.snapshot()
+}
+
+func (c *client) ResetRegionBackoff(regionName []byte) int {
+	return c.backoffs.reset(regionName)
+}
+
+// ClusterID returns the ID of the HBase cluster the client connects to. It's
+// read from ZooKeeper the first time and then remembered.
+func (c *client) ClusterID() (string, error) {
+	c.clusterIDM.Lock()
+	defer c.clusterIDM.Unlock()
+	if c.clusterID != "" {
+		return c.clusterID, nil
+	}
+	r, ok := c.zkClient.(zk.ClusterIDReader)
+	if !ok {
+		return "", fmt.Errorf("ZooKeeper client %T can't read the cluster ID", c.zkClient)
+	}
+	id, err := r.ReadClusterID(zk.ClusterID.Prepend(c.zkRoot))
+	if err != nil {
+		return "", fmt.Errorf("failed to read the cluster ID: %w", err)
+	}
+	c.clusterID = id
+	return id, nil
+}
+
+// InvalidateTableRegions removes the cached regions of the table, so that
+// they're looked up in hbase:meta again by subsequent RPCs, e.g. after regions
+// of the table have been reassigned or merged manually.
+func (c *client) InvalidateTableRegions(table string) {
+	for _, reg := range c.regions.delTable([]byte(table)) {
+		c.clients.del(reg)
+	}
+	log.WithField("table", table).Info("invalidated cached regions of table")
+}
+
+func (c *client) LoadRegionCache(locations []hrpc.RegionLocation) error {
+	sorted := make([]hrpc.RegionLocation, len(locations))
+	copy(sorted, locations)
+	sort.Slice(sorted, func(i, j int) bool {
+		return region.Compare(sorted[i].Region.Name(), sorted[j].Region.Name()) < 0
+	})
+	for i := 1; i < len(sorted); i++ {
+		prev, reg := sorted[i-1].Region, sorted[i].Region
+		if bytes.Equal(fullyQualifiedTable(prev), fullyQualifiedTable(reg)) &&
+			(len(prev.StopKey()) == 0 || bytes.Compare(prev.StopKey(), reg.StartKey()) > 0) {
+			return fmt.Errorf("regions %s and %s overlap", prev, reg)
+		}
+	}
+
+	for _, l := range sorted {
+		// the region is established by the first rpc finding it has no client
+		overlaps, replaced := c.regions.put(l.Region)
+		if !replaced {
+			// the same or younger regions are already in cache
+			continue
+		}
+		for _, r := range overlaps {
+			c.clients.del(r)
+		}
+		c.loadedAddrs.put(l.Region, c.rewriteAddr(l.Addr))
+	}
+	return nil
+}
+
+func (c *client) RegionClientFor(ctx context.Context, host string,
+	port int) (hrpc.RegionClient, error) {
+	addr := c.rewriteAddr(net.JoinHostPort(host, strconv.Itoa(port)))
+	var created bool
+	rc := c.clients.client(addr, func() hrpc.RegionClient {
+		created = true
+		return c.newRegionClientFn(addr, c.clientType, c.rpcQueueSize, c.flushInterval,
+			c.effectiveUser, c.regionReadTimeout, c.compressionCodec,
+			c.regionClientOptions...)
+	})
+	dialCtx, cancel := context.WithTimeout(ctx, c.regionLookupTimeout)
+	err := c.dial(dialCtx, rc)
+	cancel()
+	if err != nil {
+		if created {
+			c.clients.remove(rc)
+			rc.Close()
+		}
+		return nil, fmt.Errorf("failed to connect to RegionServer %s: %w", addr, err)
+	}
+	return rc, nil
+}
+
+// Context returns the context of background operations of the client,
+// which is cancelled when the client is closed.
+func (c *client) Context() context.Context {
+	return c.ctx
+}
+
 // Close closes connections to hbase master and regionservers
 func (c *client) Close() {
 	c.closeOnce.Do(func() {
+		c.cancel()
 		close(c.done)
 		if c.clientType == region.MasterClient {
 			if ac := c.adminRegionInfo.Client(); ac != nil {
@@ -281,11 +1027,140 @@ func (c *client) Close() {
 	})
 }
 
+// RegisterTableDefaults registers options applied to Get, Scan and mutation
+// calls against the table, such as families to read or durability of writes,
+// instead of passing them to every call. Options passed to a call take
+// precedence over the defaults, and defaults that can't be used with a call
+// are skipped for it. Registering defaults again for the same table replaces
+// the previous ones, and registering no options removes them.
+func (c *client) RegisterTableDefaults(table string, options ...func(hrpc.Call) error) {
+	c.tableDefaultsM.Lock()
+	defer c.tableDefaultsM.Unlock()
+	if len(options) == 0 {
+		delete(c.tableDefaults, table)
+		return
+	}
+	if c.tableDefaults == nil {
+		c.tableDefaults = make(map[string][]func(hrpc.Call) error)
+	}
+	c.tableDefaults[table] = options
+}
+
+// applyTableDefaults applies the defaults registered for the table of the call.
+func (c *client) applyTableDefaults(call hrpc.Call) {
+	c.tableDefaultsM.RLock()
+	defaults := c.tableDefaults[string(call.Table())]
+	c.tableDefaultsM.RUnlock()
+	hrpc.ApplyDefaults(call, defaults...)
+}
+
 func (c *client) Scan(s *hrpc.Scan) hrpc.Scanner {
-	return newScanner(c, s)
+	c.applyTableDefaults(s)
+	scanner := newScanner(c, s)
+	// scans of hbase:meta are needed by the scanners holding memory
+	// to locate their next regions, so they don't wait for it
+	if !bytes.Equal(s.Table(), metaTableName) {
+		scanner.memory = c.scanMemory
+		scanner.tableRegions = c.tableRegions
+	}
+	return scanner
+}
+
+func (c *client) ScanCallback(s *hrpc.Scan, fn func(*hrpc.Result) bool) error {
+	return scanCallback(c.Scan(s), fn)
+}
+
+// scanCallback calls fn with the rows of scanner until it returns false or
+// scanner has no more rows, and closes scanner so that no more rows are fetched.
+func scanCallback(scanner hrpc.Scanner, fn func(*hrpc.Result) bool) error {
+	defer scanner.Close()
+	for {
+		res, err := scanner.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if !fn(res) {
+			return nil
+		}
+	}
+}
+
+// ScanRegion scans the whole region with the given name and nothing else,
+// e.g. for tools processing data region by region. Unlike scans of a key range
+// that continue in daughter regions when a region is split, the scanner returns
+// ErrRegionChanged if the region has been split or merged before or while
+// it's scanned. A region moved to another regionserver is scanned there.
+// Reversed scans of a region are not supported.
+func (c *client) ScanRegion(ctx context.Context, regionName []byte,
+	options ...func(hrpc.Call) error) hrpc.Scanner {
+	table, startKey, err := parseRegionName(regionName)
+	if err != nil {
+		return &failedScanner{err: err}
+	}
+	s, err := hrpc.NewScanRange(ctx, table, startKey, nil, options...)
+	if err != nil {
+		return &failedScanner{err: err}
+	}
+	c.applyTableDefaults(s)
+	if s.Reversed() {
+		return &failedScanner{err: errors.New("'Reversed' option can't be used with ScanRegion")}
+	}
+	scanner := newScanner(c, s)
+	// the whole region is scanned, row ranges of filters are left to the server
+	scanner.startRow, scanner.closed = startKey, false
+	scanner.regionName = regionName
+	scanner.memory = c.scanMemory
+	return scanner
+}
+
+// EstimateRange estimates the number of rows and cells of table from start
+// included to stop excluded, and their size, without transferring the values
+// of the cells: only their keys and the lengths of their values are scanned,
+// with a KeyOnlyFilter. Cells written or deleted while the range is scanned
+// may or may not be counted.
+func (c *client) EstimateRange(ctx context.Context,
+	table, start, stop []byte) (hrpc.RangeEstimate, error) {
+	var est hrpc.RangeEstimate
+	scan, err := hrpc.NewScanRange(ctx, table, start, stop,
+		hrpc.Filters(filter.NewKeyOnlyFilter(true)))
+	if err != nil {
+		return est, err
+	}
+	scanner := c.Scan(scan)
+	defer scanner.Close()
+	for {
+		res, err := scanner.Next()
+		if err == io.EOF {
+			return est, nil
+		} else if err != nil {
+			return est, err
+		}
+		est.Rows++
+		for _, cell := range res.Cells {
+			est.Cells++
+			est.Bytes += keyOnlyCellSize(cell)
+		}
+	}
+}
+
+// keyOnlyCellSize returns the size of the KeyValue of a cell returned by
+// a KeyOnlyFilter, whose value is the length of the original value.
+func keyOnlyCellSize(cell *hrpc.Cell) uint64 {
+	valueLen := uint64(len(cell.Value))
+	if len(cell.Value) == 4 {
+		valueLen = uint64(binary.BigEndian.Uint32(cell.Value))
+	}
+	// lengths of key and value, row length, row, family length,
+	// family, qualifier, timestamp, type and value
+	return 4 + 4 + 2 + uint64(len(cell.Row)) + 1 + uint64(len(cell.Family)) +
+		uint64(len(cell.Qualifier)) + 8 + 1 + valueLen
 }
 
 func (c *client) Get(g *hrpc.Get) (*hrpc.Result, error) {
+	c.applyTableDefaults(g)
 	pbmsg, err := c.SendRPC(g)
 	if err != nil {
 		return nil, err
@@ -296,7 +1171,24 @@ func (c *client) Get(g *hrpc.Get) (*hrpc.Result, error) {
 		return nil, fmt.Errorf("sendRPC returned not a GetResponse")
 	}
 
-	return hrpc.ToLocalResult(r.Result), nil
+	res := hrpc.ToLocalResult(r.Result)
+	if g.SortedCells() {
+		res.SortCells()
+	}
+	res.Region = regionBounds(g.Region())
+	return res, nil
+}
+
+// regionBounds returns the bounds of the region that served a call
+func regionBounds(reg hrpc.RegionInfo) *hrpc.RegionBounds {
+	if reg == nil {
+		return nil
+	}
+	return &hrpc.RegionBounds{
+		Name:     reg.Name(),
+		StartKey: reg.StartKey(),
+		StopKey:  reg.StopKey(),
+	}
 }
 
 func (c *client) Put(p *hrpc.Mutate) (*hrpc.Result, error) {
@@ -327,6 +1219,11 @@ func (c *client) Increment(i *hrpc.Mutate) (int64, error) {
 }
 
 func (c *client) mutate(m *hrpc.Mutate) (*hrpc.Result, error) {
+	c.applyTableDefaults(m)
+	var start time.Time
+	if c.clockSkewThreshold > 0 {
+		start = time.Now()
+	}
 	pbmsg, err := c.SendRPC(m)
 	if err != nil {
 		return nil, err
@@ -337,16 +1234,135 @@ func (c *client) mutate(m *hrpc.Mutate) (*hrpc.Result, error) {
 		return nil, fmt.Errorf("sendRPC returned not a MutateResponse")
 	}
 
-	return hrpc.ToLocalResult(r.Result), nil
+	res := hrpc.ToLocalResult(r.Result)
+	res.Processed = r.Processed
+	res.Region = regionBounds(m.Region())
+	if c.clockSkewThreshold > 0 {
+		c.checkClockSkew(start, time.Now(), m, res)
+	}
+	return res, nil
 }
 
 func (c *client) CheckAndPut(p *hrpc.Mutate, family string,
 	qualifier string, expectedValue []byte) (bool, error) {
+	c.applyTableDefaults(p)
 	cas, err := hrpc.NewCheckAndPut(p, family, qualifier, expectedValue)
 	if err != nil {
 		return false, err
 	}
+	return c.checkAndPut(cas)
+}
+
+// CheckAndPutCompare performs the put if comparing the value of the comparator
+// with the one of the cell using compareOp is true, see hrpc.NewCheckAndPutCompare.
+func (c *client) CheckAndPutCompare(p *hrpc.Mutate, family string, qualifier string,
+	compareOp filter.CompareType, comparator filter.Comparator) (bool, error) {
+	c.applyTableDefaults(p)
+	cas, err := hrpc.NewCheckAndPutCompare(p, family, qualifier, compareOp, comparator)
+	if err != nil {
+		return false, err
+	}
+	return c.checkAndPut(cas)
+}
+
+// CheckAndMutate atomically performs the mutations of a row if comparing
+// the value of the comparator with the one of the cell using compareOp is
+// true, see hrpc.NewCheckAndMutate.
+func (c *client) CheckAndMutate(mutations []*hrpc.Mutate, family string, qualifier string,
+	compareOp filter.CompareType, comparator filter.Comparator) (bool, error) {
+	for _, m := range mutations {
+		c.applyTableDefaults(m)
+	}
+	cam, err := hrpc.NewCheckAndMutate(mutations, family, qualifier, compareOp, comparator)
+	if err != nil {
+		return false, err
+	}
+	pbmsg, err := c.SendRPC(cam)
+	if err != nil {
+		return false, err
+	}
+
+	r, ok := pbmsg.(*pb.MultiResponse)
+	if !ok {
+		return false, fmt.Errorf("sendRPC returned a %T instead of MultiResponse", pbmsg)
+	}
+
+	if r.Processed == nil {
+		return false, fmt.Errorf("protobuf in the response didn't contain the field "+
+			"indicating whether the CheckAndMutate was successful or not: %s", r)
+	}
+
+	return r.GetProcessed(), nil
+}
+
+func (c *client) CoprocessorService(ctx context.Context, table, row []byte,
+	service, method string, request []byte) ([]byte, error) {
+	cs, err := hrpc.NewCoprocessorService(ctx, table, row, service, method, request)
+	if err != nil {
+		return nil, err
+	}
+	return c.execService(cs)
+}
+
+// execService sends cs and returns the serialized response of the method.
+func (c *client) execService(cs *hrpc.CoprocessorService) ([]byte, error) {
+	pbmsg, err := c.SendRPC(cs)
+	if err != nil {
+		return nil, err
+	}
+
+	r, ok := pbmsg.(*pb.CoprocessorServiceResponse)
+	if !ok {
+		return nil, fmt.Errorf("sendRPC returned a %T instead of CoprocessorServiceResponse",
+			pbmsg)
+	}
+	return r.GetValue().GetValue(), nil
+}
+
+// CoprocessorServiceAll looks up the regions of the table first, and invokes
+// the method in the region holding the start key of each of them, at most
+// as many at once as set with CoprocessorConcurrency option.
+func (c *client) CoprocessorServiceAll(ctx context.Context, table []byte,
+	service, method string, request []byte) (map[string]hrpc.CoprocessorResult, error) {
+	regions, err := c.tableRegions(ctx, table)
+	if err != nil {
+		return nil, err
+	}
+	calls := make([]*hrpc.CoprocessorService, len(regions))
+	for i, reg := range regions {
+		calls[i], err = hrpc.NewCoprocessorService(ctx, table, reg.StartKey(),
+			service, method, request)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var (
+		m       sync.Mutex
+		wg      sync.WaitGroup
+		results = make(map[string]hrpc.CoprocessorResult, len(regions))
+		sem     = make(chan struct{}, c.coprocessorConcurrency)
+	)
+	for i, reg := range regions {
+		sem <- struct{}{}
+		wg.Add(1)
+		go func(reg hrpc.RegionInfo, cs *hrpc.CoprocessorService) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			var res hrpc.CoprocessorResult
+			res.Value, res.Err = c.execService(cs)
+			m.Lock()
+			results[string(reg.Name())] = res
+			m.Unlock()
+		}(reg, calls[i])
+	}
+	wg.Wait()
+	return results, nil
+}
 
+func (c *client) checkAndPut(cas *hrpc.CheckAndPut) (bool, error) {
 	pbmsg, err := c.SendRPC(cas)
 	if err != nil {
 		return false, err
@@ -0,0 +1,51 @@
+// Copyright (C) 2026  The GoHBase Authors.  All rights reserved.
+// This file is part of GoHBase.
+// Use of this source code is governed by the Apache License 2.0
+// that can be found in the COPYING file.
+
+package gohbase
+
+import (
+	"time"
+
+	"github.com/baiweiguo/gohbase/hrpc"
+	"github.com/baiweiguo/gohbase/pb"
+	log "github.com/sirupsen/logrus"
+)
+
+// EstimateClockSkew estimates the clock skew between the client and a regionserver
+// given the time a request was sent, the time its response was received and the
+// timestamp in milliseconds the regionserver assigned while handling it.
+// The server is assumed to have handled the request in the middle of the round-trip,
+// so the estimate is off by at most half of the round-trip time.
+// A positive skew means that the clock of the regionserver is ahead.
+func EstimateClockSkew(start, end time.Time, serverTs uint64) time.Duration {
+	mid := start.Add(end.Sub(start) / 2)
+	return time.Unix(0, int64(serverTs)*int64(time.Millisecond)).Sub(mid)
+}
+
+// checkClockSkew estimates the clock skew from the timestamps of cells of the result
+// and reports it if it exceeds the threshold configured with ClockSkewThreshold option.
+func (c *client) checkClockSkew(start, end time.Time, m *hrpc.Mutate, r *hrpc.Result) {
+	if r == nil || len(r.Cells) == 0 {
+		// only Increment and Append return cells with timestamps assigned by server
+		return
+	}
+	var serverTs uint64
+	for _, cell := range r.Cells {
+		if ts := (*pb.Cell)(cell).GetTimestamp(); ts > serverTs {
+			serverTs = ts
+		}
+	}
+	skew := EstimateClockSkew(start, end, serverTs)
+	if skew < c.clockSkewThreshold && skew > -c.clockSkewThreshold {
+		return
+	}
+	clockSkewExceeded.Inc()
+	log.WithFields(log.Fields{
+		"skew":      skew,
+		"threshold": c.clockSkewThreshold,
+		"rtt":       end.Sub(start),
+		"region":    m.Region(),
+	}).Warn("clock skew between client and regionserver exceeds threshold")
+}
@@ -0,0 +1,103 @@
+// Copyright (C) 2026  The GoHBase Authors.  All rights reserved.
+// This file is part of GoHBase.
+// Use of this source code is governed by the Apache License 2.0
+// that can be found in the COPYING file.
+
+package gohbase
+
+import (
+	"context"
+	"encoding/binary"
+	"testing"
+	"time"
+
+	"github.com/baiweiguo/gohbase/hrpc"
+	"github.com/baiweiguo/gohbase/pb"
+	"github.com/baiweiguo/gohbase/region"
+	"github.com/baiweiguo/gohbase/test"
+	mockRegion "github.com/baiweiguo/gohbase/test/mock/region"
+	"github.com/golang/mock/gomock"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestEstimateClockSkew(t *testing.T) {
+	start := time.Unix(1600000000, 0)
+	end := start.Add(100 * time.Millisecond)
+	// server handled the request in the middle of round-trip
+	mid := uint64(start.Add(50*time.Millisecond).UnixNano() / int64(time.Millisecond))
+
+	tests := []struct {
+		serverTs uint64
+		expSkew  time.Duration
+	}{
+		{serverTs: mid, expSkew: 0},
+		{serverTs: mid + 5000, expSkew: 5 * time.Second},
+		{serverTs: mid - 5000, expSkew: -5 * time.Second},
+		{serverTs: mid + 20, expSkew: 20 * time.Millisecond},
+	}
+	for _, tcase := range tests {
+		if skew := EstimateClockSkew(start, end, tcase.serverTs); skew != tcase.expSkew {
+			t.Errorf("expected skew %v for server timestamp %d, got %v",
+				tcase.expSkew, tcase.serverTs, skew)
+		}
+	}
+}
+
+func TestClockSkewThreshold(t *testing.T) {
+	ctrl := test.NewController(t)
+	defer ctrl.Finish()
+
+	c := newMockClient(nil)
+	ClockSkewThreshold(time.Minute)(c)
+
+	reg := region.NewInfo(0, nil, []byte("test1"),
+		[]byte("test1,,1234567890042.56f833d5569a27c7a43fbf547b4924a4."), nil, nil)
+	c.regions.put(reg)
+	rc := mockRegion.NewMockRegionClient(ctrl)
+	rc.EXPECT().String().Return("mock region client").AnyTimes()
+	c.clients.put("regionserver:1", reg, func() hrpc.RegionClient { return rc })
+	reg.SetClient(rc)
+
+	var skew time.Duration
+	rc.EXPECT().QueueRPC(gomock.Any()).AnyTimes().Do(func(rpc hrpc.Call) {
+		// regionserver assigns its current time to the incremented cell
+		ts := time.Now().Add(skew).UnixNano() / int64(time.Millisecond)
+		value := make([]byte, 8)
+		binary.BigEndian.PutUint64(value, 42)
+		rpc.ResultChan() <- hrpc.RPCResult{Msg: &pb.MutateResponse{
+			Result: &pb.Result{Cell: []*pb.Cell{&pb.Cell{
+				Row:       []byte("row"),
+				Family:    []byte("cf"),
+				Qualifier: []byte("a"),
+				Value:     value,
+				Timestamp: proto.Uint64(uint64(ts)),
+			}}},
+		}}
+	})
+
+	tests := []struct {
+		skew    time.Duration
+		warning bool
+	}{
+		{skew: 0, warning: false},
+		{skew: 30 * time.Second, warning: false},
+		{skew: time.Hour, warning: true},
+		{skew: -time.Hour, warning: true},
+	}
+	for _, tcase := range tests {
+		skew = tcase.skew
+		before := testutil.ToFloat64(clockSkewExceeded)
+		inc, err := hrpc.NewIncStrSingle(context.Background(), "test1", "row", "cf", "a", 1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := c.Increment(inc); err != nil {
+			t.Fatal(err)
+		}
+		warned := testutil.ToFloat64(clockSkewExceeded) > before
+		if warned != tcase.warning {
+			t.Errorf("expected warning=%v for skew %v, got %v", tcase.warning, tcase.skew, warned)
+		}
+	}
+}
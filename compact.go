@@ -0,0 +1,111 @@
+// Copyright (C) 2026  The GoHBase Authors.  All rights reserved.
+// This file is part of GoHBase.
+// Use of this source code is governed by the Apache License 2.0
+// that can be found in the COPYING file.
+
+package gohbase
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net"
+
+	"github.com/baiweiguo/gohbase/hrpc"
+	"github.com/baiweiguo/gohbase/region"
+)
+
+func (c *client) CompactTable(ctx context.Context, table string, major bool) error {
+	prefix := []byte(table + ",")
+	found, err := c.compact(ctx, func(name []byte) bool {
+		return bytes.HasPrefix(name, prefix)
+	}, major)
+	if err == nil && !found {
+		err = fmt.Errorf("%w: no region of table %q is online", TableNotFound, table)
+	}
+	return err
+}
+
+func (c *client) CompactRegion(ctx context.Context, regionName []byte, major bool) error {
+	found, err := c.compact(ctx, func(name []byte) bool {
+		return bytes.Equal(name, regionName)
+	}, major)
+	if err == nil && !found {
+		err = fmt.Errorf("%w: region %q isn't online", ErrCannotFindRegion, regionName)
+	}
+	return err
+}
+
+// compact compacts the online regions whose names match match
+// and returns whether there was any.
+func (c *client) compact(ctx context.Context, match func(name []byte) bool,
+	major bool) (bool, error) {
+	servers, err := c.regionServers(match)
+	if err != nil {
+		return false, err
+	}
+	for addr, names := range servers {
+		if err := c.compactRegions(ctx, addr, names, major); err != nil {
+			return false, err
+		}
+	}
+	return len(servers) > 0, nil
+}
+
+// regionServers returns the names of the online regions matching match
+// keyed by the address of their RegionServer, as found in the cluster status.
+func (c *client) regionServers(match func(name []byte) bool) (map[string][][]byte, error) {
+	status, err := c.ClusterStatus()
+	if err != nil {
+		return nil, err
+	}
+	servers := make(map[string][][]byte)
+	for _, s := range status.GetLiveServers() {
+		addr := net.JoinHostPort(s.GetServer().GetHostName(), fmt.Sprint(s.GetServer().GetPort()))
+		for _, l := range s.GetServerLoad().GetRegionLoads() {
+			if name := l.GetRegionSpecifier().GetValue(); match(name) {
+				servers[addr] = append(servers[addr], name)
+			}
+		}
+	}
+	return servers, nil
+}
+
+// compactRegions asks the RegionServer at addr to compact the regions with
+// the given names over a dedicated connection to its AdminService.
+func (c *client) compactRegions(ctx context.Context, addr string, regionNames [][]byte,
+	major bool) error {
+	rc, err := c.dialRegionServer(ctx, addr, region.RegionAdminClient)
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
+
+	for _, name := range regionNames {
+		res, err := sendBlocking(ctx, rc, hrpc.NewCompactRegion(ctx, name, major))
+		if err != nil {
+			return err
+		}
+		if res.Error != nil {
+			return fmt.Errorf("failed to compact region %q: %w", name, res.Error)
+		}
+	}
+	return nil
+}
+
+// dialRegionServer returns a dedicated connection to the service ctype of
+// the RegionServer at addr, which the caller must close.
+func (c *client) dialRegionServer(ctx context.Context, addr string,
+	ctype region.ClientType) (hrpc.RegionClient, error) {
+	addr = c.rewriteAddr(addr)
+	rc := c.newRegionClientFn(addr, ctype, c.rpcQueueSize,
+		c.flushInterval, c.effectiveUser, c.regionReadTimeout, nil, c.regionClientOptions...)
+	dialCtx, cancel := context.WithTimeout(ctx, c.regionLookupTimeout)
+	err := c.dial(dialCtx, rc)
+	cancel()
+	if err != nil {
+		rc.Close()
+		return nil, fmt.Errorf("failed to connect to RegionServer %s: %w", addr, err)
+	}
+	return rc, nil
+}
@@ -0,0 +1,142 @@
+// Copyright (C) 2026  The GoHBase Authors.  All rights reserved.
+// This file is part of GoHBase.
+// Use of this source code is governed by the Apache License 2.0
+// that can be found in the COPYING file.
+
+package gohbase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/baiweiguo/gohbase/compression"
+	"github.com/baiweiguo/gohbase/hrpc"
+	"github.com/baiweiguo/gohbase/pb"
+	"github.com/baiweiguo/gohbase/region"
+	"github.com/baiweiguo/gohbase/test"
+	mockRegion "github.com/baiweiguo/gohbase/test/mock/region"
+	"github.com/golang/mock/gomock"
+	"google.golang.org/protobuf/proto"
+)
+
+// compactionServer records the compactions requested
+// to the AdminService of RegionServers.
+type compactionServer struct {
+	m           sync.Mutex
+	compactions []string
+}
+
+type compactionRegionClient struct {
+	addr  string
+	ctype region.ClientType
+	srv   *compactionServer
+}
+
+func (c *compactionRegionClient) Dial(context.Context) error { return nil }
+func (c *compactionRegionClient) Close()                     {}
+func (c *compactionRegionClient) Addr() string               { return c.addr }
+func (c *compactionRegionClient) String() string             { return c.addr }
+
+func (c *compactionRegionClient) QueueRPC(call hrpc.Call) {
+	req, ok := call.ToProto().(*pb.CompactRegionRequest)
+	if !ok || c.ctype != region.RegionAdminClient {
+		call.ResultChan() <- hrpc.RPCResult{
+			Error: fmt.Errorf("unexpected %s over %s", call.Name(), c.ctype)}
+		return
+	}
+	c.srv.m.Lock()
+	c.srv.compactions = append(c.srv.compactions,
+		fmt.Sprintf("%s %s major=%v", c.addr, req.Region.Value, req.GetMajor()))
+	c.srv.m.Unlock()
+	call.ResultChan() <- hrpc.RPCResult{Msg: &pb.CompactRegionResponse{}}
+}
+
+func (c *compactionRegionClient) QueueBatch(ctx context.Context, calls []hrpc.Call) {
+	for _, call := range calls {
+		c.QueueRPC(call)
+	}
+}
+
+func (s *compactionServer) requested() []string {
+	s.m.Lock()
+	defer s.m.Unlock()
+	compactions := s.compactions
+	s.compactions = nil
+	sort.Strings(compactions)
+	return compactions
+}
+
+func TestCompact(t *testing.T) {
+	ctrl := test.NewController(t)
+	defer ctrl.Finish()
+	srv := &compactionServer{}
+	c := newMockClient(nil)
+	c.clientType = region.MasterClient
+	c.adminRegionInfo = region.NewInfo(0, nil, nil, nil, nil, nil)
+	c.newRegionClientFn = func(addr string, ctype region.ClientType, _ int, _ time.Duration,
+		_ string, _ time.Duration, _ compression.Codec,
+		_ ...region.ClientOption) hrpc.RegionClient {
+		return &compactionRegionClient{addr: addr, ctype: ctype, srv: srv}
+	}
+
+	server := func(host string, regions ...string) *pb.LiveServerInfo {
+		s := liveServer(host, 16020, 0, 0)
+		for _, name := range regions {
+			s.ServerLoad.RegionLoads = append(s.ServerLoad.RegionLoads, &pb.RegionLoad{
+				RegionSpecifier: &pb.RegionSpecifier{
+					Type:  pb.RegionSpecifier_REGION_NAME.Enum(),
+					Value: []byte(name),
+				},
+			})
+		}
+		return s
+	}
+	status := &pb.ClusterStatus{LiveServers: []*pb.LiveServerInfo{
+		server("host1", "test,,1.a.", "test,m,1.b.", "other,,1.c."),
+		server("host2", "test,f,1.d.", "test2,,1.e."),
+	}}
+	master := mockRegion.NewMockRegionClient(ctrl)
+	master.EXPECT().QueueRPC(gomock.Any()).AnyTimes().Do(func(rpc hrpc.Call) {
+		rpc.ResultChan() <- hrpc.RPCResult{Msg: &pb.GetClusterStatusResponse{
+			ClusterStatus: proto.Clone(status).(*pb.ClusterStatus)}}
+	})
+	c.adminRegionInfo.SetClient(master)
+
+	ctx := context.Background()
+	if err := c.CompactTable(ctx, "test", true); err != nil {
+		t.Fatal(err)
+	}
+	exp := []string{
+		"host1:16020 test,,1.a. major=true",
+		"host1:16020 test,m,1.b. major=true",
+		"host2:16020 test,f,1.d. major=true",
+	}
+	if got := srv.requested(); !reflect.DeepEqual(exp, got) {
+		t.Errorf("expected compactions %q, got %q", exp, got)
+	}
+
+	if err := c.CompactRegion(ctx, []byte("test,f,1.d."), false); err != nil {
+		t.Fatal(err)
+	}
+	exp = []string{"host2:16020 test,f,1.d. major=false"}
+	if got := srv.requested(); !reflect.DeepEqual(exp, got) {
+		t.Errorf("expected compactions %q, got %q", exp, got)
+	}
+
+	if err := c.CompactTable(ctx, "tes", false); !errors.Is(err, TableNotFound) {
+		t.Errorf("expected an error matching %v, got %v", TableNotFound, err)
+	}
+	err := c.CompactRegion(ctx, []byte("test,z,1.f."), false)
+	if !errors.Is(err, ErrCannotFindRegion) {
+		t.Errorf("expected an error matching %v, got %v", ErrCannotFindRegion, err)
+	}
+	if got := srv.requested(); len(got) != 0 {
+		t.Errorf("expected no compactions, got %q", got)
+	}
+}
@@ -0,0 +1,137 @@
+// Copyright (C) 2026  The GoHBase Authors.  All rights reserved.
+// This file is part of GoHBase.
+// Use of this source code is governed by the Apache License 2.0
+// that can be found in the COPYING file.
+
+package gohbase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"sort"
+	"sync"
+
+	"github.com/baiweiguo/gohbase/hrpc"
+	"github.com/baiweiguo/gohbase/pb"
+	"github.com/baiweiguo/gohbase/region"
+)
+
+// RegionServerCoprocessorServiceAll looks up the live RegionServers in the
+// cluster status first, and invokes the method in all of them at once.
+func (c *client) RegionServerCoprocessorServiceAll(ctx context.Context,
+	service, method string, request []byte) (map[string]hrpc.CoprocessorResult, error) {
+	// check the arguments before looking up RegionServers
+	if _, err := hrpc.NewRegionServerCoprocessorService(ctx, service, method,
+		request); err != nil {
+		return nil, err
+	}
+	addrs, err := c.liveServers()
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		m       sync.Mutex
+		wg      sync.WaitGroup
+		results = make(map[string]hrpc.CoprocessorResult, len(addrs))
+	)
+	for _, addr := range addrs {
+		wg.Add(1)
+		go func(addr string) {
+			defer wg.Done()
+			var res hrpc.CoprocessorResult
+			res.Value, res.Err = c.execRegionServerService(ctx, addr,
+				service, method, request)
+			m.Lock()
+			results[addr] = res
+			m.Unlock()
+		}(addr)
+	}
+	wg.Wait()
+	return results, nil
+}
+
+func (c *client) RegionServerMetrics(ctx context.Context,
+	decode hrpc.MetricsDecoder) (*hrpc.CoprocessorMetrics, error) {
+	if decode == nil {
+		return nil, errors.New("'RegionServerMetrics' needs a MetricsDecoder")
+	}
+	results, err := c.RegionServerCoprocessorServiceAll(ctx,
+		hrpc.MetricsService, hrpc.MetricsMethod, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	addrs := make([]string, 0, len(results))
+	for addr := range results {
+		addrs = append(addrs, addr)
+	}
+	sort.Strings(addrs)
+	metrics := &hrpc.CoprocessorMetrics{
+		Total:   make(map[string]float64),
+		Servers: make(map[string]map[string]float64, len(results)),
+	}
+	for _, addr := range addrs {
+		res := results[addr]
+		if res.Err != nil {
+			return nil, fmt.Errorf("failed to get the metrics of RegionServer %s: %w",
+				addr, res.Err)
+		}
+		values, err := decode(res.Value)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode the metrics of RegionServer %s: %w",
+				addr, err)
+		}
+		metrics.Servers[addr] = values
+		for name, v := range values {
+			metrics.Total[name] += v
+		}
+	}
+	return metrics, nil
+}
+
+// liveServers returns the addresses of the live RegionServers
+// found in the cluster status.
+func (c *client) liveServers() ([]string, error) {
+	status, err := c.ClusterStatus()
+	if err != nil {
+		return nil, err
+	}
+	addrs := make([]string, 0, len(status.GetLiveServers()))
+	for _, s := range status.GetLiveServers() {
+		addrs = append(addrs, net.JoinHostPort(s.GetServer().GetHostName(),
+			fmt.Sprint(s.GetServer().GetPort())))
+	}
+	return addrs, nil
+}
+
+// execRegionServerService invokes method of the coprocessor service loaded in
+// the RegionServer at addr and returns the serialized response of the method.
+func (c *client) execRegionServerService(ctx context.Context, addr string,
+	service, method string, request []byte) ([]byte, error) {
+	rc, err := c.dialRegionServer(ctx, addr, region.RegionAdminClient)
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+
+	cs, err := hrpc.NewRegionServerCoprocessorService(ctx, service, method, request)
+	if err != nil {
+		return nil, err
+	}
+	res, err := sendBlocking(ctx, rc, cs)
+	if err != nil {
+		return nil, err
+	}
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	r, ok := res.Msg.(*pb.CoprocessorServiceResponse)
+	if !ok {
+		return nil, fmt.Errorf("sendRPC returned a %T instead of CoprocessorServiceResponse",
+			res.Msg)
+	}
+	return r.GetValue().GetValue(), nil
+}
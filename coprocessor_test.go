@@ -0,0 +1,150 @@
+// Copyright (C) 2026  The GoHBase Authors.  All rights reserved.
+// This file is part of GoHBase.
+// Use of this source code is governed by the Apache License 2.0
+// that can be found in the COPYING file.
+
+package gohbase
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/baiweiguo/gohbase/compression"
+	"github.com/baiweiguo/gohbase/hrpc"
+	"github.com/baiweiguo/gohbase/pb"
+	"github.com/baiweiguo/gohbase/region"
+	"github.com/baiweiguo/gohbase/test"
+	mockRegion "github.com/baiweiguo/gohbase/test/mock/region"
+	"github.com/golang/mock/gomock"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// metricsRegionClient serves the metrics endpoint of a RegionServer,
+// encoding its metrics in a protobuf Struct.
+type metricsRegionClient struct {
+	addr    string
+	ctype   region.ClientType
+	metrics map[string]interface{}
+}
+
+func (c *metricsRegionClient) Dial(context.Context) error { return nil }
+func (c *metricsRegionClient) Close()                     {}
+func (c *metricsRegionClient) Addr() string               { return c.addr }
+func (c *metricsRegionClient) String() string             { return c.addr }
+
+func (c *metricsRegionClient) QueueRPC(call hrpc.Call) {
+	req, ok := call.ToProto().(*pb.CoprocessorServiceRequest)
+	if !ok || c.ctype != region.RegionAdminClient || call.Name() != "ExecRegionServerService" ||
+		req.Call.GetServiceName() != hrpc.MetricsService ||
+		req.Call.GetMethodName() != hrpc.MetricsMethod {
+		call.ResultChan() <- hrpc.RPCResult{
+			Error: fmt.Errorf("unexpected %s over %s", call.Name(), c.ctype)}
+		return
+	}
+	if c.metrics == nil {
+		call.ResultChan() <- hrpc.RPCResult{Error: region.RetryableError{}}
+		return
+	}
+	s, err := structpb.NewStruct(c.metrics)
+	if err == nil {
+		var b []byte
+		b, err = proto.Marshal(s)
+		call.ResultChan() <- hrpc.RPCResult{Msg: &pb.CoprocessorServiceResponse{
+			Value: &pb.NameBytesPair{Name: proto.String(""), Value: b}}}
+		return
+	}
+	call.ResultChan() <- hrpc.RPCResult{Error: err}
+}
+
+func (c *metricsRegionClient) QueueBatch(ctx context.Context, calls []hrpc.Call) {
+	for _, call := range calls {
+		c.QueueRPC(call)
+	}
+}
+
+// decodeStructMetrics decodes the metrics encoded by metricsRegionClient.
+func decodeStructMetrics(response []byte) (map[string]float64, error) {
+	var s structpb.Struct
+	if err := proto.Unmarshal(response, &s); err != nil {
+		return nil, err
+	}
+	values := make(map[string]float64, len(s.Fields))
+	for name, v := range s.Fields {
+		n, ok := v.Kind.(*structpb.Value_NumberValue)
+		if !ok {
+			return nil, fmt.Errorf("metric %q isn't a number", name)
+		}
+		values[name] = n.NumberValue
+	}
+	return values, nil
+}
+
+func TestRegionServerMetrics(t *testing.T) {
+	ctrl := test.NewController(t)
+	defer ctrl.Finish()
+	metrics := map[string]map[string]interface{}{
+		"host1:16020": {"requests": 10, "cacheHits": 7},
+		"host2:16020": {"requests": 5, "queued": 2},
+		"host3:16020": {"requests": 1},
+	}
+	c := newMockClient(nil)
+	c.clientType = region.MasterClient
+	c.adminRegionInfo = region.NewInfo(0, nil, nil, nil, nil, nil)
+	c.newRegionClientFn = func(addr string, ctype region.ClientType, _ int, _ time.Duration,
+		_ string, _ time.Duration, _ compression.Codec,
+		_ ...region.ClientOption) hrpc.RegionClient {
+		return &metricsRegionClient{addr: addr, ctype: ctype, metrics: metrics[addr]}
+	}
+
+	status := &pb.ClusterStatus{LiveServers: []*pb.LiveServerInfo{
+		liveServer("host1", 16020, 0, 0),
+		liveServer("host2", 16020, 0, 0),
+		liveServer("host3", 16020, 0, 0),
+	}}
+	master := mockRegion.NewMockRegionClient(ctrl)
+	master.EXPECT().QueueRPC(gomock.Any()).AnyTimes().Do(func(rpc hrpc.Call) {
+		rpc.ResultChan() <- hrpc.RPCResult{Msg: &pb.GetClusterStatusResponse{
+			ClusterStatus: proto.Clone(status).(*pb.ClusterStatus)}}
+	})
+	c.adminRegionInfo.SetClient(master)
+
+	ctx := context.Background()
+	got, err := c.RegionServerMetrics(ctx, decodeStructMetrics)
+	if err != nil {
+		t.Fatal(err)
+	}
+	exp := &hrpc.CoprocessorMetrics{
+		Total: map[string]float64{"requests": 16, "cacheHits": 7, "queued": 2},
+		Servers: map[string]map[string]float64{
+			"host1:16020": {"requests": 10, "cacheHits": 7},
+			"host2:16020": {"requests": 5, "queued": 2},
+			"host3:16020": {"requests": 1},
+		},
+	}
+	if !reflect.DeepEqual(exp, got) {
+		t.Errorf("expected metrics %v, got %v", exp, got)
+	}
+
+	// the metrics can't be aggregated if a RegionServer fails to report them
+	metrics["host2:16020"] = nil
+	if _, err := c.RegionServerMetrics(ctx, decodeStructMetrics); err == nil {
+		t.Error("expected an error")
+	}
+	res, err := c.RegionServerCoprocessorServiceAll(ctx,
+		hrpc.MetricsService, hrpc.MetricsMethod, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res) != 3 {
+		t.Fatalf("expected results of 3 RegionServers, got %v", res)
+	}
+	for addr, r := range res {
+		if failed := addr == "host2:16020"; failed != (r.Err != nil) {
+			t.Errorf("expected RegionServer %s to fail: %v, got error %v", addr, failed, r.Err)
+		}
+	}
+}
@@ -0,0 +1,76 @@
+// Copyright (C) 2026  The GoHBase Authors.  All rights reserved.
+// This file is part of GoHBase.
+// Use of this source code is governed by the Apache License 2.0
+// that can be found in the COPYING file.
+
+package gohbase_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/baiweiguo/gohbase"
+	"github.com/baiweiguo/gohbase/hrpc"
+	"github.com/baiweiguo/gohbase/pb"
+	"github.com/baiweiguo/gohbase/region"
+)
+
+// fakeResolver serves every key of every table from a single region.
+type fakeResolver struct{}
+
+func (fakeResolver) ResolveRegion(ctx context.Context,
+	table, key []byte) (hrpc.RegionInfo, string, error) {
+	name := []byte(string(table) + ",,1.0123456789abcdef0123456789abcdef.")
+	return region.NewInfo(0, nil, table, name, nil, nil), "fake:16020", nil
+}
+
+// fakeRegionClient answers every Get with a single cell.
+type fakeRegionClient struct {
+	addr string
+}
+
+func (c *fakeRegionClient) Dial(context.Context) error { return nil }
+func (c *fakeRegionClient) Close()                     {}
+func (c *fakeRegionClient) Addr() string               { return c.addr }
+func (c *fakeRegionClient) String() string             { return "fakeRegionClient" }
+
+func (c *fakeRegionClient) QueueRPC(call hrpc.Call) {
+	get, ok := call.(*hrpc.Get)
+	if !ok {
+		call.ResultChan() <- hrpc.RPCResult{Error: fmt.Errorf("unexpected %s", call.Name())}
+		return
+	}
+	call.ResultChan() <- hrpc.RPCResult{Msg: &pb.GetResponse{Result: &pb.Result{
+		Cell: []*pb.Cell{{
+			Row:       get.Key(),
+			Family:    []byte("cf"),
+			Qualifier: []byte("q"),
+			Value:     []byte("value"),
+		}},
+	}}}
+}
+
+func (c *fakeRegionClient) QueueBatch(ctx context.Context, calls []hrpc.Call) {
+	for _, call := range calls {
+		c.QueueRPC(call)
+	}
+}
+
+func ExampleNewClientWithResolver() {
+	client := gohbase.NewClientWithResolver(fakeResolver{},
+		func(addr string) hrpc.RegionClient { return &fakeRegionClient{addr: addr} })
+	defer client.Close()
+
+	get, err := hrpc.NewGetStr(context.Background(), "table", "row")
+	if err != nil {
+		panic(err)
+	}
+	res, err := client.Get(get)
+	if err != nil {
+		panic(err)
+	}
+	for _, cell := range res.Cells {
+		fmt.Printf("%s %s:%s %s\n", cell.Row, cell.Family, cell.Qualifier, cell.Value)
+	}
+	// Output: row cf:q value
+}
@@ -617,10 +617,15 @@ func (f *RowFilter) ConstructPBFilter() (*pb.Filter, error) {
 	return filter, nil
 }
 
-// SingleColumnValueFilter is TODO
+// SingleColumnValueFilter returns the rows whose value of a column
+// matches a condition, e.g. to filter rows by the value of a column server-side.
 type SingleColumnValueFilter pb.SingleColumnValueFilter
 
-// NewSingleColumnValueFilter is TODO
+// NewSingleColumnValueFilter creates a filter returning the rows where the
+// value of the given column compared with comparatorObj with compareOp is true.
+// If filterIfMissing is true, rows without the column are filtered out,
+// otherwise they're returned. If latestVersionOnly is false, the row is returned
+// if any version of the column matches, not only the latest one.
 func NewSingleColumnValueFilter(columnFamily, columnQualifier []byte, compareOp CompareType,
 	comparatorObj Comparator, filterIfMissing, latestVersionOnly bool) *SingleColumnValueFilter {
 	obj, err := comparatorObj.ConstructPBComparator()
@@ -659,10 +664,13 @@ func (f *SingleColumnValueFilter) ConstructPBFilter() (*pb.Filter, error) {
 	return filter, nil
 }
 
-// SingleColumnValueExcludeFilter is TODO
+// SingleColumnValueExcludeFilter is a SingleColumnValueFilter which also
+// drops the tested column from the rows it returns.
 type SingleColumnValueExcludeFilter pb.SingleColumnValueExcludeFilter
 
-// NewSingleColumnValueExcludeFilter is TODO
+// NewSingleColumnValueExcludeFilter creates a filter returning the rows
+// matching filter without its column. The filterIfMissing and latestVersionOnly
+// flags of filter apply the same way.
 func NewSingleColumnValueExcludeFilter(
 	filter *SingleColumnValueFilter) *SingleColumnValueExcludeFilter {
 	return &SingleColumnValueExcludeFilter{
@@ -825,7 +833,9 @@ func (f *RowRange) ConstructPBFilter() (*pb.Filter, error) {
 	return filter, nil
 }
 
-// MultiRowRangeFilter is TODO
+// MultiRowRangeFilter returns the rows within any of several row ranges,
+// e.g. to scan disjoint key ranges at once. Scanners with this filter
+// skip the regions outside of all the ranges.
 type MultiRowRangeFilter pb.MultiRowRangeFilter
 
 // NewMultiRowRangeFilter is TODO
@@ -0,0 +1,73 @@
+// Copyright (C) 2026  The GoHBase Authors.  All rights reserved.
+// This file is part of GoHBase.
+// Use of this source code is governed by the Apache License 2.0
+// that can be found in the COPYING file.
+
+package gohbase
+
+import (
+	"errors"
+
+	"github.com/baiweiguo/gohbase/hrpc"
+)
+
+// StreamGet fetches the row of g in chunks of at most chunkSize cells per column
+// family and calls fn with the cells of each chunk, so that very large rows don't
+// have to be held in memory at once. The chunks are fetched by sending g repeatedly
+// with ResultOffset and MaxResultsPerColumnFamily options overwritten, therefore
+// g is modified and shouldn't have these options set. Cells passed to fn aren't
+// retained afterwards. If fn returns an error, StreamGet stops and returns it.
+// StreamGet is done once a chunk isn't Partial if HBase flags chunks as such,
+// or is short otherwise.
+func StreamGet(c Client, g *hrpc.Get, chunkSize uint32,
+	fn func(cells []*hrpc.Cell) error) error {
+	if chunkSize == 0 {
+		return errors.New("chunk size must be positive")
+	}
+	if err := hrpc.MaxResultsPerColumnFamily(chunkSize)(g); err != nil {
+		return err
+	}
+	var (
+		offset uint32
+		// flagged is set once HBase flagged a chunk as partial,
+		// after which the flag tells whether the row is exhausted
+		flagged bool
+	)
+	for {
+		if err := hrpc.ResultOffset(offset)(g); err != nil {
+			return err
+		}
+		res, err := c.Get(g)
+		if err != nil {
+			return err
+		}
+		if len(res.Cells) == 0 {
+			return nil
+		}
+		if err := fn(res.Cells); err != nil {
+			return err
+		}
+
+		if res.Partial {
+			flagged = true
+			offset += chunkSize
+			continue
+		} else if flagged {
+			return nil
+		}
+
+		// the row is exhausted once every column family returned less than the limit
+		perFamily := make(map[string]uint32)
+		more := false
+		for _, cell := range res.Cells {
+			perFamily[string(cell.Family)]++
+			if perFamily[string(cell.Family)] == chunkSize {
+				more = true
+			}
+		}
+		if !more {
+			return nil
+		}
+		offset += chunkSize
+	}
+}
@@ -0,0 +1,109 @@
+// Copyright (C) 2026  The GoHBase Authors.  All rights reserved.
+// This file is part of GoHBase.
+// Use of this source code is governed by the Apache License 2.0
+// that can be found in the COPYING file.
+
+package gohbase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/baiweiguo/gohbase/hrpc"
+	"github.com/baiweiguo/gohbase/testserver"
+)
+
+func TestStreamGet(t *testing.T) {
+	srv := testserver.New()
+	defer srv.Close()
+	srv.CreateTable("test")
+	c := NewClient("", ZookeeperClient(srv), Dialer(srv.Dial))
+	defer c.Close()
+
+	const columns = 100000
+	values := make(map[string][]byte, columns)
+	for i := 0; i < columns; i++ {
+		values[fmt.Sprintf("q%06d", i)] = []byte("v")
+	}
+	put, err := hrpc.NewPutStr(context.Background(), "test", "row",
+		map[string]map[string][]byte{"cf": values})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = c.Put(put); err != nil {
+		t.Fatal(err)
+	}
+
+	get, err := hrpc.NewGetStr(context.Background(), "test", "row")
+	if err != nil {
+		t.Fatal(err)
+	}
+	const chunkSize = 10000
+	var chunks, cells int
+	err = StreamGet(c, get, chunkSize, func(chunk []*hrpc.Cell) error {
+		if len(chunk) > chunkSize {
+			t.Fatalf("expected at most %d cells in a chunk, got %d", chunkSize, len(chunk))
+		}
+		for _, cell := range chunk {
+			if q := fmt.Sprintf("q%06d", cells); string(cell.Qualifier) != q {
+				t.Fatalf("expected qualifier %q, got %q", q, cell.Qualifier)
+			}
+			cells++
+		}
+		chunks++
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cells != columns {
+		t.Errorf("expected %d cells, got %d", columns, cells)
+	}
+	if chunks != columns/chunkSize {
+		t.Errorf("expected %d chunks, got %d", columns/chunkSize, chunks)
+	}
+
+	// stop streaming on error
+	stop := errors.New("stop")
+	chunks = 0
+	err = StreamGet(c, get, chunkSize, func(chunk []*hrpc.Cell) error {
+		chunks++
+		return stop
+	})
+	if err != stop {
+		t.Errorf("expected error %v, got %v", stop, err)
+	}
+	if chunks != 1 {
+		t.Errorf("expected 1 chunk, got %d", chunks)
+	}
+
+	// rows of HBase flagging partial results are done with the last chunk
+	cc := &countingClient{Client: c}
+	chunks = 0
+	if err := StreamGet(cc, get, chunkSize, func([]*hrpc.Cell) error {
+		chunks++
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if cc.gets != chunks {
+		t.Errorf("expected %d gets for %d chunks, got %d", chunks, chunks, cc.gets)
+	}
+
+	if err = StreamGet(c, get, 0, nil); err == nil {
+		t.Error("expected an error for zero chunk size")
+	}
+}
+
+// countingClient counts the Gets sent with the client.
+type countingClient struct {
+	Client
+	gets int
+}
+
+func (c *countingClient) Get(g *hrpc.Get) (*hrpc.Result, error) {
+	c.gets++
+	return c.Client.Get(g)
+}
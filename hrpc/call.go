@@ -11,6 +11,9 @@ import (
 	"encoding/binary"
 	"errors"
 	"fmt"
+	"math"
+	"sort"
+	"time"
 	"unsafe"
 
 	"github.com/baiweiguo/gohbase/pb"
@@ -20,6 +23,14 @@ import (
 // RegionInfo represents HBase region.
 type RegionInfo interface {
 	IsUnavailable() bool
+	// AvailabilityChan returns a channel that is closed once the region becomes
+	// available again, or nil if the region is available. A new channel is created
+	// every time the region is marked unavailable and it's never reused, so it can
+	// be selected on along with other channels, such as the Done channel of
+	// a context, to wait for the region without holding any lock. As a nil channel
+	// blocks forever, it has to be checked for nil before selecting on it.
+	// Once it's closed, either the region has a client or it's dead, which
+	// its Context tells, and has to be looked up again.
 	AvailabilityChan() <-chan struct{}
 	MarkUnavailable() bool
 	MarkAvailable()
@@ -34,6 +45,10 @@ type RegionInfo interface {
 	Table() []byte
 	SetClient(RegionClient)
 	Client() RegionClient
+	// Replicas returns the addresses of the RegionServers of the replicas of
+	// the region indexed by replica ID, replica 0 being the primary region.
+	// Replicas that aren't assigned have empty addresses.
+	Replicas() []string
 }
 
 // RegionClient represents HBase region client.
@@ -48,6 +63,69 @@ type RegionClient interface {
 	String() string
 }
 
+// ConnStats is a snapshot of statistics of a connection to a RegionServer.
+type ConnStats struct {
+	// BytesSent is the number of bytes of requests written to the connection.
+	BytesSent uint64
+	// BytesReceived is the number of bytes of responses read from the connection.
+	BytesReceived uint64
+	// RPCsCompleted is the number of RPCs that got a response without an error.
+	RPCsCompleted uint64
+	// RPCsFailed is the number of sent RPCs that got an exception in response
+	// or were failed because the connection was closed.
+	RPCsFailed uint64
+}
+
+// ClientStats is a snapshot of statistics of a client over its lifetime.
+type ClientStats struct {
+	// RegionLookups is the number of times regions were looked up,
+	// in hbase:meta or with the resolver of the client.
+	RegionLookups uint64
+	// CacheHits is the number of times the region of an RPC was found in cache.
+	CacheHits uint64
+	// Reconnects is the number of times regions were reestablished
+	// after the connection to their RegionServer was lost.
+	Reconnects uint64
+	// Retries is the number of times RPCs were retried.
+	Retries uint64
+	// DeadlinesExceeded is the number of RPCs that failed
+	// because their deadline was exceeded.
+	DeadlinesExceeded uint64
+	// ScanMemoryInUse is the number of bytes currently reserved
+	// by the batches of results of scanners, see ScanMemoryLimit.
+	ScanMemoryInUse uint64
+}
+
+// TableRegionsEvent is a change of the regions of a table.
+type TableRegionsEvent struct {
+	// Regions are the regions of the table in the order of their keys.
+	Regions []RegionInfo
+	// Added are the regions that appeared since the previous event, and
+	// Removed the ones that disappeared, e.g. the daughters and the parent
+	// of a split region.
+	Added, Removed []RegionInfo
+}
+
+// RegionLocation is a region and the address of the RegionServer hosting it,
+// e.g. shared between clients to load their caches without looking regions up.
+type RegionLocation struct {
+	// Region is the region, created with region.NewInfo and not used by
+	// any client yet.
+	Region RegionInfo
+	// Addr is the address of the RegionServer in "host:port" form.
+	Addr string
+}
+
+// PrewarmSummary is the outcome of prewarming the regions of a table.
+type PrewarmSummary struct {
+	// Regions is the number of regions of the table that were looked up.
+	Regions int
+	// RegionServers is the number of RegionServers connected to.
+	RegionServers int
+	// Failed are the errors connecting to RegionServers keyed by their address.
+	Failed map[string]error
+}
+
 // Call represents an HBase RPC call.
 type Call interface {
 	Table() []byte
@@ -91,17 +169,158 @@ func SkipBatch() func(Call) error {
 	}
 }
 
+// BatchOptions are the options of a batch sent with SendBatchWithOptions.
+type BatchOptions struct {
+	// FailFast is set by FailFast option.
+	FailFast bool
+}
+
+// BatchOption is an option of a batch sent with SendBatchWithOptions.
+type BatchOption func(*BatchOptions)
+
+// FailFast is an option for batches making SendBatchWithOptions return as soon
+// as a call fails rather than waiting for the results of all calls, for
+// all-or-nothing semantics. The calls that haven't completed yet are given up on and their
+// results are context.Canceled.
+func FailFast() BatchOption {
+	return func(o *BatchOptions) {
+		o.FailFast = true
+	}
+}
+
+// ReadCall interface is implemented by calls reading rows (Get and Scan),
+// e.g. to apply settings of the client specific to reads.
+type ReadCall interface {
+	Call
+	readsRows()
+}
+
+// WriteCall interface is implemented by calls writing rows (Mutate, and
+// CheckAndPut and CheckAndMutate), e.g. to apply settings of the client
+// specific to writes.
+type WriteCall interface {
+	Call
+	writesRows()
+}
+
+// NewAttempt returns a copy of c to send as another attempt at sending c while
+// the previous ones may still be processed, e.g. because they're taking too
+// long, or false if c can't be sent that way. Only Gets, and increments and
+// appends with a nonce HBase recognizes their retries by, can, since their
+// outcome is the same whichever attempts are processed. The copy has a result
+// channel of its own, so that the results of attempts given up on are neither
+// mistaken for the result of another attempt nor wait to be read.
+func NewAttempt(c Call) (Call, bool) {
+	switch c := c.(type) {
+	case *Get:
+		a := *c
+		a.resultch = make(chan RPCResult, 1)
+		return &a, true
+	case *Mutate:
+		if c.Nonce() == 0 {
+			return nil, false
+		}
+		a := *c
+		a.resultch = make(chan RPCResult, 1)
+		return &a, true
+	}
+	return nil, false
+}
+
+// Targetable interface is implemented by calls that can be sent to a specific
+// RegionServer with TargetRegionServer option (Get and Mutate).
+type Targetable interface {
+	// TargetRegionServer returns the address of the RegionServer the call has
+	// to be sent to, or an empty string if it's sent to the one serving its region.
+	TargetRegionServer() string
+
+	setTargetRegionServer(addr string)
+}
+
+// TargetRegionServer is an option for Get and Mutate requests to send the request
+// to the RegionServer at addr ("host:port") instead of the one serving the region
+// of the request according to hbase:meta, e.g. for debugging and repair tools.
+// The request is sent over a dedicated connection that is closed afterwards, and
+// it isn't retried nor batched. Neither the connection nor the region of the
+// request are cached by the client.
+func TargetRegionServer(addr string) func(Call) error {
+	return func(c Call) error {
+		t, ok := c.(Targetable)
+		if !ok {
+			return errors.New(
+				"'TargetRegionServer' option only works with Get and Mutate requests")
+		}
+		t.setTargetRegionServer(addr)
+		t.(Batchable).setSkipBatch(true)
+		return nil
+	}
+}
+
+// hasAttributes is implemented by calls that carry operation attributes
+// (Get, Scan and Mutate).
+type hasAttributes interface {
+	setAttribute(key string, value []byte)
+}
+
+// Attribute is an option for Get, Scan and Mutate requests that sets the
+// operation attribute key of the request to value. Operation attributes are
+// sent to RegionServers along with the request, where they can be read by
+// coprocessors. Setting the same key again replaces its value.
+func Attribute(key string, value []byte) func(Call) error {
+	return func(c Call) error {
+		a, ok := c.(hasAttributes)
+		if !ok {
+			return errors.New("'Attribute' option only works with Get, Scan and Mutate requests")
+		}
+		a.setAttribute(key, value)
+		return nil
+	}
+}
+
+// hasMetricLabel is implemented by calls whose metrics can be labeled.
+type hasMetricLabel interface {
+	setMetricLabel(label string)
+}
+
+// MetricLabel is an option for requests that labels their metrics, such as
+// their latency and errors, with a logical name of the operation they're part
+// of, e.g. "user-profile-read", so that they can be grouped by it. Labels
+// should be few, as each one adds time series to the metrics.
+func MetricLabel(label string) func(Call) error {
+	return func(c Call) error {
+		l, ok := c.(hasMetricLabel)
+		if !ok {
+			return errors.New("'MetricLabel' option doesn't work with this request")
+		}
+		l.setMetricLabel(label)
+		return nil
+	}
+}
+
+// setAttribute sets the attribute key to value in attrs and returns them.
+func setAttribute(attrs []*pb.NameBytesPair, key string, value []byte) []*pb.NameBytesPair {
+	for _, a := range attrs {
+		if a.GetName() == key {
+			a.Value = value
+			return attrs
+		}
+	}
+	return append(attrs, &pb.NameBytesPair{Name: &key, Value: value})
+}
+
 // hasQueryOptions is interface that needs to be implemented by calls
 // that allow to provide Families and Filters options.
 type hasQueryOptions interface {
 	setFamilies(families map[string][]string)
 	setFilter(filter *pb.Filter)
 	setTimeRangeUint64(from, to uint64)
+	setColumnFamilyTimeRangeUint64(family string, from, to uint64)
 	setMaxVersions(versions uint32)
 	setMaxResultsPerColumnFamily(maxresults uint32)
 	setResultOffset(offset uint32)
 	setCacheBlocks(cacheBlocks bool)
 	setConsistency(consistency ConsistencyType)
+	setReplicaID(id int)
 }
 
 // RPCResult is struct that will contain both the resulting message from an RPC
@@ -120,6 +339,11 @@ type base struct {
 
 	region   RegionInfo
 	resultch chan RPCResult
+
+	// retries is the number of times the call was retried
+	retries int
+	// metricLabel is the label of the metrics of the call, see MetricLabel
+	metricLabel string
 }
 
 func (b *base) Context() context.Context {
@@ -134,6 +358,28 @@ func (b *base) SetRegion(region RegionInfo) {
 	b.region = region
 }
 
+// Retries returns the number of times the call had to be retried by the client
+// before it completed, for example because its region was unavailable.
+// It's only meaningful once the call has completed.
+func (b *base) Retries() int {
+	return b.retries
+}
+
+// SetRetries sets the number of times the call was retried.
+func (b *base) SetRetries(retries int) {
+	b.retries = retries
+}
+
+// MetricLabel returns the label of the metrics of the call set with
+// MetricLabel option, if any.
+func (b *base) MetricLabel() string {
+	return b.metricLabel
+}
+
+func (b *base) setMetricLabel(label string) {
+	b.metricLabel = label
+}
+
 var RegionSpecifierRegionName = pb.RegionSpecifier_REGION_NAME.Enum()
 
 func (b *base) regionSpecifier() *pb.RegionSpecifier {
@@ -179,6 +425,33 @@ func applyOptions(call Call, options ...func(Call) error) error {
 	return nil
 }
 
+// ApplyDefaults applies the default options to the call and then the options
+// the call was created with again, so that they take precedence over the
+// defaults. Defaults that can't be used with the call are skipped. Options of
+// the call become the applied defaults followed by its own options.
+func ApplyDefaults(call Call, defaults ...func(Call) error) {
+	wo, ok := call.(withOptions)
+	if !ok || len(defaults) == 0 {
+		return
+	}
+	if m, ok := call.(*Mutate); ok {
+		// DeleteMarkers option adds to the markers, so start over
+		m.deleteMarkers = nil
+	}
+	options := make([]func(Call) error, 0, len(defaults)+len(wo.Options()))
+	for _, option := range defaults {
+		if option(call) == nil {
+			options = append(options, option)
+		}
+	}
+	for _, option := range wo.Options() {
+		// can't fail as it has been applied when the call was created
+		_ = option(call)
+		options = append(options, option)
+	}
+	wo.setOptions(options)
+}
+
 func (b *base) Table() []byte {
 	return b.table
 }
@@ -199,6 +472,20 @@ func (c *Cell) String() string {
 	return (*pb.Cell)(c).String()
 }
 
+// LatestTimestamp is HBase's LATEST_TIMESTAMP, which stands for the current time
+// of the regionserver rather than a point in time.
+const LatestTimestamp uint64 = math.MaxInt64
+
+// Time returns the timestamp of the cell, in milliseconds since the epoch,
+// as time.Time. It returns false if the cell has no timestamp or if it's
+// LatestTimestamp, or beyond it, as they can't be converted to a point in time.
+func (c *Cell) Time() (time.Time, bool) {
+	if c.Timestamp == nil || *c.Timestamp >= LatestTimestamp {
+		return time.Time{}, false
+	}
+	return time.UnixMilli(int64(*c.Timestamp)), true
+}
+
 // cellFromCellBlock deserializes a cell from a reader
 func cellFromCellBlock(b []byte) (*pb.Cell, uint32, error) {
 	if len(b) < 4 {
@@ -270,11 +557,31 @@ func deserializeCellBlocks(b []byte, cellsLen uint32) ([]*pb.Cell, uint32, error
 
 // Result holds a slice of Cells as well as miscellaneous information about the response.
 type Result struct {
-	Cells   []*Cell
-	Stale   bool
+	Cells []*Cell
+	Stale bool
+	// Partial is set when the row may have more cells than the ones of the
+	// result, i.e. mayHaveMoreCellsInRow of HBase: the rest of a row of a scan
+	// allowing partial results is in the next results, and more cells of a Get
+	// paged with MaxResultsPerColumnFamily and ResultOffset can be fetched with
+	// a greater offset.
+	// Not all HBase versions set it for Gets.
 	Partial bool
 	// Exists is only set if existance_only was set in the request query.
 	Exists *bool
+	// Processed is only set for results of Put and Delete. It means that the
+	// mutation was applied, but not that any data was modified: HBase doesn't
+	// tell, so deleting a cell that doesn't exist is processed too.
+	Processed *bool
+	// Region is the region that served the request. It's only set for results
+	// of Get and mutations, e.g. to learn boundaries of regions of a table.
+	Region *RegionBounds
+}
+
+// RegionBounds identifies a region and the range of keys it holds
+type RegionBounds struct {
+	Name     []byte
+	StartKey []byte
+	StopKey  []byte
 }
 
 func (c *Result) String() string {
@@ -282,6 +589,22 @@ func (c *Result) String() string {
 		c.Cells, c.Stale, c.Partial, c.Exists)
 }
 
+// SortCells sorts the cells of the result by family and qualifier, and by
+// timestamp from newest to oldest, like HBase sorts the cells of a row.
+// The order of cells with the same family, qualifier and timestamp is kept.
+func (c *Result) SortCells() {
+	sort.SliceStable(c.Cells, func(i, j int) bool {
+		a, b := c.Cells[i], c.Cells[j]
+		if cmp := bytes.Compare(a.Family, b.Family); cmp != 0 {
+			return cmp < 0
+		}
+		if cmp := bytes.Compare(a.Qualifier, b.Qualifier); cmp != 0 {
+			return cmp < 0
+		}
+		return (*pb.Cell)(a).GetTimestamp() > (*pb.Cell)(b).GetTimestamp()
+	})
+}
+
 func extractBool(v *bool) bool {
 	return v != nil && *v
 }
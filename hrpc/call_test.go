@@ -6,6 +6,7 @@
 package hrpc
 
 import (
+	"context"
 	"reflect"
 	"strconv"
 	"testing"
@@ -126,3 +127,45 @@ func TestDeserializeCellblocks(t *testing.T) {
 		t.Errorf("invalid number of bytes read: expected %d, got %d", 54, int(read))
 	}
 }
+
+func TestNewAttempt(t *testing.T) {
+	ctx := context.Background()
+	values := map[string]map[string][]byte{"cf": {"a": []byte("1")}}
+	get, _ := NewGetStr(ctx, "table", "row")
+	put, _ := NewPutStr(ctx, "table", "row", values)
+	inc, _ := NewIncStrSingle(ctx, "table", "row", "cf", "a", 1)
+	incNoNonce, _ := NewIncStrSingle(ctx, "table", "row", "cf", "a", 1, Nonce(0))
+	cas, _ := NewCheckAndPut(put, "cf", "a", nil)
+	for _, tcase := range []struct {
+		name string
+		call Call
+		ok   bool
+	}{
+		{name: "get", call: get, ok: true},
+		{name: "increment", call: inc, ok: true},
+		{name: "put", call: put},
+		{name: "incrementWithoutNonce", call: incNoNonce},
+		{name: "checkAndPut", call: cas},
+	} {
+		t.Run(tcase.name, func(t *testing.T) {
+			tcase.call.SetRegion(mockRegionInfo([]byte("region")))
+			attempt, ok := NewAttempt(tcase.call)
+			if ok != tcase.ok {
+				t.Fatalf("expected %v, got %v", tcase.ok, ok)
+			}
+			if !ok {
+				return
+			}
+			if reflect.TypeOf(attempt) != reflect.TypeOf(tcase.call) {
+				t.Errorf("expected a %T, got a %T", tcase.call, attempt)
+			}
+			if !proto.Equal(attempt.ToProto(), tcase.call.ToProto()) {
+				t.Errorf("expected the same request, got %v and %v",
+					attempt.ToProto(), tcase.call.ToProto())
+			}
+			if attempt.ResultChan() == tcase.call.ResultChan() {
+				t.Error("expected the attempt to have a result channel of its own")
+			}
+		})
+	}
+}
@@ -0,0 +1,142 @@
+// Copyright (C) 2026  The GoHBase Authors.  All rights reserved.
+// This file is part of GoHBase.
+// Use of this source code is governed by the Apache License 2.0
+// that can be found in the COPYING file.
+
+package hrpc
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/baiweiguo/gohbase/filter"
+	"github.com/baiweiguo/gohbase/pb"
+	"google.golang.org/protobuf/proto"
+)
+
+// CheckAndMutate atomically performs several Put and Delete operations on
+// a row if the value of a cell of the row satisfies a condition. Either all
+// of the mutations are performed or none of them.
+type CheckAndMutate struct {
+	base
+
+	mutations []*Mutate
+
+	family    []byte
+	qualifier []byte
+
+	compareType pb.CompareType
+	comparator  *pb.Comparator
+}
+
+// NewCheckAndMutate creates a new CheckAndMutate request that will compare the
+// value of the comparator with the one in HBase located at the row of the
+// mutations and provided family:qualifier using compareOp, and if the
+// comparison is true, perform all the mutations atomically, see
+// NewCheckAndPutCompare. The mutations must be Puts or Deletes of the same
+// row of the same table, and they're sent within the context of the first one.
+func NewCheckAndMutate(mutations []*Mutate, family string, qualifier string,
+	compareOp filter.CompareType, comparator filter.Comparator) (*CheckAndMutate, error) {
+	if len(mutations) == 0 {
+		return nil, errors.New("'CheckAndMutate' needs at least one mutation")
+	}
+	first := mutations[0]
+	for _, m := range mutations {
+		if m.mutationType != pb.MutationProto_PUT &&
+			m.mutationType != pb.MutationProto_DELETE {
+			return nil, fmt.Errorf("'CheckAndMutate' only takes 'Put' and 'Delete' "+
+				"requests, got %q", m.Description())
+		}
+		if !bytes.Equal(m.table, first.table) || !bytes.Equal(m.key, first.key) {
+			return nil, fmt.Errorf("'CheckAndMutate' mutations must be of the same row, "+
+				"got %q in %q and %q in %q", first.key, first.table, m.key, m.table)
+		}
+	}
+	if compareOp < filter.Less || compareOp > filter.NoOp {
+		return nil, fmt.Errorf("invalid compare operator %d", compareOp)
+	}
+
+	cmp, err := comparator.ConstructPBComparator()
+	if err != nil {
+		return nil, err
+	}
+
+	return &CheckAndMutate{
+		base: base{
+			table:    first.table,
+			key:      first.key,
+			ctx:      first.ctx,
+			resultch: make(chan RPCResult, 1),
+		},
+		mutations:   mutations,
+		family:      []byte(family),
+		qualifier:   []byte(qualifier),
+		compareType: pb.CompareType(compareOp),
+		comparator:  cmp,
+	}, nil
+}
+
+// Name returns the name of this RPC call.
+func (cm *CheckAndMutate) Name() string {
+	return "Multi"
+}
+
+// Description returns the description of this RPC call.
+func (cm *CheckAndMutate) Description() string {
+	return "CheckAndMutate"
+}
+
+// Mutations returns the mutations performed if the condition is satisfied.
+func (cm *CheckAndMutate) Mutations() []*Mutate {
+	return cm.mutations
+}
+
+// KeyValueSizes returns the total size of the KeyValues the cells of the
+// mutations are sent to HBase as, and the size of the largest of them.
+func (cm *CheckAndMutate) KeyValueSizes() (total, largest int64) {
+	for _, m := range cm.mutations {
+		t, l := m.KeyValueSizes()
+		total += t
+		if l > largest {
+			largest = l
+		}
+	}
+	return total, largest
+}
+
+// ToProto converts the RPC into a protobuf message. The mutations are sent
+// as an atomic action of the region of the row.
+func (cm *CheckAndMutate) ToProto() proto.Message {
+	ra := &pb.RegionAction{
+		Region: cm.regionSpecifier(),
+		Atomic: proto.Bool(true),
+		Action: make([]*pb.Action, len(cm.mutations)),
+	}
+	req := &pb.MultiRequest{
+		RegionAction: []*pb.RegionAction{ra},
+		Condition: &pb.Condition{
+			Row:         cm.key,
+			Family:      cm.family,
+			Qualifier:   cm.qualifier,
+			CompareType: cm.compareType.Enum(),
+			Comparator:  cm.comparator,
+		},
+	}
+	for i, m := range cm.mutations {
+		mProto, _, _ := m.mutationToProto(false, nil)
+		if m.Nonce() != 0 {
+			req.NonceGroup = &nonceGroup
+		}
+		// indexes start at 1 to tell them apart from unset ones
+		ra.Action[i] = &pb.Action{Index: proto.Uint32(uint32(i) + 1), Mutation: mProto}
+	}
+	return req
+}
+
+// NewResponse creates an empty protobuf message to read the response of this RPC.
+func (cm *CheckAndMutate) NewResponse() proto.Message {
+	return &pb.MultiResponse{}
+}
+
+func (cm *CheckAndMutate) writesRows() {}
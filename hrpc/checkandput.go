@@ -21,7 +21,8 @@ type CheckAndPut struct {
 	family    []byte
 	qualifier []byte
 
-	comparator *pb.Comparator
+	compareType pb.CompareType
+	comparator  *pb.Comparator
 }
 
 // NewCheckAndPut creates a new CheckAndPut request that will compare provided
@@ -29,13 +30,30 @@ type CheckAndPut struct {
 // and if they are equal, perform the provided put request on the row
 func NewCheckAndPut(put *Mutate, family string,
 	qualifier string, expectedValue []byte) (*CheckAndPut, error) {
+	// The condition that needs to match for the edit to be applied.
+	exp := filter.NewByteArrayComparable(expectedValue)
+	return NewCheckAndPutCompare(put, family, qualifier,
+		filter.Equal, filter.NewBinaryComparator(exp))
+}
+
+// NewCheckAndPutCompare creates a new CheckAndPut request that will compare
+// the value of the comparator with the one in HBase located at put's row and
+// provided family:qualifier using compareOp, and if the comparison is true,
+// perform the provided put request on the row. The value of the comparator is
+// on the left side of the comparison, e.g. with filter.Greater the put is
+// performed if the value of the comparator is greater than the one in HBase.
+// Comparators that don't order values, such as regex or substring comparators,
+// compare as equal if the value in HBase matches and as greater otherwise.
+func NewCheckAndPutCompare(put *Mutate, family string, qualifier string,
+	compareOp filter.CompareType, comparator filter.Comparator) (*CheckAndPut, error) {
 	if put.mutationType != pb.MutationProto_PUT {
 		return nil, fmt.Errorf("'CheckAndPut' only takes 'Put' request")
 	}
+	if compareOp < filter.Less || compareOp > filter.NoOp {
+		return nil, fmt.Errorf("invalid compare operator %d", compareOp)
+	}
 
-	// The condition that needs to match for the edit to be applied.
-	exp := filter.NewByteArrayComparable(expectedValue)
-	cmp, err := filter.NewBinaryComparator(exp).ConstructPBComparator()
+	cmp, err := comparator.ConstructPBComparator()
 	if err != nil {
 		return nil, err
 	}
@@ -45,10 +63,11 @@ func NewCheckAndPut(put *Mutate, family string,
 	put.setSkipBatch(true)
 
 	return &CheckAndPut{
-		Mutate:     put,
-		family:     []byte(family),
-		qualifier:  []byte(qualifier),
-		comparator: cmp,
+		Mutate:      put,
+		family:      []byte(family),
+		qualifier:   []byte(qualifier),
+		compareType: pb.CompareType(compareOp),
+		comparator:  cmp,
 	}, nil
 }
 
@@ -59,7 +78,7 @@ func (cp *CheckAndPut) ToProto() proto.Message {
 		Row:         cp.key,
 		Family:      cp.family,
 		Qualifier:   cp.qualifier,
-		CompareType: pb.CompareType_EQUAL.Enum(),
+		CompareType: cp.compareType.Enum(),
 		Comparator:  cp.comparator,
 	}
 	return mutateRequest
@@ -0,0 +1,59 @@
+// Copyright (C) 2026  The GoHBase Authors.  All rights reserved.
+// This file is part of GoHBase.
+// Use of this source code is governed by the Apache License 2.0
+// that can be found in the COPYING file.
+
+package hrpc
+
+import (
+	"context"
+
+	"github.com/baiweiguo/gohbase/pb"
+	"google.golang.org/protobuf/proto"
+)
+
+// CompactRegion asks a RegionServer to compact one of its regions.
+// It's sent to the AdminService of the RegionServer, which compacts
+// the region asynchronously.
+type CompactRegion struct {
+	base
+	req *pb.CompactRegionRequest
+}
+
+// NewCompactRegion creates an hrpc to compact the region with the given name,
+// with a major compaction if major is true. Specify full region name.
+func NewCompactRegion(ctx context.Context, regionName []byte, major bool) *CompactRegion {
+	return &CompactRegion{
+		base: base{
+			ctx:      ctx,
+			resultch: make(chan RPCResult, 1),
+		},
+		req: &pb.CompactRegionRequest{
+			Region: &pb.RegionSpecifier{
+				Type:  pb.RegionSpecifier_REGION_NAME.Enum(),
+				Value: regionName,
+			},
+			Major: proto.Bool(major),
+		},
+	}
+}
+
+// Name returns the name of this RPC call.
+func (cr *CompactRegion) Name() string {
+	return "CompactRegion"
+}
+
+// Description returns the description of this RPC call.
+func (cr *CompactRegion) Description() string {
+	return cr.Name()
+}
+
+// ToProto converts the RPC into a protobuf message.
+func (cr *CompactRegion) ToProto() proto.Message {
+	return cr.req
+}
+
+// NewResponse creates an empty protobuf message to read the response of this RPC.
+func (cr *CompactRegion) NewResponse() proto.Message {
+	return &pb.CompactRegionResponse{}
+}
@@ -0,0 +1,189 @@
+// Copyright (C) 2026  The GoHBase Authors.  All rights reserved.
+// This file is part of GoHBase.
+// Use of this source code is governed by the Apache License 2.0
+// that can be found in the COPYING file.
+
+package hrpc
+
+import (
+	"context"
+	"errors"
+
+	"github.com/baiweiguo/gohbase/pb"
+	"google.golang.org/protobuf/proto"
+)
+
+// CoprocessorResult is the result of a coprocessor endpoint in a region.
+type CoprocessorResult struct {
+	// Value is the serialized response of the method.
+	Value []byte
+	// Err is the error of the method in the region, if any.
+	Err error
+}
+
+// CoprocessorService invokes a method of a coprocessor endpoint (Service)
+// loaded in the region holding a row.
+type CoprocessorService struct {
+	base
+
+	service string
+	method  string
+	request []byte
+}
+
+// NewCoprocessorService creates a new CoprocessorService request invoking
+// method of service in the region of table holding row with request, the
+// serialized protobuf message the method takes. The service name is the full
+// name of the protobuf service, e.g. "hbase.pb.AggregateService".
+func NewCoprocessorService(ctx context.Context, table, row []byte,
+	service, method string, request []byte) (*CoprocessorService, error) {
+	if service == "" || method == "" {
+		return nil, errors.New("'CoprocessorService' needs a service and a method")
+	}
+	return &CoprocessorService{
+		base: base{
+			table:    table,
+			key:      row,
+			ctx:      ctx,
+			resultch: make(chan RPCResult, 1),
+		},
+		service: service,
+		method:  method,
+		request: request,
+	}, nil
+}
+
+// Name returns the name of this RPC call.
+func (cs *CoprocessorService) Name() string {
+	return "ExecService"
+}
+
+// Description returns the description of this RPC call.
+func (cs *CoprocessorService) Description() string {
+	return "CoprocessorService"
+}
+
+// Service returns the name of the invoked coprocessor service.
+func (cs *CoprocessorService) Service() string {
+	return cs.service
+}
+
+// Method returns the name of the invoked method of the service.
+func (cs *CoprocessorService) Method() string {
+	return cs.method
+}
+
+// ToProto converts the RPC into a protobuf message.
+func (cs *CoprocessorService) ToProto() proto.Message {
+	return &pb.CoprocessorServiceRequest{
+		Region: cs.regionSpecifier(),
+		Call: &pb.CoprocessorServiceCall{
+			Row:         cs.key,
+			ServiceName: &cs.service,
+			MethodName:  &cs.method,
+			// the field is required even if the request is empty
+			Request: append([]byte{}, cs.request...),
+		},
+	}
+}
+
+// NewResponse creates an empty protobuf message to read the response of this RPC.
+func (cs *CoprocessorService) NewResponse() proto.Message {
+	return &pb.CoprocessorServiceResponse{}
+}
+
+// RegionServerCoprocessorService invokes a method of a coprocessor endpoint
+// (Service) loaded in a RegionServer rather than in one of its regions.
+// It's sent to the AdminService of the RegionServer.
+type RegionServerCoprocessorService struct {
+	base
+
+	service string
+	method  string
+	request []byte
+}
+
+// NewRegionServerCoprocessorService creates a new RegionServerCoprocessorService
+// request invoking method of service with request, see NewCoprocessorService.
+func NewRegionServerCoprocessorService(ctx context.Context, service, method string,
+	request []byte) (*RegionServerCoprocessorService, error) {
+	if service == "" || method == "" {
+		return nil, errors.New(
+			"'RegionServerCoprocessorService' needs a service and a method")
+	}
+	return &RegionServerCoprocessorService{
+		base: base{
+			ctx:      ctx,
+			resultch: make(chan RPCResult, 1),
+		},
+		service: service,
+		method:  method,
+		request: request,
+	}, nil
+}
+
+// Name returns the name of this RPC call.
+func (cs *RegionServerCoprocessorService) Name() string {
+	return "ExecRegionServerService"
+}
+
+// Description returns the description of this RPC call.
+func (cs *RegionServerCoprocessorService) Description() string {
+	return "RegionServerCoprocessorService"
+}
+
+// Service returns the name of the invoked coprocessor service.
+func (cs *RegionServerCoprocessorService) Service() string {
+	return cs.service
+}
+
+// Method returns the name of the invoked method of the service.
+func (cs *RegionServerCoprocessorService) Method() string {
+	return cs.method
+}
+
+// ToProto converts the RPC into a protobuf message.
+func (cs *RegionServerCoprocessorService) ToProto() proto.Message {
+	return &pb.CoprocessorServiceRequest{
+		// the region is required but ignored by RegionServers
+		Region: &pb.RegionSpecifier{
+			Type:  pb.RegionSpecifier_REGION_NAME.Enum(),
+			Value: []byte{},
+		},
+		Call: &pb.CoprocessorServiceCall{
+			Row:         []byte{},
+			ServiceName: &cs.service,
+			MethodName:  &cs.method,
+			Request:     append([]byte{}, cs.request...),
+		},
+	}
+}
+
+// NewResponse creates an empty protobuf message to read the response of this RPC.
+func (cs *RegionServerCoprocessorService) NewResponse() proto.Message {
+	return &pb.CoprocessorServiceResponse{}
+}
+
+const (
+	// MetricsService is the name of the coprocessor service that RegionServers
+	// are expected to load to expose custom metrics, see MetricsMethod.
+	MetricsService = "hbase.pb.MetricsService"
+	// MetricsMethod is the method of MetricsService returning the metrics of
+	// a RegionServer. It takes an empty request, and its response is decoded
+	// by a MetricsDecoder.
+	MetricsMethod = "GetMetrics"
+)
+
+// MetricsDecoder decodes the serialized response of the method of a
+// coprocessor endpoint exposing metrics into their values keyed by name.
+type MetricsDecoder func(response []byte) (map[string]float64, error)
+
+// CoprocessorMetrics are the metrics exposed by the coprocessor
+// endpoints of RegionServers.
+type CoprocessorMetrics struct {
+	// Total are the values of the metrics summed over RegionServers.
+	Total map[string]float64
+	// Servers are the values of the metrics of each RegionServer,
+	// keyed by its address.
+	Servers map[string]map[string]float64
+}
@@ -7,6 +7,9 @@ package hrpc
 
 import (
 	"context"
+	"encoding/binary"
+	"fmt"
+	"math/big"
 
 	"github.com/baiweiguo/gohbase/pb"
 	"google.golang.org/protobuf/proto"
@@ -72,6 +75,69 @@ func SplitKeys(sk [][]byte) func(*CreateTable) {
 	}
 }
 
+// SplitAlgorithm computes split keys that divide the space of row keys
+// into regions of the same size.
+type SplitAlgorithm int
+
+const (
+	// UniformSplit divides the space of arbitrary binary row keys
+	// uniformly by their first 8 bytes.
+	UniformSplit SplitAlgorithm = iota
+
+	// HexStringSplit divides the space of row keys starting with
+	// 8 lowercase hexadecimal digits, such as hex encoded hashes.
+	HexStringSplit
+
+	// DecimalStringSplit divides the space of row keys starting with
+	// 8 decimal digits.
+	DecimalStringSplit
+)
+
+// Split returns the numRegions-1 split keys dividing the space of row keys
+// into numRegions regions.
+func (sa SplitAlgorithm) Split(numRegions int) [][]byte {
+	if numRegions <= 1 {
+		return nil
+	}
+	var size *big.Int
+	switch sa {
+	case UniformSplit:
+		size = new(big.Int).Lsh(big.NewInt(1), 64)
+	case HexStringSplit:
+		size = big.NewInt(1 << 32)
+	case DecimalStringSplit:
+		size = big.NewInt(100000000)
+	default:
+		panic("invalid value for SplitAlgorithm")
+	}
+
+	splits := make([][]byte, numRegions-1)
+	for i := range splits {
+		// (i+1) * size / numRegions
+		k := new(big.Int).Mul(size, big.NewInt(int64(i+1)))
+		k.Quo(k, big.NewInt(int64(numRegions)))
+		switch sa {
+		case UniformSplit:
+			splits[i] = make([]byte, 8)
+			binary.BigEndian.PutUint64(splits[i], k.Uint64())
+		case HexStringSplit:
+			splits[i] = []byte(fmt.Sprintf("%08x", k.Uint64()))
+		case DecimalStringSplit:
+			splits[i] = []byte(fmt.Sprintf("%08d", k.Uint64()))
+		}
+	}
+	return splits
+}
+
+// SplitRegions will return an option that will set the split keys for the created
+// table to the ones computed by the algorithm, so that the table starts with
+// numRegions regions. It replaces the split keys set by a preceding SplitKeys option.
+func SplitRegions(algorithm SplitAlgorithm, numRegions int) func(*CreateTable) {
+	return func(ct *CreateTable) {
+		ct.splitKeys = algorithm.Split(numRegions)
+	}
+}
+
 // TableAttributes will return an option that will set attributes on the created table
 func TableAttributes(attrs map[string]string) func(*CreateTable) {
 	return func(ct *CreateTable) {
@@ -0,0 +1,161 @@
+// Copyright (C) 2026  The GoHBase Authors.  All rights reserved.
+// This file is part of GoHBase.
+// Use of this source code is governed by the Apache License 2.0
+// that can be found in the COPYING file.
+
+package hrpc
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+)
+
+// Column is a column of a table, identified by its family and qualifier.
+type Column struct {
+	Family    string
+	Qualifier string
+}
+
+// Decode sets the fields of the struct pointed to by dst to the latest values
+// of the cells of r in the columns the fields are mapped to, e.g. to map rows
+// to domain types. Fields whose columns have no cell in r are left as is.
+//
+// Fields can be []byte, which references the value of the cell, string, bool,
+// integers and floats, or pointers to them, which are allocated if the column
+// has a cell. Numbers and booleans are decoded the way HBase's Bytes encodes
+// them: booleans on a single byte, integers and floats in big-endian order on
+// as many bytes as their type, and int and uint on 8 bytes like Java's long
+// whatever their size on the platform. Fields are decoded in the order of
+// their names, and decoding stops at the first one that fails.
+func (r *Result) Decode(dst interface{}, fields map[string]Column) error {
+	v := reflect.ValueOf(dst)
+	if v.Kind() != reflect.Ptr || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("can't decode a row into %T, expected a pointer to a struct", dst)
+	}
+	v = v.Elem()
+	names := make([]string, 0, len(fields))
+	for name := range fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		col := fields[name]
+		field := v.FieldByName(name)
+		if !field.IsValid() {
+			return fmt.Errorf("%s has no field %s", v.Type(), name)
+		}
+		if !field.CanSet() {
+			return fmt.Errorf("field %s of %s isn't exported", name, v.Type())
+		}
+		cell := r.latestCell(col)
+		if cell == nil {
+			continue
+		}
+		if err := decodeValue(field, cell.Value); err != nil {
+			return fmt.Errorf("failed to decode column %s:%s into field %s: %w",
+				col.Family, col.Qualifier, name, err)
+		}
+	}
+	return nil
+}
+
+// latestCell returns the first cell of r in col, which is the latest version
+// of the column as HBase returns cells of a column by decreasing timestamps.
+func (r *Result) latestCell(col Column) *Cell {
+	for _, c := range r.Cells {
+		if string(c.Family) == col.Family && string(c.Qualifier) == col.Qualifier {
+			return c
+		}
+	}
+	return nil
+}
+
+// decodeValue sets v to the value of a cell, see Result.Decode.
+func decodeValue(v reflect.Value, value []byte) error {
+	if v.Kind() == reflect.Ptr {
+		elem := reflect.New(v.Type().Elem())
+		if err := decodeValue(elem.Elem(), value); err != nil {
+			return err
+		}
+		v.Set(elem)
+		return nil
+	}
+
+	size := func(n int) error {
+		if len(value) != n {
+			return fmt.Errorf("expected %d bytes for %s, got %d", n, v.Type(), len(value))
+		}
+		return nil
+	}
+	switch v.Kind() {
+	case reflect.Slice:
+		if v.Type().Elem().Kind() != reflect.Uint8 {
+			break
+		}
+		v.SetBytes(value)
+		return nil
+	case reflect.String:
+		v.SetString(string(value))
+		return nil
+	case reflect.Bool:
+		if err := size(1); err != nil {
+			return err
+		}
+		v.SetBool(value[0] != 0)
+		return nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if err := size(intSize(v)); err != nil {
+			return err
+		}
+		n := int64(bigEndianUint(value)) << (64 - 8*len(value)) >> (64 - 8*len(value))
+		if v.OverflowInt(n) {
+			return fmt.Errorf("%d overflows %s", n, v.Type())
+		}
+		v.SetInt(n)
+		return nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if err := size(intSize(v)); err != nil {
+			return err
+		}
+		n := bigEndianUint(value)
+		if v.OverflowUint(n) {
+			return fmt.Errorf("%d overflows %s", n, v.Type())
+		}
+		v.SetUint(n)
+		return nil
+	case reflect.Float32:
+		if err := size(4); err != nil {
+			return err
+		}
+		v.SetFloat(float64(math.Float32frombits(binary.BigEndian.Uint32(value))))
+		return nil
+	case reflect.Float64:
+		if err := size(8); err != nil {
+			return err
+		}
+		v.SetFloat(math.Float64frombits(binary.BigEndian.Uint64(value)))
+		return nil
+	}
+	return fmt.Errorf("unsupported type %s", v.Type())
+}
+
+// intSize returns the number of bytes integer v is encoded on, 8 for int and
+// uint as they're encoded like Java's long.
+func intSize(v reflect.Value) int {
+	if k := v.Kind(); k == reflect.Int || k == reflect.Uint {
+		return 8
+	}
+	return int(v.Type().Size())
+}
+
+// bigEndianUint decodes an unsigned integer of at most 8 bytes in big-endian order.
+func bigEndianUint(b []byte) uint64 {
+	var n uint64
+	for _, c := range b {
+		n = n<<8 | uint64(c)
+	}
+	return n
+}
@@ -0,0 +1,128 @@
+// Copyright (C) 2026  The GoHBase Authors.  All rights reserved.
+// This file is part of GoHBase.
+// Use of this source code is governed by the Apache License 2.0
+// that can be found in the COPYING file.
+
+package hrpc
+
+import (
+	"encoding/binary"
+	"math"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestResultDecode(t *testing.T) {
+	type user struct {
+		Name    string
+		Avatar  []byte
+		Age     int32
+		Balance float64
+		Active  bool
+		Visits  *uint64
+		Email   *string
+		Country string
+		Score   int
+		Rank    uint
+		ignored int
+	}
+	fields := map[string]Column{
+		"Name":    {"info", "name"},
+		"Avatar":  {"info", "avatar"},
+		"Age":     {"info", "age"},
+		"Balance": {"account", "balance"},
+		"Active":  {"account", "active"},
+		"Visits":  {"stats", "visits"},
+		"Email":   {"info", "email"},
+		"Country": {"info", "country"},
+		"Score":   {"stats", "score"},
+		"Rank":    {"stats", "rank"},
+	}
+	cell := func(family, qualifier string, value []byte) *Cell {
+		return &Cell{Family: []byte(family), Qualifier: []byte(qualifier), Value: value}
+	}
+	age := make([]byte, 4)
+	ageValue := int32(-7)
+	binary.BigEndian.PutUint32(age, uint32(ageValue))
+	balance := make([]byte, 8)
+	binary.BigEndian.PutUint64(balance, math.Float64bits(12.5))
+	visits := make([]byte, 8)
+	binary.BigEndian.PutUint64(visits, 42)
+	// int and uint are encoded on 8 bytes whatever their size
+	score := make([]byte, 8)
+	scoreValue := int64(-3)
+	binary.BigEndian.PutUint64(score, uint64(scoreValue))
+	rank := make([]byte, 8)
+	binary.BigEndian.PutUint64(rank, 5)
+	res := &Result{Cells: []*Cell{
+		cell("info", "name", []byte("alice")),
+		// the latest version comes first
+		cell("info", "avatar", []byte{1, 2}),
+		cell("info", "avatar", []byte{0}),
+		cell("info", "age", age),
+		cell("account", "balance", balance),
+		cell("account", "active", []byte{0xff}),
+		cell("stats", "visits", visits),
+		cell("stats", "score", score),
+		cell("stats", "rank", rank),
+		cell("stats", "short", age),
+		// unmapped columns are ignored
+		cell("info", "unknown", []byte("?")),
+	}}
+
+	// absent columns leave their fields as is
+	got := user{Country: "unknown"}
+	if err := res.Decode(&got, fields); err != nil {
+		t.Fatal(err)
+	}
+	n := uint64(42)
+	exp := user{
+		Name:    "alice",
+		Avatar:  []byte{1, 2},
+		Age:     -7,
+		Balance: 12.5,
+		Active:  true,
+		Visits:  &n,
+		Country: "unknown",
+		Score:   -3,
+		Rank:    5,
+	}
+	if !reflect.DeepEqual(exp, got) {
+		t.Errorf("expected %+v, got %+v", exp, got)
+	}
+
+	for name, tc := range map[string]struct {
+		dst    interface{}
+		fields map[string]Column
+	}{
+		"notPointer":   {dst: user{}, fields: fields},
+		"notStruct":    {dst: new(string), fields: fields},
+		"missingField": {dst: &user{}, fields: map[string]Column{"Phone": {"info", "phone"}}},
+		"unexported":   {dst: &user{}, fields: map[string]Column{"ignored": {"info", "name"}}},
+		"badLength":    {dst: &user{}, fields: map[string]Column{"Age": {"info", "name"}}},
+		"shortInt":     {dst: &user{}, fields: map[string]Column{"Score": {"stats", "short"}}},
+		"unsupported": {
+			dst:    &struct{ Tags []string }{},
+			fields: map[string]Column{"Tags": {"info", "name"}},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			if err := res.Decode(tc.dst, tc.fields); err == nil {
+				t.Error("expected an error")
+			}
+		})
+	}
+
+	// fields are decoded in the order of their names
+	for i := 0; i < 10; i++ {
+		err := res.Decode(&user{}, map[string]Column{
+			"Age":  {"info", "name"},
+			"Name": {"info", "name"},
+			"Rank": {"info", "name"},
+		})
+		if err == nil || !strings.Contains(err.Error(), "field Age") {
+			t.Fatalf("expected the error of field Age, got %v", err)
+		}
+	}
+}
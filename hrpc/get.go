@@ -7,6 +7,7 @@ package hrpc
 
 import (
 	"context"
+	"errors"
 
 	"github.com/baiweiguo/gohbase/pb"
 	"google.golang.org/protobuf/proto"
@@ -20,6 +21,12 @@ type Get struct {
 	// table or not.
 	existsOnly bool
 	skipbatch  bool
+	// targetServer is the address of the RegionServer to send the request to
+	targetServer string
+	// sortedCells sorts the cells of the result, see SortedCells option
+	sortedCells bool
+	// limits are the maximum lengths set with MaxLengths option
+	limits lengthLimits
 }
 
 // baseGet returns a Get struct with default values set.
@@ -38,6 +45,9 @@ func baseGet(ctx context.Context, table []byte, key []byte,
 	if err != nil {
 		return nil, err
 	}
+	if err := g.checkLengths(); err != nil {
+		return nil, err
+	}
 	return g, nil
 }
 
@@ -73,12 +83,60 @@ func (g *Get) setSkipBatch(v bool) {
 	g.skipbatch = v
 }
 
+// TargetRegionServer returns the address of the RegionServer set with
+// TargetRegionServer option, or an empty string if it wasn't set.
+func (g *Get) TargetRegionServer() string {
+	return g.targetServer
+}
+
+func (g *Get) setTargetRegionServer(addr string) {
+	g.targetServer = addr
+}
+
+func (g *Get) setLengthLimits(limits lengthLimits) {
+	g.limits = limits
+}
+
 // ExistsOnly makes this Get request not return any KeyValue, merely whether
 // or not the given row key exists in the table.
 func (g *Get) ExistsOnly() {
 	g.existsOnly = true
 }
 
+// ExistsOnly is a Get-only option that makes the request not return any
+// KeyValue, merely whether or not the given row key exists in the table,
+// in Exists of the result. It's much cheaper than getting the whole row.
+func ExistsOnly(existsOnly bool) func(Call) error {
+	return func(g Call) error {
+		get, ok := g.(*Get)
+		if !ok {
+			return errors.New("'ExistsOnly' option can only be used with Get queries")
+		}
+		get.existsOnly = existsOnly
+		return nil
+	}
+}
+
+// SortedCells is a Get-only option that makes the client sort the cells of
+// the result with Result.SortCells once it's received, so that callers can
+// rely on their order. Cells are returned in the order of the server otherwise.
+func SortedCells() func(Call) error {
+	return func(g Call) error {
+		get, ok := g.(*Get)
+		if !ok {
+			return errors.New("'SortedCells' option can only be used with Get queries")
+		}
+		get.sortedCells = true
+		return nil
+	}
+}
+
+// SortedCells returns true if the cells of the result are sorted by the client,
+// see SortedCells option.
+func (g *Get) SortedCells() bool {
+	return g.sortedCells
+}
+
 // ToProto converts this RPC into a protobuf message.
 func (g *Get) ToProto() proto.Message {
 	get := &pb.GetRequest{
@@ -107,6 +165,7 @@ func (g *Get) ToProto() proto.Message {
 	if g.toTimestamp != MaxTimestamp {
 		get.Get.TimeRange.To = &g.toTimestamp
 	}
+	get.Get.CfTimeRange = g.cfTimeRangesToProto()
 	if g.existsOnly {
 		get.Get.ExistenceOnly = proto.Bool(true)
 	}
@@ -117,6 +176,7 @@ func (g *Get) ToProto() proto.Message {
 		get.Get.Consistency = g.consistency.toProto()
 	}
 	get.Get.Filter = g.filter
+	get.Get.Attribute = g.attributes
 	return get
 }
 
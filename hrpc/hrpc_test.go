@@ -13,6 +13,7 @@ import (
 	"reflect"
 	"sort"
 	"strconv"
+	"strings"
 	"testing"
 	"time"
 
@@ -68,6 +69,15 @@ func TestNewGet(t *testing.T) {
 	if err != nil && errStr != err.Error() || err == nil {
 		t.Errorf("Get8 Expected: %#v\nReceived: %#v", errStr, err)
 	}
+	get, err = NewGet(ctx, tableb, keyb, ExistsOnly(true))
+	if err != nil || !get.existsOnly {
+		t.Errorf("Get9 didn't set attributes correctly.")
+	}
+	_, err = NewScan(ctx, tableb, ExistsOnly(true))
+	errStr = "'ExistsOnly' option can only be used with Get queries"
+	if err == nil || errStr != err.Error() {
+		t.Errorf("Scan Expected: %#v\nReceived: %#v", errStr, err)
+	}
 }
 
 func confirmGetAttributes(ctx context.Context, g *Get, table, key []byte,
@@ -252,6 +262,66 @@ func TestNewScan(t *testing.T) {
 	}
 }
 
+func TestNewScanRangeInvalid(t *testing.T) {
+	for _, tcase := range []struct {
+		start, stop string
+		reversed    bool
+		err         error
+	}{
+		{start: "b", stop: "a", err: ErrInvalidScanRange},
+		{start: "a", stop: "a"},
+		{start: "a", stop: "b"},
+		{start: "a", stop: ""},
+		{start: "", stop: "a"},
+		{start: "a", stop: "b", reversed: true, err: ErrInvalidScanRange},
+		{start: "a", stop: "a", reversed: true},
+		{start: "b", stop: "a", reversed: true},
+	} {
+		var options []func(Call) error
+		if tcase.reversed {
+			options = append(options, Reversed())
+		}
+		_, err := NewScanRangeStr(context.Background(), "test", tcase.start, tcase.stop,
+			options...)
+		if err != tcase.err {
+			t.Errorf("expected error %v for range [%q; %q[ (reversed %v), got %v",
+				tcase.err, tcase.start, tcase.stop, tcase.reversed, err)
+		}
+	}
+}
+
+func TestScanKeys(t *testing.T) {
+	ctx := context.Background()
+	if _, err := NewGetStr(ctx, "test", "a", Keys([]byte("a"))); err == nil {
+		t.Error("expected an error for Keys option of a Get")
+	}
+	if _, err := NewScanStr(ctx, "test", Keys()); err == nil {
+		t.Error("expected an error for Keys option without keys")
+	}
+
+	// the keys take precedence over the row ranges of other filters
+	scan, err := NewScanStr(ctx, "test", Keys([]byte("b"), []byte("a"), []byte("b")),
+		Filters(filter.NewMultiRowRangeFilter([]*filter.RowRange{
+			filter.NewRowRange([]byte("x"), []byte("z"), true, false),
+		})))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, r := range scan.RowRanges() {
+		if !bytes.Equal(r.StartRow, r.StopRow) {
+			t.Errorf("expected a single row range, got %v", r)
+		}
+		got = append(got, string(r.StartRow))
+	}
+	if exp := []string{"a", "b"}; !reflect.DeepEqual(exp, got) {
+		t.Errorf("expected row ranges of keys %q, got %q", exp, got)
+	}
+	if name := scan.filter.GetName(); !strings.HasSuffix(name, "FilterList") {
+		t.Errorf("expected a filter list, got %s", name)
+	}
+}
+
 func TestScanToProto(t *testing.T) {
 	var (
 		ctx = context.Background()
@@ -395,6 +465,62 @@ func TestScanToProto(t *testing.T) {
 				},
 			},
 		},
+		{ // set small attribute
+			s: func() *Scan {
+				s, _ := NewScanStr(ctx, "", Small(true))
+				return s
+			}(),
+			expProto: &pb.ScanRequest{
+				Region:                  rs,
+				NumberOfRows:            proto.Uint32(DefaultNumberOfRows),
+				CloseScanner:            proto.Bool(true),
+				ClientHandlesPartials:   proto.Bool(false),
+				ClientHandlesHeartbeats: proto.Bool(true),
+				Scan: &pb.Scan{
+					MaxResultSize: proto.Uint64(DefaultMaxResultSize),
+					Column:        []*pb.Column{},
+					TimeRange:     &pb.TimeRange{},
+					Small:         proto.Bool(true),
+				},
+			},
+		},
+		{ // set time ranges of column families
+			s: func() *Scan {
+				s, _ := NewScanStr(ctx, "",
+					ColumnFamilyTimeRangeUint64("cf1", 20, 30),
+					ColumnFamilyTimeRangeUint64("cf2", 50, 60),
+				)
+				return s
+			}(),
+			expProto: &pb.ScanRequest{
+				Region:                  rs,
+				NumberOfRows:            proto.Uint32(DefaultNumberOfRows),
+				CloseScanner:            proto.Bool(false),
+				ClientHandlesPartials:   proto.Bool(true),
+				ClientHandlesHeartbeats: proto.Bool(true),
+				Scan: &pb.Scan{
+					MaxResultSize: proto.Uint64(DefaultMaxResultSize),
+					Column:        []*pb.Column{},
+					TimeRange:     &pb.TimeRange{},
+					CfTimeRange: []*pb.ColumnFamilyTimeRange{
+						{
+							ColumnFamily: []byte("cf1"),
+							TimeRange: &pb.TimeRange{
+								From: proto.Uint64(20),
+								To:   proto.Uint64(30),
+							},
+						},
+						{
+							ColumnFamily: []byte("cf2"),
+							TimeRange: &pb.TimeRange{
+								From: proto.Uint64(50),
+								To:   proto.Uint64(60),
+							},
+						},
+					},
+				},
+			},
+		},
 		{ // scan key range
 			s: func() *Scan {
 				s, _ := NewScanRange(ctx, nil, startRow, stopRow)
@@ -493,6 +619,7 @@ func (ri mockRegionInfo) Namespace() []byte                 { return nil }
 func (ri mockRegionInfo) Table() []byte                     { return nil }
 func (ri mockRegionInfo) SetClient(RegionClient)            {}
 func (ri mockRegionInfo) Client() RegionClient              { return nil }
+func (ri mockRegionInfo) Replicas() []string                { return nil }
 
 type byFamily []*pb.MutationProto_ColumnValue
 
@@ -536,6 +663,14 @@ func bytesSlicesLen(a [][]byte) uint32 {
 	return l
 }
 
+// withNonce returns a copy of the request with the nonce of the mutation set
+func withNonce(r *pb.MutateRequest, nonce uint64) *pb.MutateRequest {
+	r = proto.Clone(r).(*pb.MutateRequest)
+	r.Mutation.Nonce = proto.Uint64(nonce)
+	r.NonceGroup = proto.Uint64(NonceGroup())
+	return r
+}
+
 func TestMutate(t *testing.T) {
 	var (
 		ctx      = context.Background()
@@ -1252,6 +1387,78 @@ func TestMutate(t *testing.T) {
 					"key" + "\x02" + "cf" + "a" +
 					"\x00\x00\x00\x00\x00\x00\x00*\b")},
 		},
+		{
+			in: func() (*Mutate, error) {
+				return NewDel(ctx, table, key, nil, DeleteMarkers(
+					DeleteColumnVersionMarker("cf", "a", MaxTimestamp),
+					DeleteFamilyMarker("cf2", MaxTimestamp),
+					DeleteColumnMarker("cf3", "b", 42)))
+			},
+			inStr: func() (*Mutate, error) {
+				return NewDelStr(ctx, tableStr, keyStr, nil, DeleteMarkers(
+					DeleteColumnVersionMarker("cf", "a", MaxTimestamp),
+					DeleteFamilyMarker("cf2", MaxTimestamp),
+					DeleteColumnMarker("cf3", "b", 42)))
+			},
+			out: &pb.MutateRequest{
+				Region: rs,
+				Mutation: &pb.MutationProto{
+					Row:        []byte(key),
+					MutateType: pb.MutationProto_DELETE.Enum(),
+					Durability: pb.MutationProto_USE_DEFAULT.Enum(),
+					ColumnValue: []*pb.MutationProto_ColumnValue{
+						&pb.MutationProto_ColumnValue{
+							Family: []byte("cf"),
+							QualifierValue: []*pb.MutationProto_ColumnValue_QualifierValue{
+								&pb.MutationProto_ColumnValue_QualifierValue{
+									Qualifier:  []byte("a"),
+									DeleteType: pb.MutationProto_DELETE_ONE_VERSION.Enum(),
+								},
+							},
+						},
+						&pb.MutationProto_ColumnValue{
+							Family: []byte("cf2"),
+							QualifierValue: []*pb.MutationProto_ColumnValue_QualifierValue{
+								&pb.MutationProto_ColumnValue_QualifierValue{
+									Qualifier:  []byte{},
+									DeleteType: pb.MutationProto_DELETE_FAMILY.Enum(),
+								},
+							},
+						},
+						&pb.MutationProto_ColumnValue{
+							Family: []byte("cf3"),
+							QualifierValue: []*pb.MutationProto_ColumnValue_QualifierValue{
+								&pb.MutationProto_ColumnValue_QualifierValue{
+									Qualifier:  []byte("b"),
+									Timestamp:  proto.Uint64(42),
+									DeleteType: pb.MutationProto_DELETE_MULTIPLE_VERSIONS.Enum(),
+								},
+							},
+						},
+					},
+				},
+			},
+			cellblocksProto: &pb.MutateRequest{
+				Region: rs,
+				Mutation: &pb.MutationProto{
+					Row:                 []byte(key),
+					MutateType:          pb.MutationProto_DELETE.Enum(),
+					Durability:          pb.MutationProto_USE_DEFAULT.Enum(),
+					AssociatedCellCount: proto.Int32(3),
+				},
+			},
+			cellblocksLen: 91,
+			cellblocks: [][]byte{
+				[]byte("\x00\x00\x00\x1a\x00\x00\x00\x12\x00\x00\x00\x00\x00\x03" +
+					"key" + "\x02" + "cf" + "a" +
+					"\x7f\xff\xff\xff\xff\xff\xff\xff\b" +
+					"\x00\x00\x00\x1a\x00\x00\x00\x12\x00\x00\x00\x00\x00\x03" +
+					"key" + "\x03" + "cf2" + "" +
+					"\x7f\xff\xff\xff\xff\xff\xff\xff\x0e" +
+					"\x00\x00\x00\x1b\x00\x00\x00\x13\x00\x00\x00\x00\x00\x03" +
+					"key" + "\x03" + "cf3" + "b" +
+					"\x00\x00\x00\x00\x00\x00\x00*\x0c")},
+		},
 		{
 			in: func() (*Mutate, error) {
 				return NewDel(ctx, table, key, nil, DeleteOneVersion())
@@ -1290,6 +1497,11 @@ func TestMutate(t *testing.T) {
 		}
 
 		tcase := tests[i]
+		if nonce := m.Nonce(); nonce != 0 {
+			// nonces are random
+			tcase.out = withNonce(tcase.out, nonce)
+			tcase.cellblocksProto = withNonce(tcase.cellblocksProto, nonce)
+		}
 
 		if !proto.Equal(tcase.out, mr) {
 			t.Errorf("expected %v, got %v", tcase.out, mr)
@@ -1409,6 +1621,97 @@ func TestDeserializeCellBlocksGet(t *testing.T) {
 	}
 }
 
+func TestMutateNonce(t *testing.T) {
+	ctx := context.Background()
+	values := map[string]map[string][]byte{"cf": map[string][]byte{"q": []byte("v")}}
+	inc1, _ := NewIncStrSingle(ctx, "table", "key", "cf", "q", 1)
+	inc2, _ := NewIncStrSingle(ctx, "table", "key", "cf", "q", 1)
+	app, _ := NewAppStr(ctx, "table", "key", values)
+	put, _ := NewPutStr(ctx, "table", "key", values, Nonce(42))
+	fixed, _ := NewAppStr(ctx, "table", "key", values, Nonce(42))
+	none, _ := NewAppStr(ctx, "table", "key", values, Nonce(0))
+
+	if NonceGroup() == 0 {
+		t.Error("expected a nonce group")
+	}
+	if inc1.Nonce() == 0 || app.Nonce() == 0 {
+		t.Errorf("expected increments and appends to have nonces, got %d and %d",
+			inc1.Nonce(), app.Nonce())
+	}
+	if inc1.Nonce() == inc2.Nonce() {
+		t.Errorf("expected different nonces of different calls, got %d", inc1.Nonce())
+	}
+	if fixed.Nonce() != 42 {
+		t.Errorf("expected nonce 42, got %d", fixed.Nonce())
+	}
+
+	for _, m := range []*Mutate{inc1, app, fixed} {
+		m.SetRegion(mockRegionInfo([]byte("region")))
+		// the nonce stays the same every time the call is sent
+		for i := 0; i < 2; i++ {
+			r := m.ToProto().(*pb.MutateRequest)
+			if r.GetMutation().GetNonce() != m.Nonce() {
+				t.Errorf("expected nonce %d, got %d", m.Nonce(), r.GetMutation().GetNonce())
+			}
+			if r.GetNonceGroup() != NonceGroup() {
+				t.Errorf("expected nonce group %d, got %d", NonceGroup(), r.GetNonceGroup())
+			}
+		}
+	}
+
+	for _, m := range []*Mutate{put, none} {
+		m.SetRegion(mockRegionInfo([]byte("region")))
+		if m.Nonce() != 0 {
+			t.Errorf("expected no nonce, got %d", m.Nonce())
+		}
+		r := m.ToProto().(*pb.MutateRequest)
+		if r.Mutation.Nonce != nil || r.NonceGroup != nil {
+			t.Errorf("expected no nonce to be sent, got %v", r)
+		}
+	}
+}
+
+func TestCellTimestamp(t *testing.T) {
+	ctx := context.Background()
+	values := map[string]map[string][]byte{"cf": map[string][]byte{
+		"a": []byte("1"),
+		"b": []byte("2"),
+		"c": []byte("3"),
+	}}
+	put, err := NewPutStr(ctx, "table", "key", values, TimestampUint64(30),
+		CellTimestamp("cf", "a", 10), CellTimestamp("cf", "b", 20))
+	if err != nil {
+		t.Fatal(err)
+	}
+	put.SetRegion(mockRegionInfo([]byte("region")))
+	exp := map[string]uint64{"a": 10, "b": 20, "c": 30}
+
+	r := put.ToProto().(*pb.MutateRequest)
+	for _, qv := range r.Mutation.ColumnValue[0].QualifierValue {
+		if ts := qv.GetTimestamp(); ts != exp[string(qv.Qualifier)] {
+			t.Errorf("expected timestamp %d of cell %s, got %d",
+				exp[string(qv.Qualifier)], qv.Qualifier, ts)
+		}
+	}
+
+	_, cellblocks, _ := put.SerializeCellBlocks(nil)
+	for q, ts := range exp {
+		cb := appendCellblock([]byte("key"), "cf", q, values["cf"][q], ts, putType, nil)
+		if !bytes.Contains(cellblocks[0], cb) {
+			t.Errorf("missing cellblock %q in %q", cb, cellblocks)
+		}
+	}
+
+	_, err = NewPutStr(ctx, "table", "key", values, CellTimestamp("cf", "d", 10))
+	if err == nil {
+		t.Error("expected an error setting the timestamp of a missing column")
+	}
+	_, err = NewGetStr(ctx, "table", "key", CellTimestamp("cf", "a", 10))
+	if err == nil {
+		t.Error("expected an error setting the timestamp of a cell of a get")
+	}
+}
+
 func TestDeserializeCellblocksMutate(t *testing.T) {
 	// the first cell is already in protobuf
 	mResp := &pb.MutateResponse{Result: &pb.Result{
@@ -1570,3 +1873,343 @@ func BenchmarkMutateToProtoWithNestedMaps(b *testing.B) {
 		}
 	}
 }
+
+func TestSplitAlgorithm(t *testing.T) {
+	for _, tcase := range []struct {
+		algorithm  SplitAlgorithm
+		numRegions int
+		expected   [][]byte
+	}{
+		{algorithm: UniformSplit, numRegions: 1},
+		{
+			algorithm:  UniformSplit,
+			numRegions: 4,
+			expected: [][]byte{
+				{0x40, 0, 0, 0, 0, 0, 0, 0},
+				{0x80, 0, 0, 0, 0, 0, 0, 0},
+				{0xc0, 0, 0, 0, 0, 0, 0, 0},
+			},
+		},
+		{
+			algorithm:  HexStringSplit,
+			numRegions: 5,
+			expected: [][]byte{
+				[]byte("33333333"), []byte("66666666"), []byte("99999999"), []byte("cccccccc"),
+			},
+		},
+		{
+			algorithm:  DecimalStringSplit,
+			numRegions: 5,
+			expected: [][]byte{
+				[]byte("20000000"), []byte("40000000"), []byte("60000000"), []byte("80000000"),
+			},
+		},
+	} {
+		got := tcase.algorithm.Split(tcase.numRegions)
+		if !reflect.DeepEqual(tcase.expected, got) {
+			t.Errorf("expected %q, got %q", tcase.expected, got)
+		}
+	}
+}
+
+func TestCreateTableSplitRegions(t *testing.T) {
+	ct := NewCreateTable(context.Background(), []byte("test"), nil,
+		SplitKeys([][]byte{[]byte("a")}),
+		SplitRegions(HexStringSplit, 5))
+	p := ct.ToProto().(*pb.CreateTableRequest)
+	if expected := HexStringSplit.Split(5); !reflect.DeepEqual(expected, p.SplitKeys) {
+		t.Errorf("expected split keys %q, got %q", expected, p.SplitKeys)
+	}
+}
+
+func TestApplyDefaults(t *testing.T) {
+	ctx := context.Background()
+	defaults := []func(Call) error{
+		MaxVersions(5),
+		Durability(SkipWal),
+		Families(map[string][]string{"cf": nil}),
+	}
+
+	get, err := NewGetStr(ctx, "table", "row", MaxVersions(2))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ApplyDefaults(get, defaults...)
+	if get.maxVersions != 2 {
+		t.Errorf("expected MaxVersions of the call to win, got %d", get.maxVersions)
+	}
+	if !reflect.DeepEqual(get.families, map[string][]string{"cf": nil}) {
+		t.Errorf("expected default families, got %v", get.families)
+	}
+	// Durability can't be used with Get
+	if len(get.Options()) != 3 {
+		t.Errorf("expected 2 defaults and 1 own option, got %d", len(get.Options()))
+	}
+
+	marker := DeleteFamilyMarker("cf", MaxTimestamp)
+	del, err := NewDelStr(ctx, "table", "row", nil, DeleteMarkers(marker))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ApplyDefaults(del, defaults...)
+	if del.durability != SkipWal {
+		t.Errorf("expected default durability, got %v", del.durability)
+	}
+	if !reflect.DeepEqual(del.deleteMarkers, []DeleteMarker{marker}) {
+		t.Errorf("expected delete markers to be added once, got %v", del.deleteMarkers)
+	}
+}
+
+func TestCellTime(t *testing.T) {
+	for name, tcase := range map[string]struct {
+		ts  *uint64
+		exp time.Time
+		ok  bool
+	}{
+		"millis": {
+			ts:  proto.Uint64(1434573235908),
+			exp: time.Date(2015, 6, 17, 20, 33, 55, 908e6, time.UTC),
+			ok:  true,
+		},
+		"epoch":  {ts: proto.Uint64(0), exp: time.Unix(0, 0), ok: true},
+		"latest": {ts: proto.Uint64(LatestTimestamp)},
+		"max":    {ts: proto.Uint64(MaxTimestamp)},
+		"none":   {},
+	} {
+		c := &Cell{Timestamp: tcase.ts}
+		got, ok := c.Time()
+		if ok != tcase.ok || !got.Equal(tcase.exp) {
+			t.Errorf("%s: expected %v, %v, got %v, %v", name, tcase.exp, tcase.ok, got, ok)
+		}
+	}
+}
+
+func TestTargetRegionServer(t *testing.T) {
+	ctx := context.Background()
+	get, err := NewGetStr(ctx, "table", "key", TargetRegionServer("host:16020"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if get.TargetRegionServer() != "host:16020" || !get.SkipBatch() {
+		t.Errorf("expected unbatched Get sent to host:16020, got %q, skip batch %v",
+			get.TargetRegionServer(), get.SkipBatch())
+	}
+	put, err := NewPutStr(ctx, "table", "key", nil, TargetRegionServer("host:16020"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if put.TargetRegionServer() != "host:16020" || !put.SkipBatch() {
+		t.Errorf("expected unbatched Put sent to host:16020, got %q, skip batch %v",
+			put.TargetRegionServer(), put.SkipBatch())
+	}
+	if _, err := NewScanStr(ctx, "table", TargetRegionServer("host:16020")); err == nil {
+		t.Error("expected an error for Scan")
+	}
+}
+
+func TestAttribute(t *testing.T) {
+	ctx := context.Background()
+	get, err := NewGetStr(ctx, "table", "key",
+		Attribute("a", []byte("1")),
+		Attribute("b", []byte("2")),
+		Attribute("a", []byte("3")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	get.SetRegion(mockRegionInfo([]byte("region")))
+	b, err := proto.Marshal(get.ToProto())
+	if err != nil {
+		t.Fatal(err)
+	}
+	var req pb.GetRequest
+	if err := proto.Unmarshal(b, &req); err != nil {
+		t.Fatal(err)
+	}
+	expected := []*pb.NameBytesPair{
+		{Name: proto.String("a"), Value: []byte("3")},
+		{Name: proto.String("b"), Value: []byte("2")},
+	}
+	attrs := req.GetGet().GetAttribute()
+	if len(attrs) != len(expected) {
+		t.Fatalf("expected attributes %v, got %v", expected, attrs)
+	}
+	for i, a := range attrs {
+		if !proto.Equal(a, expected[i]) {
+			t.Errorf("expected attribute %v, got %v", expected[i], a)
+		}
+	}
+
+	put, err := NewPutStr(ctx, "table", "key", nil,
+		Attribute("a", []byte("1")), TTL(time.Second))
+	if err != nil {
+		t.Fatal(err)
+	}
+	put.SetRegion(mockRegionInfo([]byte("region")))
+	mut := put.ToProto().(*pb.MutateRequest).GetMutation()
+	if attrs := mut.GetAttribute(); len(attrs) != 2 || attrs[0].GetName() != "a" ||
+		attrs[1].GetName() != attributeNameTTL {
+		t.Errorf("expected attributes a and %s, got %v", attributeNameTTL, attrs)
+	}
+
+	// TTL takes precedence over an attribute with the same name
+	put, err = NewPutStr(ctx, "table", "key", nil,
+		Attribute(attributeNameTTL, []byte("1")), TTL(time.Second))
+	if err != nil {
+		t.Fatal(err)
+	}
+	put.SetRegion(mockRegionInfo([]byte("region")))
+	mut = put.ToProto().(*pb.MutateRequest).GetMutation()
+	if attrs := mut.GetAttribute(); len(attrs) != 1 ||
+		attrs[0].GetName() != attributeNameTTL || !bytes.Equal(attrs[0].GetValue(), put.ttl) {
+		t.Errorf("expected a single attribute %s of the TTL, got %v", attributeNameTTL, attrs)
+	}
+
+	if _, err := NewListTableNames(ctx, Attribute("a", nil)); err == nil {
+		t.Error("expected an error for ListTableNames")
+	}
+}
+
+func TestSortCells(t *testing.T) {
+	cell := func(family, qualifier string, ts uint64) *Cell {
+		return &Cell{Family: []byte(family), Qualifier: []byte(qualifier), Timestamp: &ts}
+	}
+	res := &Result{Cells: []*Cell{
+		cell("b", "a", 1),
+		cell("a", "b", 1),
+		cell("a", "a", 1),
+		cell("a", "b", 3),
+		cell("a", "a", 2),
+	}}
+	res.SortCells()
+	expected := []*Cell{
+		cell("a", "a", 2),
+		cell("a", "a", 1),
+		cell("a", "b", 3),
+		cell("a", "b", 1),
+		cell("b", "a", 1),
+	}
+	if !reflect.DeepEqual(expected, res.Cells) {
+		t.Errorf("expected cells %v, got %v", expected, res.Cells)
+	}
+
+	get, err := NewGetStr(context.Background(), "table", "key", SortedCells())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !get.SortedCells() {
+		t.Error("expected the cells of the Get to be sorted")
+	}
+	if _, err := NewScanStr(context.Background(), "table", SortedCells()); err == nil {
+		t.Error("expected an error for Scan")
+	}
+}
+
+func TestMaxLengths(t *testing.T) {
+	ctx := context.Background()
+	values := func(family, qualifier string, value []byte) map[string]map[string][]byte {
+		return map[string]map[string][]byte{family: {qualifier: value}}
+	}
+	for name, tcase := range map[string]struct {
+		newCall func() (Call, error)
+		err     error
+	}{
+		"valid get": {
+			newCall: func() (Call, error) {
+				return NewGetStr(ctx, "table", "key",
+					Families(map[string][]string{"cf": {"q"}}))
+			},
+		},
+		"valid put": {
+			newCall: func() (Call, error) {
+				return NewPutStr(ctx, "table", "key", values("cf", "q", []byte("v")))
+			},
+		},
+		"empty get": {
+			newCall: func() (Call, error) { return NewGetStr(ctx, "table", "") },
+			err:     ErrEmptyRow,
+		},
+		"empty put": {
+			newCall: func() (Call, error) {
+				return NewPutStr(ctx, "table", "", values("cf", "q", []byte("v")))
+			},
+			err: ErrEmptyRow,
+		},
+		"long row": {
+			newCall: func() (Call, error) {
+				return NewGet(ctx, []byte("table"), make([]byte, DefaultMaxRowLength+1))
+			},
+			err: ErrTooLong,
+		},
+		"long family": {
+			newCall: func() (Call, error) {
+				return NewPutStr(ctx, "table", "key",
+					values(strings.Repeat("f", 128), "q", []byte("v")))
+			},
+			err: ErrTooLong,
+		},
+		"long qualifier": {
+			newCall: func() (Call, error) {
+				return NewPutStr(ctx, "table", "key", values("cf", "qualifier", nil),
+					MaxLengths(0, 4, 0))
+			},
+			err: ErrTooLong,
+		},
+		"long qualifier of get": {
+			newCall: func() (Call, error) {
+				return NewGetStr(ctx, "table", "key",
+					Families(map[string][]string{"cf": {"qualifier"}}), MaxLengths(0, 4, 0))
+			},
+			err: ErrTooLong,
+		},
+		"long value": {
+			newCall: func() (Call, error) {
+				return NewPutStr(ctx, "table", "key", values("cf", "q", make([]byte, 5)),
+					MaxLengths(0, 0, 4))
+			},
+			err: ErrTooLong,
+		},
+		"unlimited value": {
+			newCall: func() (Call, error) {
+				return NewPutStr(ctx, "table", "key",
+					values("cf", "q", make([]byte, 11*1024*1024)))
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := tcase.newCall()
+			if !errors.Is(err, tcase.err) || (tcase.err == nil) != (err == nil) {
+				t.Errorf("expected error %v, got %v", tcase.err, err)
+			}
+		})
+	}
+
+	if _, err := NewScanStr(ctx, "table", MaxLengths(1, 1, 1)); err == nil {
+		t.Error("expected an error for Scan")
+	}
+}
+
+func TestKeyValueSizes(t *testing.T) {
+	ctx := context.Background()
+	put, err := NewPutStr(ctx, "table", "row", map[string]map[string][]byte{
+		"cf": {"a": []byte("1"), "bb": []byte("1234")},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 4 + 4 + 2 + len("row") + 1 + len("cf") + 8 + 1 = 25 bytes and the
+	// lengths of the qualifier and value of each cell
+	const small, large = 25 + 1 + 1, 25 + 2 + 4
+	if total, largest := put.KeyValueSizes(); total != small+large || largest != large {
+		t.Errorf("expected sizes %d and %d, got %d and %d", small+large, large, total, largest)
+	}
+
+	cm, err := NewCheckAndMutate([]*Mutate{put, put}, "cf", "a", filter.Equal,
+		filter.NewBinaryComparator(filter.NewByteArrayComparable([]byte("1"))))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if total, largest := cm.KeyValueSizes(); total != 2*(small+large) || largest != large {
+		t.Errorf("expected sizes %d and %d, got %d and %d",
+			2*(small+large), large, total, largest)
+	}
+}
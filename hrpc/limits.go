@@ -0,0 +1,165 @@
+// Copyright (C) 2026  The GoHBase Authors.  All rights reserved.
+// This file is part of GoHBase.
+// Use of this source code is governed by the Apache License 2.0
+// that can be found in the COPYING file.
+
+package hrpc
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+const (
+	// DefaultMaxRowLength is the maximum length of a row key in HBase.
+	DefaultMaxRowLength = math.MaxInt16
+	// DefaultMaxQualifierLength is the maximum length of a qualifier in HBase.
+	DefaultMaxQualifierLength = math.MaxInt32
+
+	// maxFamilyLength is the maximum length of a column family name in HBase.
+	maxFamilyLength = math.MaxInt8
+)
+
+var (
+	// ErrEmptyRow is returned when creating a Get or a Mutate without a row key.
+	ErrEmptyRow = errors.New("row key is empty")
+
+	// ErrTooLong is returned when creating a Get or a Mutate with a row key,
+	// a family, a qualifier or a value longer than allowed, see MaxLengths.
+	ErrTooLong = errors.New("too long")
+)
+
+// lengthLimits are the maximum lengths checked when creating Gets and Mutates.
+// The zero value means the limits of HBase. Values aren't limited if value is 0.
+type lengthLimits struct {
+	row, qualifier, value int
+}
+
+var defaultLengthLimits = lengthLimits{
+	row:       DefaultMaxRowLength,
+	qualifier: DefaultMaxQualifierLength,
+}
+
+// hasLengthLimits is implemented by calls that check the lengths
+// of their row key, qualifiers and values (Get and Mutate).
+type hasLengthLimits interface {
+	setLengthLimits(limits lengthLimits)
+}
+
+// MaxLengths is an option for Get and Mutate requests that sets the maximum
+// lengths of their row key, qualifiers and values, which are checked when they
+// are created, in order to fail early with a descriptive error instead of the
+// one of the RegionServer. By default, row keys and qualifiers are limited to
+// the lengths HBase supports, see DefaultMaxRowLength and
+// DefaultMaxQualifierLength, and zero keeps these defaults. Values aren't
+// limited by default nor if value is zero, since their limit depends on the
+// configuration of the cluster (hbase.client.keyvalue.maxsize). Unlike the
+// MaxCellSize option of the client, which applies to all mutations and
+// limits the size of the KeyValues their cells are sent as, value limits the
+// length of the values of a single request. Row keys must not be empty in
+// any case.
+func MaxLengths(row, qualifier, value int) func(Call) error {
+	return func(c Call) error {
+		l, ok := c.(hasLengthLimits)
+		if !ok {
+			return errors.New("'MaxLengths' option only works with Get and Mutate requests")
+		}
+		limits := defaultLengthLimits
+		if row > 0 {
+			limits.row = row
+		}
+		if qualifier > 0 {
+			limits.qualifier = qualifier
+		}
+		limits.value = value
+		l.setLengthLimits(limits)
+		return nil
+	}
+}
+
+// orDefault returns the limits of HBase if l is the zero value, or l otherwise.
+func (l lengthLimits) orDefault() lengthLimits {
+	if l == (lengthLimits{}) {
+		return defaultLengthLimits
+	}
+	return l
+}
+
+// checkRow returns an error if key is empty or longer than allowed.
+func (l lengthLimits) checkRow(key []byte) error {
+	if len(key) == 0 {
+		return ErrEmptyRow
+	}
+	if len(key) > l.row {
+		return fmt.Errorf("row key of %d bytes is %w, the maximum is %d",
+			len(key), ErrTooLong, l.row)
+	}
+	return nil
+}
+
+// checkColumn returns an error if family or qualifier is longer than allowed.
+func (l lengthLimits) checkColumn(family, qualifier string) error {
+	if len(family) > maxFamilyLength {
+		return fmt.Errorf("family of %d bytes is %w, the maximum is %d",
+			len(family), ErrTooLong, maxFamilyLength)
+	}
+	if len(qualifier) > l.qualifier {
+		return fmt.Errorf("qualifier of %d bytes in family %q is %w, the maximum is %d",
+			len(qualifier), family, ErrTooLong, l.qualifier)
+	}
+	return nil
+}
+
+// checkValue returns an error if the value of family:qualifier is longer
+// than allowed.
+func (l lengthLimits) checkValue(family, qualifier string, value []byte) error {
+	if l.value > 0 && len(value) > l.value {
+		return fmt.Errorf("value of %d bytes of %q:%q is %w, the maximum is %d",
+			len(value), family, qualifier, ErrTooLong, l.value)
+	}
+	return nil
+}
+
+// checkLengths returns an error if the row key of g is empty or if it or the
+// columns of g are longer than allowed.
+func (g *Get) checkLengths() error {
+	limits := g.limits.orDefault()
+	if err := limits.checkRow(g.key); err != nil {
+		return err
+	}
+	for family, qualifiers := range g.families {
+		if err := limits.checkColumn(family, ""); err != nil {
+			return err
+		}
+		for _, qualifier := range qualifiers {
+			if err := limits.checkColumn(family, qualifier); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// checkLengths returns an error if the row key of m is empty or if it or the
+// columns and values of m are longer than allowed.
+func (m *Mutate) checkLengths() error {
+	limits := m.limits.orDefault()
+	if err := limits.checkRow(m.key); err != nil {
+		return err
+	}
+	for family, qualifiers := range m.values {
+		if err := limits.checkColumn(family, ""); err != nil {
+			return err
+		}
+		for qualifier, value := range qualifiers {
+			if err := limits.checkColumn(family, qualifier); err != nil {
+				return err
+			}
+			if err := limits.checkValue(family, qualifier, value); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,61 @@
+// Copyright (C) 2026  The GoHBase Authors.  All rights reserved.
+// This file is part of GoHBase.
+// Use of this source code is governed by the Apache License 2.0
+// that can be found in the COPYING file.
+
+package hrpc
+
+import (
+	"context"
+
+	"github.com/baiweiguo/gohbase/pb"
+	"google.golang.org/protobuf/proto"
+)
+
+// MergeRegions asks the master to merge two regions of a table into one.
+type MergeRegions struct {
+	base
+	req *pb.MergeTableRegionsRequest
+}
+
+// NewMergeRegions creates an hrpc to merge the regions with the given encoded
+// names. Regions that aren't adjacent are only merged if forcible is true.
+func NewMergeRegions(ctx context.Context, regionA, regionB []byte,
+	forcible bool) *MergeRegions {
+	return &MergeRegions{
+		base: base{
+			ctx:      ctx,
+			resultch: make(chan RPCResult, 1),
+		},
+		req: &pb.MergeTableRegionsRequest{
+			Region: []*pb.RegionSpecifier{{
+				Type:  pb.RegionSpecifier_ENCODED_REGION_NAME.Enum(),
+				Value: regionA,
+			}, {
+				Type:  pb.RegionSpecifier_ENCODED_REGION_NAME.Enum(),
+				Value: regionB,
+			}},
+			Forcible: proto.Bool(forcible),
+		},
+	}
+}
+
+// Name returns the name of this RPC call.
+func (mr *MergeRegions) Name() string {
+	return "MergeTableRegions"
+}
+
+// Description returns the description of this RPC call.
+func (mr *MergeRegions) Description() string {
+	return mr.Name()
+}
+
+// ToProto converts the RPC into a protobuf message.
+func (mr *MergeRegions) ToProto() proto.Message {
+	return mr.req
+}
+
+// NewResponse creates an empty protobuf message to read the response of this RPC.
+func (mr *MergeRegions) NewResponse() proto.Message {
+	return &pb.MergeTableRegionsResponse{}
+}
@@ -7,8 +7,10 @@ package hrpc
 
 import (
 	"context"
+	"crypto/rand"
 	"encoding/binary"
 	"errors"
+	"fmt"
 	"math"
 	"time"
 
@@ -18,6 +20,29 @@ import (
 
 var attributeNameTTL = "_ttl"
 
+// nonceGroup identifies this process in the nonces of its mutations
+var nonceGroup = newNonce()
+
+// newNonce returns a random nonce. Zero means no nonce to HBase, so it's never
+// returned.
+func newNonce() uint64 {
+	var b [8]byte
+	for {
+		if _, err := rand.Read(b[:]); err != nil {
+			panic(fmt.Sprintf("failed to generate nonce: %s", err))
+		}
+		if n := binary.BigEndian.Uint64(b[:]); n != 0 {
+			return n
+		}
+	}
+}
+
+// NonceGroup returns the nonce group sent along with the nonces of increments
+// and appends of this process.
+func NonceGroup() uint64 {
+	return nonceGroup
+}
+
 // DurabilityType is used to set durability for Durability option
 type DurabilityType int32
 
@@ -58,6 +83,27 @@ type Mutate struct {
 	durability       DurabilityType
 	deleteOneVersion bool
 	skipbatch        bool
+	// targetServer is the address of the RegionServer to send the request to
+	targetServer string
+
+	// deleteMarkers are deletes with their own type and timestamp
+	// that are sent along with the ones for values
+	deleteMarkers []DeleteMarker
+
+	// cellTimestamps are the timestamps of cells set with CellTimestamp option,
+	// keyed by family and qualifier, overriding timestamp
+	cellTimestamps map[string]map[string]uint64
+
+	// nonce lets regionservers recognize retries of increments and appends
+	// that have already been applied
+	nonce    uint64
+	nonceSet bool
+
+	// attributes are the operation attributes set with Attribute option
+	attributes []*pb.NameBytesPair
+
+	// limits are the maximum lengths set with MaxLengths option
+	limits lengthLimits
 }
 
 // TTL sets a time-to-live for mutation queries.
@@ -105,6 +151,31 @@ func TimestampUint64(ts uint64) func(Call) error {
 	}
 }
 
+// CellTimestamp sets the timestamp of the cell of a column of a mutation
+// to ts in milliseconds, instead of the one of the whole mutation set with
+// Timestamp option, e.g. so that a single put backfills columns with
+// different timestamps. The mutation must have a value of the column.
+func CellTimestamp(family, qualifier string, ts uint64) func(Call) error {
+	return func(o Call) error {
+		m, ok := o.(*Mutate)
+		if !ok {
+			return errors.New("'CellTimestamp' option can only be used with mutation queries")
+		}
+		if _, ok := m.values[family][qualifier]; !ok {
+			return fmt.Errorf("'CellTimestamp' option needs a value of column %s:%s",
+				family, qualifier)
+		}
+		if m.cellTimestamps == nil {
+			m.cellTimestamps = make(map[string]map[string]uint64)
+		}
+		if m.cellTimestamps[family] == nil {
+			m.cellTimestamps[family] = make(map[string]uint64)
+		}
+		m.cellTimestamps[family][qualifier] = ts
+		return nil
+	}
+}
+
 // Durability sets durability for mutation queries.
 func Durability(d DurabilityType) func(Call) error {
 	return func(o Call) error {
@@ -137,6 +208,84 @@ func DeleteOneVersion() func(Call) error {
 	}
 }
 
+// DeleteMarker is a delete of a column or a column family with its own type
+// and timestamp that can be added to a delete request with DeleteMarkers option.
+type DeleteMarker struct {
+	family     string
+	qualifier  string
+	deleteType pb.MutationProto_DeleteType
+	timestamp  uint64
+}
+
+// DeleteColumnMarker returns a marker deleting all versions of the column at
+// and before ts. If ts is MaxTimestamp, the timestamp of the request is used.
+func DeleteColumnMarker(family, qualifier string, ts uint64) DeleteMarker {
+	return DeleteMarker{family, qualifier, pb.MutationProto_DELETE_MULTIPLE_VERSIONS, ts}
+}
+
+// DeleteColumnVersionMarker returns a marker deleting the version of the column at ts.
+// If ts is MaxTimestamp and the request has no timestamp, the latest version is deleted.
+func DeleteColumnVersionMarker(family, qualifier string, ts uint64) DeleteMarker {
+	return DeleteMarker{family, qualifier, pb.MutationProto_DELETE_ONE_VERSION, ts}
+}
+
+// DeleteFamilyMarker returns a marker deleting all versions of all the columns
+// of the family at and before ts. If ts is MaxTimestamp, the timestamp
+// of the request is used.
+func DeleteFamilyMarker(family string, ts uint64) DeleteMarker {
+	return DeleteMarker{family, "", pb.MutationProto_DELETE_FAMILY, ts}
+}
+
+// DeleteFamilyVersionMarker returns a marker deleting the versions of all the
+// columns of the family at exactly ts.
+func DeleteFamilyVersionMarker(family string, ts uint64) DeleteMarker {
+	return DeleteMarker{family, "", pb.MutationProto_DELETE_FAMILY_VERSION, ts}
+}
+
+// cellType returns the type of the cell of the marker in cellblocks.
+func (dm DeleteMarker) cellType() byte {
+	switch dm.deleteType {
+	case pb.MutationProto_DELETE_ONE_VERSION:
+		return deleteType
+	case pb.MutationProto_DELETE_FAMILY:
+		return deleteFamilyType
+	case pb.MutationProto_DELETE_FAMILY_VERSION:
+		return deleteFamilyVersionType
+	default:
+		return deleteColumnType
+	}
+}
+
+// DeleteMarkers is a delete option that adds the markers to the request, so that
+// a single delete can remove a mix of columns, families and versions of cells.
+// The markers are sent along with the deletes of the values of the request,
+// which can be nil.
+func DeleteMarkers(markers ...DeleteMarker) func(Call) error {
+	return func(o Call) error {
+		m, ok := o.(*Mutate)
+		if !ok {
+			return errors.New("'DeleteMarkers' option can only be used with mutation queries")
+		}
+		m.deleteMarkers = append(m.deleteMarkers, markers...)
+		return nil
+	}
+}
+
+// Nonce sets the nonce of increments and appends, which is otherwise random,
+// e.g. to make the regionserver recognize a mutation that has been recreated
+// to be retried. Nonce 0 sends the mutation without a nonce. The option has
+// no effect on other mutations.
+func Nonce(nonce uint64) func(Call) error {
+	return func(o Call) error {
+		m, ok := o.(*Mutate)
+		if !ok {
+			return errors.New("'Nonce' option can only be used with mutation queries")
+		}
+		m.nonce, m.nonceSet = nonce, true
+		return nil
+	}
+}
+
 // baseMutate returns a Mutate struct without the mutationType filled in.
 func baseMutate(ctx context.Context, table, key []byte, values map[string]map[string][]byte,
 	options ...func(Call) error) (*Mutate, error) {
@@ -154,6 +303,9 @@ func baseMutate(ctx context.Context, table, key []byte, values map[string]map[st
 	if err != nil {
 		return nil, err
 	}
+	if err := m.checkLengths(); err != nil {
+		return nil, err
+	}
 	return m, nil
 }
 
@@ -202,6 +354,9 @@ func NewPutStr(ctx context.Context, table, key string,
 // passed, only the latest version will be removed. For delete specific families request,
 // the timestamp should be passed or it will have no effect as it's an expensive
 // operation to perform.
+//
+// To delete columns, families and versions with different types or timestamps
+// in a single request, pass hrpc.DeleteMarkers() option.
 func NewDel(ctx context.Context, table, key []byte,
 	values map[string]map[string][]byte, options ...func(Call) error) (*Mutate, error) {
 	m, err := baseMutate(ctx, table, key, values, options...)
@@ -209,7 +364,7 @@ func NewDel(ctx context.Context, table, key []byte,
 		return nil, err
 	}
 
-	if len(m.values) == 0 && m.deleteOneVersion {
+	if len(m.values) == 0 && len(m.deleteMarkers) == 0 && m.deleteOneVersion {
 		return nil, errors.New(
 			"'DeleteOneVersion' option cannot be specified for delete entire row request")
 	}
@@ -234,6 +389,7 @@ func NewApp(ctx context.Context, table, key []byte,
 		return nil, err
 	}
 	m.mutationType = pb.MutationProto_APPEND
+	m.setNonce()
 	return m, nil
 }
 
@@ -268,6 +424,7 @@ func NewInc(ctx context.Context, table, key []byte,
 		return nil, err
 	}
 	m.mutationType = pb.MutationProto_INCREMENT
+	m.setNonce()
 	return m, nil
 }
 
@@ -301,10 +458,66 @@ func (m *Mutate) Values() map[string]map[string][]byte {
 	return m.values
 }
 
+// KeyValueSizes returns the total size of the KeyValues the cells of the
+// mutation are sent to HBase as, and the size of the largest of them.
+func (m *Mutate) KeyValueSizes() (total, largest int64) {
+	for family, qualifiers := range m.values {
+		for qualifier, value := range qualifiers {
+			// lengths of key and value, row length, row, family length,
+			// family, qualifier, timestamp, type and value
+			size := int64(4 + 4 + 2 + len(m.key) + 1 + len(family) + len(qualifier) +
+				8 + 1 + len(value))
+			total += size
+			if size > largest {
+				largest = size
+			}
+		}
+	}
+	return total, largest
+}
+
+// Nonce returns the nonce sent with the mutation, which stays the same when
+// the mutation is retried. Only increments and appends have a nonce, it's 0
+// for other mutations or if it has been set to 0 with the Nonce option.
+func (m *Mutate) Nonce() uint64 {
+	if m.mutationType != pb.MutationProto_INCREMENT &&
+		m.mutationType != pb.MutationProto_APPEND {
+		return 0
+	}
+	return m.nonce
+}
+
+// setNonce generates a nonce unless one has been set with the Nonce option
+func (m *Mutate) setNonce() {
+	if !m.nonceSet {
+		m.nonce = newNonce()
+	}
+}
+
 func (m *Mutate) setSkipBatch(v bool) {
 	m.skipbatch = v
 }
 
+func (m *Mutate) writesRows() {}
+
+func (m *Mutate) setLengthLimits(limits lengthLimits) {
+	m.limits = limits
+}
+
+func (m *Mutate) setAttribute(key string, value []byte) {
+	m.attributes = setAttribute(m.attributes, key, value)
+}
+
+// TargetRegionServer returns the address of the RegionServer set with
+// TargetRegionServer option, or an empty string if it wasn't set.
+func (m *Mutate) TargetRegionServer() string {
+	return m.targetServer
+}
+
+func (m *Mutate) setTargetRegionServer(addr string) {
+	m.targetServer = addr
+}
+
 var (
 	MutationProtoDeleteFamilyVersion    = pb.MutationProto_DELETE_FAMILY_VERSION.Enum()
 	MutationProtoDeleteFamily           = pb.MutationProto_DELETE_FAMILY.Enum()
@@ -346,10 +559,14 @@ func (m *Mutate) valuesToProto(ts *uint64) []*pb.MutationProto_ColumnValue {
 		qvs := make([]*pb.MutationProto_ColumnValue_QualifierValue, len(v))
 		j := 0
 		for k1, v1 := range v {
+			cellTs := ts
+			if t, ok := m.cellTimestamps[k][k1]; ok {
+				cellTs = proto.Uint64(t)
+			}
 			qvs[j] = &pb.MutationProto_ColumnValue_QualifierValue{
 				Qualifier:  []byte(k1),
 				Value:      v1,
-				Timestamp:  ts,
+				Timestamp:  cellTs,
 				DeleteType: dt,
 			}
 			j++
@@ -360,6 +577,20 @@ func (m *Mutate) valuesToProto(ts *uint64) []*pb.MutationProto_ColumnValue {
 		}
 		i++
 	}
+	for _, dm := range m.deleteMarkers {
+		markerTs := ts
+		if dm.timestamp != MaxTimestamp {
+			markerTs = proto.Uint64(dm.timestamp)
+		}
+		cvs = append(cvs, &pb.MutationProto_ColumnValue{
+			Family: []byte(dm.family),
+			QualifierValue: []*pb.MutationProto_ColumnValue_QualifierValue{{
+				Qualifier:  []byte(dm.qualifier),
+				Timestamp:  markerTs,
+				DeleteType: dm.deleteType.Enum(),
+			}},
+		})
+	}
 	return cvs
 }
 
@@ -425,7 +656,7 @@ func appendCellblock(row []byte, family, qualifier string, value []byte, ts uint
 }
 
 func (m *Mutate) valuesToCellblocks() ([]byte, int32, uint32) {
-	if len(m.values) == 0 {
+	if len(m.values) == 0 && len(m.deleteMarkers) == 0 {
 		return nil, 0, 0
 	}
 	var cbsLen int
@@ -439,6 +670,10 @@ func (m *Mutate) valuesToCellblocks() ([]byte, int32, uint32) {
 			cbsLen += cellblockLen(len(m.key), len(family), len(k1), len(v1))
 		}
 	}
+	count += len(m.deleteMarkers)
+	for _, dm := range m.deleteMarkers {
+		cbsLen += cellblockLen(len(m.key), len(dm.family), len(dm.qualifier), 0)
+	}
 	cbs := make([]byte, 0, cbsLen)
 
 	var ts uint64
@@ -475,9 +710,20 @@ func (m *Mutate) valuesToCellblocks() ([]byte, int32, uint32) {
 		}
 
 		for k1, v1 := range v {
-			cbs = appendCellblock(m.key, family, k1, v1, ts, mt, cbs)
+			cellTs := ts
+			if t, ok := m.cellTimestamps[family][k1]; ok {
+				cellTs = t
+			}
+			cbs = appendCellblock(m.key, family, k1, v1, cellTs, mt, cbs)
 		}
 	}
+	for _, dm := range m.deleteMarkers {
+		markerTs := ts
+		if dm.timestamp != MaxTimestamp {
+			markerTs = dm.timestamp
+		}
+		cbs = appendCellblock(m.key, dm.family, dm.qualifier, nil, markerTs, dm.cellType(), cbs)
+	}
 	if len(cbs) != cbsLen {
 		panic("cellblocks len mismatch")
 	}
@@ -493,6 +739,21 @@ var durabilities = []*pb.MutationProto_Durability{
 }
 
 func (m *Mutate) toProto(isCellblocks bool, cbs [][]byte) (*pb.MutateRequest, [][]byte, uint32) {
+	mProto, cbs, size := m.mutationToProto(isCellblocks, cbs)
+	req := &pb.MutateRequest{
+		Region:   m.regionSpecifier(),
+		Mutation: mProto,
+	}
+	if m.Nonce() != 0 {
+		req.NonceGroup = &nonceGroup
+	}
+	return req, cbs, size
+}
+
+// mutationToProto converts the mutation into a protobuf message
+// independently of the region of the request.
+func (m *Mutate) mutationToProto(isCellblocks bool,
+	cbs [][]byte) (*pb.MutationProto, [][]byte, uint32) {
 	var ts *uint64
 	if m.timestamp != MaxTimestamp {
 		ts = &m.timestamp
@@ -520,17 +781,15 @@ func (m *Mutate) toProto(isCellblocks bool, cbs [][]byte) (*pb.MutateRequest, []
 		mProto.ColumnValue = m.valuesToProto(ts)
 	}
 
+	mProto.Attribute = append(mProto.Attribute, m.attributes...)
 	if len(m.ttl) > 0 {
-		mProto.Attribute = append(mProto.Attribute, &pb.NameBytesPair{
-			Name:  &attributeNameTTL,
-			Value: m.ttl,
-		})
+		mProto.Attribute = setAttribute(mProto.Attribute, attributeNameTTL, m.ttl)
 	}
 
-	return &pb.MutateRequest{
-		Region:   m.regionSpecifier(),
-		Mutation: mProto,
-	}, cbs, size
+	if m.Nonce() != 0 {
+		mProto.Nonce = &m.nonce
+	}
+	return mProto, cbs, size
 }
 
 // ToProto converts this mutate RPC into a protobuf message
@@ -6,8 +6,10 @@
 package hrpc
 
 import (
+	"bytes"
 	"errors"
 	"math"
+	"sort"
 	"time"
 
 	"github.com/baiweiguo/gohbase/filter"
@@ -25,6 +27,14 @@ type baseQuery struct {
 	storeOffset   uint32
 	cacheBlocks   bool
 	consistency   ConsistencyType
+	replicaID     int
+
+	// cfTimeRanges are time ranges of individual column families
+	// that take precedence over the time range of the query
+	cfTimeRanges map[string][2]uint64
+
+	// attributes are the operation attributes set with Attribute option
+	attributes []*pb.NameBytesPair
 }
 
 // ConsistencyType is used to specify the required consistency of data
@@ -82,6 +92,12 @@ func (bq *baseQuery) setTimeRangeUint64(from, to uint64) {
 	bq.fromTimestamp = from
 	bq.toTimestamp = to
 }
+func (bq *baseQuery) setColumnFamilyTimeRangeUint64(family string, from, to uint64) {
+	if bq.cfTimeRanges == nil {
+		bq.cfTimeRanges = make(map[string][2]uint64)
+	}
+	bq.cfTimeRanges[family] = [2]uint64{from, to}
+}
 func (bq *baseQuery) setMaxVersions(versions uint32) {
 	bq.maxVersions = versions
 }
@@ -97,6 +113,19 @@ func (bq *baseQuery) setCacheBlocks(cacheBlocks bool) {
 func (bq *baseQuery) setConsistency(consistency ConsistencyType) {
 	bq.consistency = consistency
 }
+func (bq *baseQuery) setReplicaID(id int) {
+	bq.replicaID = id
+}
+func (bq *baseQuery) setAttribute(key string, value []byte) {
+	bq.attributes = setAttribute(bq.attributes, key, value)
+}
+func (bq *baseQuery) readsRows() {}
+
+// ReplicaID returns the ID of the replica of the region set with ReplicaID
+// option, 0 being the primary region.
+func (bq *baseQuery) ReplicaID() int {
+	return bq.replicaID
+}
 
 // Families option adds families constraint to a Scan or Get request.
 func Families(f map[string][]string) func(Call) error {
@@ -118,12 +147,30 @@ func Filters(f filter.Filter) func(Call) error {
 				return err
 			}
 			c.setFilter(pbF)
+			if s, ok := hc.(*Scan); ok && s.keys == nil {
+				s.rowRanges = sortedRowRanges(f)
+			}
 			return nil
 		}
 		return errors.New("'Filters' option can only be used with Get or Scan request")
 	}
 }
 
+// sortedRowRanges returns the row ranges of f sorted by start row
+// if f is a MultiRowRangeFilter, nil otherwise.
+func sortedRowRanges(f filter.Filter) []*pb.RowRange {
+	mrrf, ok := f.(*filter.MultiRowRangeFilter)
+	if !ok {
+		return nil
+	}
+	ranges := make([]*pb.RowRange, len(mrrf.RowRangeList))
+	copy(ranges, mrrf.RowRangeList)
+	sort.Slice(ranges, func(i, j int) bool {
+		return bytes.Compare(ranges[i].StartRow, ranges[j].StartRow) < 0
+	})
+	return ranges
+}
+
 // TimeRange is used as a parameter for request creation. Adds TimeRange constraint to a request.
 // It will get values in range [from, to[ ('to' is exclusive).
 func TimeRange(from, to time.Time) func(Call) error {
@@ -148,6 +195,34 @@ func TimeRangeUint64(from, to uint64) func(Call) error {
 	}
 }
 
+// ColumnFamilyTimeRange is used as a parameter for request creation. Adds TimeRange
+// constraint for cells of the given column family that takes precedence over the
+// TimeRange of the request. It can be passed multiple times for different families.
+// It will get values in range [from, to[ ('to' is exclusive).
+func ColumnFamilyTimeRange(family string, from, to time.Time) func(Call) error {
+	return ColumnFamilyTimeRangeUint64(family,
+		uint64(from.UnixNano()/1e6), uint64(to.UnixNano()/1e6))
+}
+
+// ColumnFamilyTimeRangeUint64 is used as a parameter for request creation.
+// Adds TimeRange constraint for cells of the given column family.
+// from and to should be in milliseconds
+// It will get values in range [from, to[ ('to' is exclusive).
+func ColumnFamilyTimeRangeUint64(family string, from, to uint64) func(Call) error {
+	return func(hc Call) error {
+		if c, ok := hc.(hasQueryOptions); ok {
+			if from >= to {
+				// or equal is becuase 'to' is exclusive
+				return errors.New("'from' timestamp is greater or equal to 'to' timestamp")
+			}
+			c.setColumnFamilyTimeRangeUint64(family, from, to)
+			return nil
+		}
+		return errors.New(
+			"'ColumnFamilyTimeRange' option can only be used with Get or Scan request")
+	}
+}
+
 // MaxVersions is used as a parameter for request creation.
 // Adds MaxVersions constraint to a request.
 func MaxVersions(versions uint32) func(Call) error {
@@ -164,7 +239,9 @@ func MaxVersions(versions uint32) func(Call) error {
 }
 
 // MaxResultsPerColumnFamily is an option for Get or Scan requests that sets the maximum
-// number of cells returned per column family in a row.
+// number of cells returned per column family in a row, known as the store limit
+// in HBase. Together with ResultOffset it allows to page through the columns
+// of a wide row.
 func MaxResultsPerColumnFamily(maxresults uint32) func(Call) error {
 	return func(hc Call) error {
 		if c, ok := hc.(hasQueryOptions); ok {
@@ -181,7 +258,7 @@ func MaxResultsPerColumnFamily(maxresults uint32) func(Call) error {
 }
 
 // ResultOffset is a option for Scan or Get requests that sets the offset for cells
-// within a column family.
+// within a column family, known as the store offset in HBase.
 func ResultOffset(offset uint32) func(Call) error {
 	return func(hc Call) error {
 		if c, ok := hc.(hasQueryOptions); ok {
@@ -218,3 +295,51 @@ func Consistency(consistency ConsistencyType) func(Call) error {
 		return errors.New("'Consistency' option can only be used with Get or Scan requests")
 	}
 }
+
+// ReplicaID is a Scan or Get option that reads the replica with the given ID
+// of the region of the request instead of the primary one, e.g. to always
+// read from the same replica for cache affinity. Replica 0 is the primary
+// region. Reading other replicas requires timeline consistency, which is set
+// as well. Like with TargetRegionServer option, requests to other replicas are
+// sent over dedicated connections. They fail if the region has no such replica.
+func ReplicaID(id int) func(Call) error {
+	return func(g Call) error {
+		c, ok := g.(hasQueryOptions)
+		if !ok {
+			return errors.New("'ReplicaID' option can only be used with Get or Scan requests")
+		}
+		if id < 0 {
+			return errors.New("replica ID must not be negative")
+		}
+		c.setReplicaID(id)
+		if id > 0 {
+			c.setConsistency(TimelineConsistency)
+		}
+		return nil
+	}
+}
+
+// cfTimeRangesToProto converts time ranges of column families to protobuf,
+// sorted by family to keep the message deterministic.
+func (bq *baseQuery) cfTimeRangesToProto() []*pb.ColumnFamilyTimeRange {
+	if len(bq.cfTimeRanges) == 0 {
+		return nil
+	}
+	families := make([]string, 0, len(bq.cfTimeRanges))
+	for family := range bq.cfTimeRanges {
+		families = append(families, family)
+	}
+	sort.Strings(families)
+	ranges := make([]*pb.ColumnFamilyTimeRange, len(families))
+	for i, family := range families {
+		tr := bq.cfTimeRanges[family]
+		ranges[i] = &pb.ColumnFamilyTimeRange{
+			ColumnFamily: []byte(family),
+			TimeRange: &pb.TimeRange{
+				From: &tr[0],
+				To:   &tr[1],
+			},
+		}
+	}
+	return ranges
+}
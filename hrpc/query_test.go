@@ -20,7 +20,7 @@ import (
 func TestFamilesOption(t *testing.T) {
 	f := map[string][]string{"yolo": []string{"swag", "meow"}}
 
-	g, err := NewGet(context.Background(), nil, nil, Families(f))
+	g, err := NewGet(context.Background(), nil, []byte("key"), Families(f))
 	if err != nil {
 		t.Error(err)
 	}
@@ -37,7 +37,7 @@ func TestFamilesOption(t *testing.T) {
 
 func TestFiltersOption(t *testing.T) {
 	f := filter.NewColumnCountGetFilter(1)
-	g, err := NewGet(context.Background(), nil, nil, Filters(f))
+	g, err := NewGet(context.Background(), nil, []byte("key"), Filters(f))
 	if err != nil {
 		t.Error(err)
 	}
@@ -67,7 +67,7 @@ func TestTimeRangeOption(t *testing.T) {
 	}
 
 	for _, tcase := range tests {
-		g, err := NewGet(context.Background(), nil, nil, TimeRange(tcase.from, tcase.to))
+		g, err := NewGet(context.Background(), nil, []byte("key"), TimeRange(tcase.from, tcase.to))
 		if !test.ErrEqual(tcase.err, err) {
 			t.Fatalf("expected %v, got %v", tcase.err, err)
 		}
@@ -93,7 +93,7 @@ func TestTimeRangeOption(t *testing.T) {
 
 func TestMaxVersions(t *testing.T) {
 	v := uint32(123456)
-	g, err := NewGet(context.Background(), nil, nil, MaxVersions(v))
+	g, err := NewGet(context.Background(), nil, []byte("key"), MaxVersions(v))
 	if err != nil {
 		t.Error(err)
 	}
@@ -102,7 +102,7 @@ func TestMaxVersions(t *testing.T) {
 		t.Errorf("expected %d, got %d", vExp, vGot)
 	}
 
-	_, err = NewGet(context.Background(), nil, nil, MaxVersions(uint32(math.MaxUint32)))
+	_, err = NewGet(context.Background(), nil, []byte("key"), MaxVersions(uint32(math.MaxUint32)))
 	if err == nil || err.Error() != "'MaxVersions' exceeds supported number of versions" {
 		t.Error(err)
 	}
@@ -116,7 +116,7 @@ func TestMaxVersions(t *testing.T) {
 
 func TestMaxResultsPerColumnFamily(t *testing.T) {
 	r := uint32(123456)
-	g, err := NewGet(context.Background(), nil, nil, MaxResultsPerColumnFamily(r))
+	g, err := NewGet(context.Background(), nil, []byte("key"), MaxResultsPerColumnFamily(r))
 	if err != nil {
 		t.Error(err)
 	}
@@ -125,7 +125,7 @@ func TestMaxResultsPerColumnFamily(t *testing.T) {
 		t.Errorf("expected %d, got %d", rExp, rGot)
 	}
 
-	_, err = NewGet(context.Background(), nil, nil,
+	_, err = NewGet(context.Background(), nil, []byte("key"),
 		MaxResultsPerColumnFamily(uint32(math.MaxUint32)))
 	if err == nil || err.Error() !=
 		"'MaxResultsPerColumnFamily' exceeds supported number of value results" {
@@ -141,7 +141,7 @@ func TestMaxResultsPerColumnFamily(t *testing.T) {
 
 func TestResultOffset(t *testing.T) {
 	r := uint32(123456)
-	g, err := NewGet(context.Background(), nil, nil, ResultOffset(r))
+	g, err := NewGet(context.Background(), nil, []byte("key"), ResultOffset(r))
 	if err != nil {
 		t.Error(err)
 	}
@@ -150,7 +150,7 @@ func TestResultOffset(t *testing.T) {
 		t.Errorf("expected %d, got %d", rExp, rGot)
 	}
 
-	_, err = NewGet(context.Background(), nil, nil, ResultOffset(uint32(math.MaxUint32)))
+	_, err = NewGet(context.Background(), nil, []byte("key"), ResultOffset(uint32(math.MaxUint32)))
 	if err == nil || err.Error() != "'ResultOffset' exceeds supported offset value" {
 		t.Error(err)
 	}
@@ -164,7 +164,7 @@ func TestResultOffset(t *testing.T) {
 
 func TestCacheBlocks(t *testing.T) {
 	// set CacheBlocks to false for Get
-	g, err := NewGet(context.Background(), nil, nil, CacheBlocks(false))
+	g, err := NewGet(context.Background(), nil, []byte("key"), CacheBlocks(false))
 	if err != nil {
 		t.Error(err)
 	}
@@ -174,7 +174,7 @@ func TestCacheBlocks(t *testing.T) {
 	}
 
 	// check that default CacheBlocks for Get is true
-	g2, err := NewGet(context.Background(), nil, nil)
+	g2, err := NewGet(context.Background(), nil, []byte("key"))
 	if err != nil {
 		t.Error(err)
 	}
@@ -6,11 +6,14 @@
 package hrpc
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
 	"math"
+	"sort"
 
+	"github.com/baiweiguo/gohbase/filter"
 	"github.com/baiweiguo/gohbase/pb"
 	"google.golang.org/protobuf/proto"
 )
@@ -54,6 +57,26 @@ type Scanner interface {
 	Close() error
 }
 
+// ScanPosition is the position of a scanner among the regions it scans.
+type ScanPosition struct {
+	// Region is the region the rows last returned by Next are from,
+	// nil if none has been fetched yet.
+	Region RegionInfo
+	// Fraction estimates the fraction of the scan that is done, from 0 to 1,
+	// as the fraction of the regions in the range of the scan done before
+	// Region. It's 1 once the scanner is closed or has returned all the rows.
+	Fraction float64
+}
+
+// PositionReporter is implemented by the scanners of the client, which report
+// their position, e.g. to show the progress of long scans.
+type PositionReporter interface {
+	// Position returns the position of the scanner. The regions in the range
+	// of the scan are looked up the first time it's called after a region has
+	// been fetched, with the context of the scan.
+	Position() (ScanPosition, error)
+}
+
 // Scan represents a scanner on an HBase table.
 type Scan struct {
 	base
@@ -70,6 +93,16 @@ type Scan struct {
 
 	closeScanner        bool
 	allowPartialResults bool
+	small               bool
+
+	zeroCopy bool
+	// release returns the pooled buffer backing cells of the response
+	release func()
+
+	// rowRanges are the ranges of a MultiRowRangeFilter sorted by start row
+	rowRanges []*pb.RowRange
+	// keys are the sorted rows the scan is restricted to, see Keys option
+	keys [][]byte
 }
 
 // baseScan returns a Scan struct with default values set.
@@ -91,9 +124,43 @@ func baseScan(ctx context.Context, table []byte,
 	if err != nil {
 		return nil, err
 	}
+	if s.keys != nil {
+		if err := s.filterKeys(); err != nil {
+			return nil, err
+		}
+	}
 	return s, nil
 }
 
+// filterKeys restricts the scan to its keys with a MultiRowRangeFilter
+// of single rows, which must pass along with the filter of the scan if any.
+func (s *Scan) filterKeys() error {
+	ranges := make([]*filter.RowRange, len(s.keys))
+	for i, key := range s.keys {
+		ranges[i] = filter.NewRowRange(key, key, true, true)
+	}
+	var f filter.Filter = filter.NewMultiRowRangeFilter(ranges)
+	s.rowRanges = sortedRowRanges(f)
+	if s.filter != nil {
+		f = filter.NewList(filter.MustPassAll, f, encodedFilter{s.filter})
+	}
+	pbF, err := f.ConstructPBFilter()
+	if err != nil {
+		return err
+	}
+	s.filter = pbF
+	return nil
+}
+
+// encodedFilter is a filter already encoded in a pb.Filter.
+type encodedFilter struct {
+	*pb.Filter
+}
+
+func (f encodedFilter) ConstructPBFilter() (*pb.Filter, error) {
+	return f.Filter, nil
+}
+
 func (s *Scan) String() string {
 	return fmt.Sprintf("Scan{Table=%q StartRow=%q StopRow=%q TimeRange=(%d, %d) "+
 		"MaxVersions=%d NumberOfRows=%d MaxResultSize=%d Familes=%v Filter=%v "+
@@ -108,15 +175,28 @@ func NewScan(ctx context.Context, table []byte, options ...func(Call) error) (*S
 	return baseScan(ctx, table, options...)
 }
 
+// ErrInvalidScanRange is returned when creating a scan whose start row is after
+// its stop row, or before it for reversed scans, as such a scan can't return
+// any row.
+var ErrInvalidScanRange = errors.New("scan start row is after its stop row")
+
 // NewScanRange creates a scanner for the given table and key range.
 // The range is half-open, i.e. [startRow; stopRow[ -- stopRow is not
-// included in the range.
+// included in the range. For reversed scans, startRow is the highest row.
+// ErrInvalidScanRange is returned if the range is reversed compared to the
+// direction of the scan.
 func NewScanRange(ctx context.Context, table, startRow, stopRow []byte,
 	options ...func(Call) error) (*Scan, error) {
 	scan, err := baseScan(ctx, table, options...)
 	if err != nil {
 		return nil, err
 	}
+	if len(startRow) > 0 && len(stopRow) > 0 {
+		if cmp := bytes.Compare(startRow, stopRow); cmp > 0 && !scan.reversed ||
+			cmp < 0 && scan.reversed {
+			return nil, ErrInvalidScanRange
+		}
+	}
 	scan.startRow = startRow
 	scan.stopRow = stopRow
 	scan.key = startRow
@@ -171,6 +251,44 @@ func (s *Scan) Reversed() bool {
 	return s.reversed
 }
 
+// MaxResultSize returns the maximum number of bytes fetched
+// by a request of the scan, see MaxResultSize option.
+func (s *Scan) MaxResultSize() uint64 {
+	return s.maxResultSize
+}
+
+// Small returns true if this is a small scan, see Small option.
+func (s *Scan) Small() bool {
+	return s.small
+}
+
+// ZeroCopy returns true if cells of the response can reference the buffer
+// the response was read into instead of being copied out of it.
+func (s *Scan) ZeroCopy() bool {
+	return s.zeroCopy
+}
+
+// SetBufferRelease is used by the region client to hand over the function
+// returning the pooled buffer backing cells of the response.
+func (s *Scan) SetBufferRelease(release func()) {
+	s.release = release
+}
+
+// ReleaseBuffer returns the pooled buffer backing cells of the response,
+// if any. Cells of the response must not be used afterwards.
+func (s *Scan) ReleaseBuffer() {
+	if s.release != nil {
+		s.release()
+		s.release = nil
+	}
+}
+
+// RowRanges returns the row ranges of the MultiRowRangeFilter of this scan
+// sorted by start row, if any. The scanner skips the regions outside of them.
+func (s *Scan) RowRanges() []*pb.RowRange {
+	return s.rowRanges
+}
+
 // NumberOfRows returns how many rows this scan
 // fetches from regionserver in a single response.
 func (s *Scan) NumberOfRows() uint32 {
@@ -193,6 +311,12 @@ func (s *Scan) ToProto() proto.Message {
 		scan.ScannerId = &s.scannerID
 		return scan
 	}
+	if s.small {
+		// the whole range is fetched in this request, so the scanner
+		// is closed at server side and rows are never split
+		scan.CloseScanner = proto.Bool(true)
+		scan.ClientHandlesPartials = proto.Bool(false)
+	}
 	scan.Scan = &pb.Scan{
 		Column:        familiesToColumn(s.families),
 		StartRow:      s.startRow,
@@ -218,9 +342,13 @@ func (s *Scan) ToProto() proto.Message {
 	if s.toTimestamp != MaxTimestamp {
 		scan.Scan.TimeRange.To = &s.toTimestamp
 	}
+	scan.Scan.CfTimeRange = s.cfTimeRangesToProto()
 	if s.reversed {
 		scan.Scan.Reversed = &s.reversed
 	}
+	if s.small {
+		scan.Scan.Small = &s.small
+	}
 	if s.cacheBlocks != DefaultCacheBlocks {
 		scan.Scan.CacheBlocks = &s.cacheBlocks
 	}
@@ -228,6 +356,7 @@ func (s *Scan) ToProto() proto.Message {
 		scan.Scan.Consistency = s.consistency.toProto()
 	}
 	scan.Scan.Filter = s.filter
+	scan.Scan.Attribute = s.attributes
 	return scan
 }
 
@@ -287,7 +416,8 @@ func CloseScanner() func(Call) error {
 
 // MaxResultSize is an option for scan requests.
 // Maximum number of bytes fetched when calling a scanner's next method.
-// MaxResultSize takes priority over NumberOfRows.
+// MaxResultSize takes priority over NumberOfRows: the server returns fewer
+// rows once the limit is reached and the scanner keeps fetching the rest.
 func MaxResultSize(n uint64) func(Call) error {
 	return func(g Call) error {
 		scan, ok := g.(*Scan)
@@ -344,3 +474,72 @@ func Reversed() func(Call) error {
 		return nil
 	}
 }
+
+// Small is a Scan-only option which marks the scan as small, i.e. returning few
+// rows that fit in a single response from a region. A small scan doesn't open
+// a scanner at regionserver: each request opens, reads and closes it at once,
+// saving the requests to fetch more results and to close the scanner.
+// Small scans never return partial rows.
+func Small(small bool) func(Call) error {
+	return func(g Call) error {
+		scan, ok := g.(*Scan)
+		if !ok {
+			return errors.New("'Small' option can only be used with Scan queries")
+		}
+		scan.small = small
+		return nil
+	}
+}
+
+// ZeroCopy is a Scan-only option which allows the region client to deserialize
+// cells directly from a pooled response buffer instead of copying them out of it.
+// It reduces allocations of scans fetching a lot of data, but results returned
+// by Scanner's Next are only valid until the following call to Next or Close.
+func ZeroCopy() func(Call) error {
+	return func(g Call) error {
+		scan, ok := g.(*Scan)
+		if !ok {
+			return errors.New("'ZeroCopy' option can only be used with Scan queries")
+		}
+		scan.zeroCopy = true
+		return nil
+	}
+}
+
+// Keys is a Scan-only option which restricts the scan to the rows with the
+// given keys, e.g. to apply filters to rows scattered across regions. Only
+// the regions holding some of the keys are scanned. The keys are combined with
+// the filters of the Filters option, if any, so that rows must pass both.
+func Keys(keys ...[]byte) func(Call) error {
+	return func(g Call) error {
+		scan, ok := g.(*Scan)
+		if !ok {
+			return errors.New("'Keys' option can only be used with Scan queries")
+		}
+		if len(keys) == 0 {
+			return errors.New("'Keys' option needs at least one key")
+		}
+		sorted := make([][]byte, len(keys))
+		copy(sorted, keys)
+		sort.Slice(sorted, func(i, j int) bool {
+			return bytes.Compare(sorted[i], sorted[j]) < 0
+		})
+		scan.keys = sorted[:1]
+		for _, key := range sorted[1:] {
+			if !bytes.Equal(key, scan.keys[len(scan.keys)-1]) {
+				scan.keys = append(scan.keys, key)
+			}
+		}
+		return nil
+	}
+}
+
+// RangeEstimate is an estimate of the data in a range of rows of a table.
+type RangeEstimate struct {
+	// Rows is the number of rows.
+	Rows uint64
+	// Cells is the number of cells.
+	Cells uint64
+	// Bytes is the size of the cells, as the KeyValues they're stored as.
+	Bytes uint64
+}
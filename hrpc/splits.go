@@ -0,0 +1,60 @@
+// Copyright (C) 2026  The GoHBase Authors.  All rights reserved.
+// This file is part of GoHBase.
+// Use of this source code is governed by the Apache License 2.0
+// that can be found in the COPYING file.
+
+package hrpc
+
+import (
+	"bytes"
+	"io"
+	"sort"
+)
+
+// SplitPoints returns up to n split keys that partition the sample of row keys
+// into n+1 ranges with the same number of keys each, for use with SplitKeys
+// option when creating a table. The keys are compared as unsigned bytes. The
+// returned split keys are sorted and unique, so fewer than n are returned if
+// the sample doesn't have enough distinct keys. The sample isn't modified.
+func SplitPoints(sample [][]byte, n int) [][]byte {
+	if n <= 0 || len(sample) == 0 {
+		return nil
+	}
+	sorted := make([][]byte, len(sample))
+	copy(sorted, sample)
+	sort.Slice(sorted, func(i, j int) bool {
+		return bytes.Compare(sorted[i], sorted[j]) < 0
+	})
+
+	var splits [][]byte
+	for i := 1; i <= n; i++ {
+		key := sorted[i*len(sorted)/(n+1)]
+		if bytes.Equal(key, sorted[0]) ||
+			len(splits) > 0 && bytes.Equal(key, splits[len(splits)-1]) {
+			// the range before the split key would be empty
+			continue
+		}
+		splits = append(splits, append([]byte(nil), key...))
+	}
+	return splits
+}
+
+// SplitPointsFromScanner returns up to n split keys that partition the rows
+// returned by the scanner into n+1 ranges with the same number of rows each.
+// See SplitPoints. The scanner is read until io.EOF and then closed.
+func SplitPointsFromScanner(scanner Scanner, n int) ([][]byte, error) {
+	defer scanner.Close()
+	var sample [][]byte
+	for {
+		res, err := scanner.Next()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+		if len(res.Cells) > 0 {
+			sample = append(sample, res.Cells[0].Row)
+		}
+	}
+	return SplitPoints(sample, n), nil
+}
@@ -0,0 +1,147 @@
+// Copyright (C) 2026  The GoHBase Authors.  All rights reserved.
+// This file is part of GoHBase.
+// Use of this source code is governed by the Apache License 2.0
+// that can be found in the COPYING file.
+
+package hrpc
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+// binaryKeys returns n 4 byte big endian keys spread evenly
+// over the whole space of keys, shuffled.
+func binaryKeys(n int) [][]byte {
+	keys := make([][]byte, n)
+	for i := range keys {
+		keys[i] = make([]byte, 4)
+		binary.BigEndian.PutUint32(keys[i], uint32(uint64(i)*(1<<32)/uint64(n)))
+	}
+	rand.New(rand.NewSource(1)).Shuffle(n, func(i, j int) {
+		keys[i], keys[j] = keys[j], keys[i]
+	})
+	return keys
+}
+
+func TestSplitPoints(t *testing.T) {
+	keys := binaryKeys(10000)
+	orig := make([][]byte, len(keys))
+	copy(orig, keys)
+
+	splits := SplitPoints(keys, 4)
+	if len(splits) != 4 {
+		t.Fatalf("expected 4 split points, got %d", len(splits))
+	}
+	if !reflect.DeepEqual(orig, keys) {
+		t.Error("sample was modified")
+	}
+
+	// every range between split points has the same number of keys
+	counts := make([]int, len(splits)+1)
+	for _, key := range keys {
+		i := 0
+		for i < len(splits) && bytes.Compare(key, splits[i]) >= 0 {
+			i++
+		}
+		counts[i]++
+	}
+	for i, count := range counts {
+		if count != 2000 {
+			t.Errorf("expected 2000 keys in range %d, got %d: %v", i, count, counts)
+		}
+	}
+
+	// keys are compared as unsigned bytes, so the split points
+	// fall on the quarters of the space of keys
+	for i, split := range splits {
+		expected := uint32(uint64(i+1) * (1 << 32) / 5)
+		if got := binary.BigEndian.Uint32(split); got != expected {
+			t.Errorf("expected split point %d to be %x, got %x", i, expected, got)
+		}
+	}
+}
+
+func TestSplitPointsFewKeys(t *testing.T) {
+	for _, tcase := range []struct {
+		sample   [][]byte
+		n        int
+		expected [][]byte
+	}{
+		{sample: nil, n: 3},
+		{sample: [][]byte{[]byte("a")}, n: 0},
+		{sample: [][]byte{[]byte("a")}, n: 3},
+		{
+			// duplicates don't create empty ranges
+			sample: [][]byte{
+				[]byte("a"), []byte("a"), []byte("a"), []byte("a"), []byte("b"), []byte("c"),
+			},
+			n:        3,
+			expected: [][]byte{[]byte("b")},
+		},
+		{
+			sample:   [][]byte{{0xff}, {0x00}, {0x7f, 0x00}, {0x80}},
+			n:        3,
+			expected: [][]byte{{0x7f, 0x00}, {0x80}, {0xff}},
+		},
+	} {
+		if got := SplitPoints(tcase.sample, tcase.n); !reflect.DeepEqual(tcase.expected, got) {
+			t.Errorf("expected %q, got %q", tcase.expected, got)
+		}
+	}
+}
+
+// sliceScanner returns the results one by one.
+type sliceScanner struct {
+	results []*Result
+	err     error
+	closed  bool
+}
+
+func (s *sliceScanner) Next() (*Result, error) {
+	if len(s.results) == 0 {
+		if s.err != nil {
+			return nil, s.err
+		}
+		return nil, io.EOF
+	}
+	r := s.results[0]
+	s.results = s.results[1:]
+	return r, nil
+}
+
+func (s *sliceScanner) Close() error {
+	s.closed = true
+	return nil
+}
+
+func TestSplitPointsFromScanner(t *testing.T) {
+	var results []*Result
+	for i := 0; i < 100; i++ {
+		row := []byte{byte(i)}
+		results = append(results, &Result{Cells: []*Cell{{Row: row}, {Row: row}}})
+	}
+	s := &sliceScanner{results: results}
+	splits, err := SplitPointsFromScanner(s, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := [][]byte{{25}, {50}, {75}}
+	if !reflect.DeepEqual(expected, splits) {
+		t.Errorf("expected %v, got %v", expected, splits)
+	}
+	if !s.closed {
+		t.Error("expected scanner to be closed")
+	}
+
+	scanErr := errors.New("oops")
+	s = &sliceScanner{results: results, err: scanErr}
+	if _, err := SplitPointsFromScanner(s, 3); err != scanErr {
+		t.Errorf("expected error %v, got %v", scanErr, err)
+	}
+}
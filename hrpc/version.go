@@ -0,0 +1,93 @@
+// Copyright (C) 2026  The GoHBase Authors.  All rights reserved.
+// This file is part of GoHBase.
+// Use of this source code is governed by the Apache License 2.0
+// that can be found in the COPYING file.
+
+package hrpc
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Version is a version of HBase.
+type Version struct {
+	Major, Minor, Patch int
+}
+
+// ParseVersion parses a version of HBase such as "2.4.17", ignoring any
+// suffix such as "-SNAPSHOT" or "-hadoop2".
+func ParseVersion(s string) (Version, error) {
+	if i := strings.IndexAny(s, "-+"); i >= 0 {
+		s = s[:i]
+	}
+	parts := strings.Split(s, ".")
+	if len(parts) < 2 || len(parts) > 3 {
+		return Version{}, fmt.Errorf("invalid HBase version %q", s)
+	}
+	var numbers [3]int
+	for i, part := range parts {
+		n, err := strconv.Atoi(part)
+		if err != nil || n < 0 {
+			return Version{}, fmt.Errorf("invalid HBase version %q", s)
+		}
+		numbers[i] = n
+	}
+	return Version{Major: numbers[0], Minor: numbers[1], Patch: numbers[2]}, nil
+}
+
+func (v Version) String() string {
+	return fmt.Sprintf("%d.%d.%d", v.Major, v.Minor, v.Patch)
+}
+
+// AtLeast returns true if v is o or a later version.
+func (v Version) AtLeast(o Version) bool {
+	if v.Major != o.Major {
+		return v.Major > o.Major
+	}
+	if v.Minor != o.Minor {
+		return v.Minor > o.Minor
+	}
+	return v.Patch >= o.Patch
+}
+
+// Feature is a feature of HBase calls that isn't supported by every version.
+type Feature string
+
+const (
+	// FeatureNonces are the nonces sent with increments and appends,
+	// see Nonce option.
+	FeatureNonces = Feature("nonces")
+	// FeatureSmallScans are scans fetching their whole range in a single
+	// request, see Small option.
+	FeatureSmallScans = Feature("small scans")
+	// FeatureRegionReplicas are reads from the secondary replicas of regions,
+	// see ReplicaID option.
+	FeatureRegionReplicas = Feature("region replicas")
+)
+
+// featureVersions are the first versions supporting the features.
+var featureVersions = map[Feature]Version{
+	FeatureNonces:         {Major: 0, Minor: 98},
+	FeatureSmallScans:     {Major: 0, Minor: 98},
+	FeatureRegionReplicas: {Major: 1, Minor: 0},
+}
+
+// Supports returns true if v supports feature f.
+func (v Version) Supports(f Feature) bool {
+	return v.AtLeast(featureVersions[f])
+}
+
+// RequestedFeatures returns the features explicitly requested by c with
+// its options. Nonces aren't, as they're sent implicitly.
+func RequestedFeatures(c Call) []Feature {
+	var features []Feature
+	if s, ok := c.(*Scan); ok && s.Small() {
+		features = append(features, FeatureSmallScans)
+	}
+	if r, ok := c.(interface{ ReplicaID() int }); ok && r.ReplicaID() > 0 {
+		features = append(features, FeatureRegionReplicas)
+	}
+	return features
+}
@@ -0,0 +1,113 @@
+// Copyright (C) 2026  The GoHBase Authors.  All rights reserved.
+// This file is part of GoHBase.
+// Use of this source code is governed by the Apache License 2.0
+// that can be found in the COPYING file.
+
+package hrpc
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+func TestVersionFeatures(t *testing.T) {
+	tests := []struct {
+		version  string
+		err      bool
+		expected Version
+		features map[Feature]bool
+	}{{
+		version:  "0.96.2-hadoop2",
+		expected: Version{Major: 0, Minor: 96, Patch: 2},
+		features: map[Feature]bool{
+			FeatureNonces:         false,
+			FeatureSmallScans:     false,
+			FeatureRegionReplicas: false,
+		},
+	}, {
+		version:  "0.98.24",
+		expected: Version{Major: 0, Minor: 98, Patch: 24},
+		features: map[Feature]bool{
+			FeatureNonces:         true,
+			FeatureSmallScans:     true,
+			FeatureRegionReplicas: false,
+		},
+	}, {
+		version:  "1.0",
+		expected: Version{Major: 1},
+		features: map[Feature]bool{
+			FeatureNonces:         true,
+			FeatureSmallScans:     true,
+			FeatureRegionReplicas: true,
+		},
+	}, {
+		version:  "2.4.17-SNAPSHOT",
+		expected: Version{Major: 2, Minor: 4, Patch: 17},
+		features: map[Feature]bool{
+			FeatureNonces:         true,
+			FeatureSmallScans:     true,
+			FeatureRegionReplicas: true,
+		},
+	}, {
+		version: "",
+		err:     true,
+	}, {
+		version: "2",
+		err:     true,
+	}, {
+		version: "2.x.1",
+		err:     true,
+	}, {
+		version: "1.2.3.4",
+		err:     true,
+	}}
+	for _, tcase := range tests {
+		t.Run(tcase.version, func(t *testing.T) {
+			v, err := ParseVersion(tcase.version)
+			if tcase.err {
+				if err == nil {
+					t.Fatalf("expected an error, got %v", v)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if v != tcase.expected {
+				t.Errorf("expected %v, got %v", tcase.expected, v)
+			}
+			for f, expected := range tcase.features {
+				if supported := v.Supports(f); supported != expected {
+					t.Errorf("expected %s to be supported %v, got %v", f, expected, supported)
+				}
+			}
+		})
+	}
+}
+
+func TestRequestedFeatures(t *testing.T) {
+	ctx := context.Background()
+	get, err := NewGetStr(ctx, "test", "row", ReplicaID(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if f := RequestedFeatures(get); !reflect.DeepEqual([]Feature{FeatureRegionReplicas}, f) {
+		t.Errorf("expected region replicas to be requested, got %v", f)
+	}
+	scan, err := NewScanStr(ctx, "test", Small(true))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if f := RequestedFeatures(scan); !reflect.DeepEqual([]Feature{FeatureSmallScans}, f) {
+		t.Errorf("expected small scans to be requested, got %v", f)
+	}
+	// nonces are sent implicitly
+	inc, err := NewIncStrSingle(ctx, "test", "row", "cf", "q", 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if f := RequestedFeatures(inc); len(f) != 0 {
+		t.Errorf("expected no features to be requested, got %v", f)
+	}
+}
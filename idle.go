@@ -0,0 +1,64 @@
+// Copyright (C) 2026  The GoHBase Authors.  All rights reserved.
+// This file is part of GoHBase.
+// Use of this source code is governed by the Apache License 2.0
+// that can be found in the COPYING file.
+
+package gohbase
+
+import (
+	"time"
+
+	"github.com/baiweiguo/gohbase/hrpc"
+	log "github.com/sirupsen/logrus"
+)
+
+// IdleTimeout will return an option that closes the connections to
+// RegionServers that haven't been used for timeout, e.g. the ones the regions
+// of which moved elsewhere, and removes them from cache. The next rpcs to their
+// regions connect again. Connections are checked every timeout, so an unused
+// one is closed after between timeout and twice as long. Default is 0, which
+// keeps connections open until they fail.
+func IdleTimeout(timeout time.Duration) Option {
+	return func(c *client) {
+		c.idleTimeout = timeout
+	}
+}
+
+// closeIdleClients closes the region clients that haven't sent nor received
+// anything since the previous check every idleTimeout until the client is closed.
+func (c *client) closeIdleClients() {
+	var last map[hrpc.RegionClient]hrpc.ConnStats
+	for {
+		select {
+		case <-c.ctx.Done():
+			return
+		case <-c.after(c.idleTimeout):
+		}
+		last = c.closeIdle(last)
+	}
+}
+
+// closeIdle closes the region clients whose statistics haven't changed since
+// last and that have no rpcs in flight, and returns the current statistics
+// of the others. Region clients that don't keep statistics are never closed.
+// The rpcs that looked up a region client being closed look it up again,
+// see regionInFlight.acquire.
+func (c *client) closeIdle(
+	last map[hrpc.RegionClient]hrpc.ConnStats) map[hrpc.RegionClient]hrpc.ConnStats {
+	current := c.clients.activity()
+	for rc, stats := range current {
+		if prev, ok := last[rc]; !ok || prev != stats {
+			continue
+		}
+		if !c.inFlight.startClosing(rc, c.clients.regionsOf(rc)) {
+			continue
+		}
+		delete(current, rc)
+
+		log.WithField("client", rc).Info("closing idle region client")
+		c.clients.remove(rc)
+		rc.Close()
+		c.inFlight.doneClosing(rc)
+	}
+	return current
+}
@@ -0,0 +1,267 @@
+// Copyright (C) 2026  The GoHBase Authors.  All rights reserved.
+// This file is part of GoHBase.
+// Use of this source code is governed by the Apache License 2.0
+// that can be found in the COPYING file.
+
+package gohbase
+
+import (
+	"context"
+	"math/rand"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/baiweiguo/gohbase/hrpc"
+	"github.com/baiweiguo/gohbase/region"
+)
+
+// usageRegionClient is a region client keeping stats that records being closed.
+type usageRegionClient struct {
+	hrpc.RegionClient
+
+	m      sync.Mutex
+	stats  hrpc.ConnStats
+	closed bool
+}
+
+func (c *usageRegionClient) use() {
+	c.m.Lock()
+	c.stats.RPCsCompleted++
+	c.m.Unlock()
+}
+
+func (c *usageRegionClient) isClosed() bool {
+	c.m.Lock()
+	defer c.m.Unlock()
+	return c.closed
+}
+
+func (c *usageRegionClient) ConnStats() hrpc.ConnStats {
+	c.m.Lock()
+	defer c.m.Unlock()
+	return c.stats
+}
+
+func (c *usageRegionClient) Close() {
+	c.m.Lock()
+	c.closed = true
+	c.m.Unlock()
+}
+
+func TestIdleTimeout(t *testing.T) {
+	c := newMockClient(nil)
+	defer c.cancel()
+
+	rcs := map[string]*usageRegionClient{}
+	regs := map[string]hrpc.RegionInfo{}
+	for _, addr := range []string{"busy", "idle"} {
+		reg := region.NewInfo(0, nil, []byte(addr),
+			[]byte(addr+",,1434573235908.56f833d5569a27c7a43fbf547b4924a4."), nil, nil)
+		rc := &usageRegionClient{
+			RegionClient: &flakyRegionClient{addr: addr, srv: &flakyServer{}},
+		}
+		c.regions.put(reg)
+		c.clients.put(addr, reg, func() hrpc.RegionClient { return rc })
+		reg.SetClient(rc)
+		rcs[addr], regs[addr] = rc, reg
+	}
+
+	ticks := make(chan time.Time)
+	intervals := make(chan time.Duration, 10)
+	c.after = func(d time.Duration) <-chan time.Time {
+		intervals <- d
+		return ticks
+	}
+	c.idleTimeout = time.Minute
+	go c.closeIdleClients()
+
+	if d := <-intervals; d != time.Minute {
+		t.Errorf("expected connections to be checked every minute, got %s", d)
+	}
+	ticks <- time.Now()
+	<-intervals
+
+	// a minute later, only the busy client has been used
+	rcs["busy"].use()
+	ticks <- time.Now()
+	<-intervals
+
+	if !rcs["idle"].isClosed() {
+		t.Error("expected the idle client to be closed")
+	}
+	if regs["idle"].Client() != nil {
+		t.Error("expected the region of the idle client to have no client")
+	}
+	if c.getRegionFromCache([]byte("idle"), []byte("key")) == nil {
+		t.Error("expected the region of the idle client to stay cached")
+	}
+	if rcs["busy"].isClosed() || regs["busy"].Client() != rcs["busy"] {
+		t.Error("expected the busy client to stay open")
+	}
+	stats := c.clients.activity()
+	if _, ok := stats[rcs["idle"]]; ok {
+		t.Error("expected the idle client to be removed from cache")
+	}
+	if _, ok := stats[rcs["busy"]]; !ok {
+		t.Error("expected the busy client to stay cached")
+	}
+
+	// the busy client is closed once it's idle too
+	ticks <- time.Now()
+	<-intervals
+	if !rcs["busy"].isClosed() {
+		t.Error("expected the client to be closed once idle")
+	}
+}
+
+// closingRegionClient is a region client that never reports any activity and
+// counts the rpcs queued once closed, which it fails.
+type closingRegionClient struct {
+	flakyRegionClient
+
+	m      sync.Mutex
+	closed bool
+	lost   int
+}
+
+func (c *closingRegionClient) ConnStats() hrpc.ConnStats {
+	return hrpc.ConnStats{}
+}
+
+func (c *closingRegionClient) Close() {
+	c.m.Lock()
+	c.closed = true
+	c.m.Unlock()
+}
+
+func (c *closingRegionClient) QueueRPC(call hrpc.Call) {
+	c.m.Lock()
+	closed := c.closed
+	if closed {
+		c.lost++
+	}
+	c.m.Unlock()
+	if closed {
+		call.ResultChan() <- hrpc.RPCResult{Error: region.ErrClientClosed}
+		return
+	}
+	c.flakyRegionClient.QueueRPC(call)
+}
+
+func TestIdleTimeoutConcurrentRPCs(t *testing.T) {
+	var (
+		m       sync.Mutex
+		created []*closingRegionClient
+	)
+	c := NewClientWithResolver(singleRegionResolver{}, func(addr string) hrpc.RegionClient {
+		rc := &closingRegionClient{
+			flakyRegionClient: flakyRegionClient{addr: addr, srv: &flakyServer{}},
+		}
+		m.Lock()
+		created = append(created, rc)
+		m.Unlock()
+		return rc
+	}).(*client)
+	defer c.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	done := make(chan struct{})
+	var wg sync.WaitGroup
+	errs := make(chan error, 8)
+	for i := 0; i < cap(errs); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case <-done:
+					return
+				default:
+				}
+				get, err := hrpc.NewGetStr(ctx, "test", "row")
+				if err == nil {
+					_, err = c.Get(get)
+				}
+				if err != nil {
+					errs <- err
+					return
+				}
+				// leave the region client idle from time to time
+				time.Sleep(time.Duration(rand.Intn(100)) * time.Microsecond)
+			}
+		}()
+	}
+
+	// every region client looks idle, close them while rpcs are being sent
+	deadline := time.Now().Add(200 * time.Millisecond)
+	for time.Now().Before(deadline) {
+		c.closeIdle(c.clients.activity())
+	}
+	close(done)
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		t.Errorf("expected rpcs to succeed, got %v", err)
+	}
+	m.Lock()
+	defer m.Unlock()
+	for _, rc := range created {
+		if rc.lost > 0 {
+			t.Errorf("expected rpcs not to be sent to region clients closed as idle, "+
+				"got %d sent to %s", rc.lost, rc)
+		}
+	}
+	if len(created) < 2 {
+		t.Errorf("expected idle region clients to be closed and replaced, got %d clients",
+			len(created))
+	}
+}
+
+func TestIdleTimeoutClientLookedUp(t *testing.T) {
+	c := NewClientWithResolver(singleRegionResolver{}, func(addr string) hrpc.RegionClient {
+		return &closingRegionClient{
+			flakyRegionClient: flakyRegionClient{addr: addr, srv: &flakyServer{}},
+		}
+	}).(*client)
+	defer c.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	get, err := hrpc.NewGetStr(ctx, "test", "row")
+	if err != nil {
+		t.Fatal(err)
+	}
+	reg, rc, err := c.getRegionAndClientForRPC(ctx, get)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the region client is closed as idle right after the rpc looked it up
+	c.closeIdle(c.clients.activity())
+	if !rc.(*closingRegionClient).closed {
+		t.Fatal("expected the region client to be closed")
+	}
+	if c.inFlight.acquire(reg, rc) {
+		t.Fatal("expected the rpc not to be acquired for the closed region client")
+	}
+
+	reg2, rc2, err := c.acquireRegionAndClient(ctx, get)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rc2 == rc {
+		t.Error("expected the rpc to look up a new region client")
+	}
+	// the region client isn't closed while the rpc is in flight
+	c.closeIdle(c.clients.activity())
+	if rc2.(*closingRegionClient).closed {
+		t.Error("expected the region client with an rpc in flight to stay open")
+	}
+	c.inFlight.add(reg2, -1)
+	c.closeIdle(c.clients.activity())
+	if !rc2.(*closingRegionClient).closed {
+		t.Error("expected the region client to be closed once idle")
+	}
+}
@@ -13,6 +13,7 @@ import (
 	"context"
 	"crypto/rand"
 	"encoding/json"
+	"errors"
 	"flag"
 	"fmt"
 	"io"
@@ -144,6 +145,32 @@ func TestClusterStatus(t *testing.T) {
 	}
 }
 
+// Test reading the cluster ID from ZooKeeper
+func TestClusterID(t *testing.T) {
+	c := gohbase.NewClient(*host)
+	defer c.Close()
+
+	id, err := c.(gohbase.ClusterClient).ClusterID()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// it's the same as the one in the cluster status
+	ac := gohbase.NewAdminClient(*host)
+	stats, err := ac.ClusterStatus()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if exp := stats.GetClusterId().GetClusterId(); id != exp {
+		t.Errorf("expected cluster ID %q, got %q", exp, id)
+	}
+
+	cid, err := gohbase.NewClientWithClusterID(*host, id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cid.Close()
+}
+
 func TestGet(t *testing.T) {
 	key := "row1"
 	val := []byte("1")
@@ -214,6 +241,48 @@ func TestGetDoesntExist(t *testing.T) {
 	}
 }
 
+func TestGetExistsOnly(t *testing.T) {
+	c := gohbase.NewClient(*host)
+	defer c.Close()
+	key := "row_exists_only"
+	values := map[string]map[string][]byte{"cf": map[string][]byte{
+		"a": bytes.Repeat([]byte("v"), 1024),
+	}}
+	put, err := hrpc.NewPutStr(context.Background(), table, key, values)
+	if err != nil {
+		t.Fatalf("Failed to create Put request: %s", err)
+	}
+	if _, err = c.Put(put); err != nil {
+		t.Fatalf("Put returned an error: %v", err)
+	}
+
+	tests := []struct {
+		key    string
+		exists bool
+	}{
+		{key: key, exists: true},
+		{key: key + "_doesnt_exist", exists: false},
+	}
+	for _, tcase := range tests {
+		get, err := hrpc.NewGetStr(context.Background(), table, tcase.key,
+			hrpc.Families(map[string][]string{"cf": nil}), hrpc.ExistsOnly(true))
+		if err != nil {
+			t.Fatalf("Failed to create Get request: %s", err)
+		}
+		rsp, err := c.Get(get)
+		if err != nil {
+			t.Fatalf("Get returned an error: %v", err)
+		}
+		if rsp.Exists == nil || *rsp.Exists != tcase.exists {
+			t.Errorf("Expected exists=%v for row %q, got %v", tcase.exists, tcase.key, rsp.Exists)
+		}
+		if len(rsp.Cells) != 0 {
+			t.Errorf("Expected no cells to be returned for row %q, got %d",
+				tcase.key, len(rsp.Cells))
+		}
+	}
+}
+
 func TestMutateGetTableNotFound(t *testing.T) {
 	c := gohbase.NewClient(*host)
 	defer c.Close()
@@ -538,6 +607,43 @@ func TestPutTimestamp(t *testing.T) {
 	}
 }
 
+func TestPutCellTimestamp(t *testing.T) {
+	key := "TestPutCellTimestamp"
+	c := gohbase.NewClient(*host)
+	defer c.Close()
+	values := map[string]map[string][]byte{"cf": map[string][]byte{
+		"a": []byte("1"),
+		"b": []byte("2"),
+	}}
+	put, err := hrpc.NewPutStr(context.Background(), table, key, values,
+		hrpc.CellTimestamp("cf", "a", 50), hrpc.CellTimestamp("cf", "b", 60))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.Put(put); err != nil {
+		t.Fatalf("Put failed: %s", err)
+	}
+	get, err := hrpc.NewGetStr(context.Background(), table, key,
+		hrpc.Families(map[string][]string{"cf": nil}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	rsp, err := c.Get(get)
+	if err != nil {
+		t.Fatalf("Get failed: %s", err)
+	}
+	exp := map[string]uint64{"a": 50, "b": 60}
+	if len(rsp.Cells) != len(exp) {
+		t.Fatalf("expected %d cells, got %v", len(exp), rsp.Cells)
+	}
+	for _, cell := range rsp.Cells {
+		if ts := *cell.Timestamp; ts != exp[string(cell.Qualifier)] {
+			t.Errorf("expected timestamp %d of cell %s, got %d",
+				exp[string(cell.Qualifier)], cell.Qualifier, ts)
+		}
+	}
+}
+
 // TestDelete preps state with two column families, cf1 and cf2,
 // each having 3 versions at timestamps 50, 51, 52
 func TestDelete(t *testing.T) {
@@ -1247,6 +1353,39 @@ func TestIncrementParallel(t *testing.T) {
 	}
 }
 
+func TestMutateProcessed(t *testing.T) {
+	c := gohbase.NewClient(*host)
+	defer c.Close()
+
+	key := "row_processed"
+	put, err := hrpc.NewPutStr(context.Background(), table, key,
+		map[string]map[string][]byte{"cf": {"a": []byte("1")}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := c.Put(put)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Processed == nil || !*res.Processed {
+		t.Errorf("expected put to be processed, got %v", res.Processed)
+	}
+
+	// HBase doesn't tell whether a delete found anything to delete
+	del, err := hrpc.NewDelStr(context.Background(), table, key+"_doesnt_exist",
+		map[string]map[string][]byte{"cf": {"a": nil}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err = c.Delete(del)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Processed == nil || !*res.Processed {
+		t.Errorf("expected delete of a missing cell to be processed, got %v", res.Processed)
+	}
+}
+
 func TestCheckAndPut(t *testing.T) {
 	c := gohbase.NewClient(*host)
 	defer c.Close()
@@ -1299,6 +1438,52 @@ func TestCheckAndPut(t *testing.T) {
 	// TODO: check the resulting state by performing a Get request
 }
 
+func TestCheckAndPutCompare(t *testing.T) {
+	c := gohbase.NewClient(*host)
+	defer c.Close()
+
+	key := "row102"
+	putRequest, err := hrpc.NewPutStr(context.Background(), table, key,
+		map[string]map[string][]byte{"cf": map[string][]byte{"a": []byte("5")}})
+	if err != nil {
+		t.Fatalf("NewPutStr returned an error: %v", err)
+	}
+	if _, err := c.Put(putRequest); err != nil {
+		t.Fatalf("Put returned an error: %v", err)
+	}
+
+	var castests = []struct {
+		op         filter.CompareType
+		comparator filter.Comparator
+		out        bool
+	}{
+		{filter.Greater, filter.NewBinaryComparator(
+			filter.NewByteArrayComparable([]byte("4"))), false},
+		{filter.Greater, filter.NewBinaryComparator(
+			filter.NewByteArrayComparable([]byte("6"))), true},
+		{filter.Equal, filter.NewRegexStringComparator("^[0-9]+$", 0, "UTF-8", ""), true},
+		{filter.Equal, filter.NewRegexStringComparator("^[a-z]+$", 0, "UTF-8", ""), false},
+	}
+
+	for _, tt := range castests {
+		putRequest, err := hrpc.NewPutStr(context.Background(), table, key,
+			map[string]map[string][]byte{"cf": map[string][]byte{"b": []byte("1")}})
+		if err != nil {
+			t.Fatalf("NewPutStr returned an error: %v", err)
+		}
+
+		casRes, err := c.(gohbase.CheckAndMutateClient).CheckAndPutCompare(
+			putRequest, "cf", "a", tt.op, tt.comparator)
+		if err != nil {
+			t.Fatalf("CheckAndPutCompare error: %s", err)
+		}
+		if casRes != tt.out {
+			t.Errorf("CheckAndPutCompare with op %v and comparator %v returned %v, want %v",
+				tt.op, tt.comparator, casRes, tt.out)
+		}
+	}
+}
+
 func TestCheckAndPutNotPut(t *testing.T) {
 	key := "row101"
 	c := gohbase.NewClient(*host)
@@ -1656,6 +1841,155 @@ func TestMaxResultsPerColumnFamilyGet(t *testing.T) {
 	}
 }
 
+func TestColumnPaginationGet(t *testing.T) {
+	c := gohbase.NewClient(*host)
+	defer c.Close()
+	key := "widecolumnrow"
+
+	// Save a row with 50 columns
+	values := map[string]map[string][]byte{"cf": map[string][]byte{}}
+	for i := 0; i < 50; i++ {
+		values["cf"][fmt.Sprintf("%02d", i)] = []byte(fmt.Sprintf("value %d", i))
+	}
+	putRequest, err := hrpc.NewPutStr(context.Background(), table, key, values)
+	if err != nil {
+		t.Fatalf("Failed to create put request: %s", err)
+	}
+	if _, err = c.Put(putRequest); err != nil {
+		t.Fatalf("Failed to put: %s", err)
+	}
+
+	tests := []struct {
+		offset, limit uint32
+		expected      []int
+	}{
+		{offset: 0, limit: 1, expected: []int{0}},
+		{offset: 0, limit: 5, expected: []int{0, 1, 2, 3, 4}},
+		{offset: 10, limit: 3, expected: []int{10, 11, 12}},
+		{offset: 47, limit: 10, expected: []int{47, 48, 49}},
+		{offset: 49, limit: 1, expected: []int{49}},
+		{offset: 50, limit: 10, expected: nil},
+	}
+	for _, tcase := range tests {
+		t.Run(fmt.Sprintf("offset=%d,limit=%d", tcase.offset, tcase.limit), func(t *testing.T) {
+			get, err := hrpc.NewGetStr(context.Background(), table, key,
+				hrpc.Families(map[string][]string{"cf": nil}),
+				hrpc.MaxVersions(1),
+				hrpc.MaxResultsPerColumnFamily(tcase.limit),
+				hrpc.ResultOffset(tcase.offset),
+			)
+			if err != nil {
+				t.Fatalf("Failed to create get request: %s", err)
+			}
+			result, err := c.Get(get)
+			if err != nil {
+				t.Fatalf("Failed to get: %s", err)
+			}
+			if len(result.Cells) != len(tcase.expected) {
+				t.Fatalf("Expected %d cells, got %d", len(tcase.expected), len(result.Cells))
+			}
+			for i, cell := range result.Cells {
+				expQualifier := fmt.Sprintf("%02d", tcase.expected[i])
+				if string(cell.Qualifier) != expQualifier {
+					t.Errorf("Expected qualifier %q, got %q", expQualifier, cell.Qualifier)
+				}
+			}
+		})
+	}
+
+	// page through the whole row
+	var qualifiers []string
+	for offset := uint32(0); ; offset += 7 {
+		get, err := hrpc.NewGetStr(context.Background(), table, key,
+			hrpc.Families(map[string][]string{"cf": nil}),
+			hrpc.MaxResultsPerColumnFamily(7),
+			hrpc.ResultOffset(offset),
+		)
+		if err != nil {
+			t.Fatalf("Failed to create get request: %s", err)
+		}
+		result, err := c.Get(get)
+		if err != nil {
+			t.Fatalf("Failed to get: %s", err)
+		}
+		if len(result.Cells) == 0 {
+			break
+		}
+		for _, cell := range result.Cells {
+			qualifiers = append(qualifiers, string(cell.Qualifier))
+		}
+	}
+	if len(qualifiers) != 50 {
+		t.Fatalf("Expected 50 columns when paging, got %d", len(qualifiers))
+	}
+	for i, q := range qualifiers {
+		if q != fmt.Sprintf("%02d", i) {
+			t.Errorf("Expected qualifier %02d, got %s", i, q)
+		}
+	}
+}
+
+func TestSingleColumnValueExcludeFilter(t *testing.T) {
+	c := gohbase.NewClient(*host)
+	defer c.Close()
+
+	// the second row doesn't have the tested column
+	rows := []map[string][]byte{
+		{"a": []byte("1"), "b": []byte("x")},
+		{"b": []byte("y")},
+		{"a": []byte("2"), "b": []byte("z")},
+	}
+	for i, values := range rows {
+		key := fmt.Sprintf("scvef_%d", i)
+		put, err := hrpc.NewPutStr(context.Background(), table, key,
+			map[string]map[string][]byte{"cf": values})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := c.Put(put); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	scan := func(filterIfMissing bool) []string {
+		f := filter.NewList(filter.MustPassAll,
+			filter.NewPrefixFilter([]byte("scvef_")),
+			filter.NewSingleColumnValueExcludeFilter(filter.NewSingleColumnValueFilter(
+				[]byte("cf"), []byte("a"), filter.GreaterOrEqual,
+				filter.NewBinaryComparator(filter.NewByteArrayComparable([]byte("1"))),
+				filterIfMissing, true)))
+		scan, err := hrpc.NewScanStr(context.Background(), table,
+			hrpc.Families(map[string][]string{"cf": nil}), hrpc.Filters(f))
+		if err != nil {
+			t.Fatal(err)
+		}
+		var cells []string
+		scanner := c.Scan(scan)
+		for {
+			r, err := scanner.Next()
+			if err == io.EOF {
+				break
+			} else if err != nil {
+				t.Fatal(err)
+			}
+			for _, cell := range r.Cells {
+				cells = append(cells, fmt.Sprintf("%s:%s", cell.Row, cell.Qualifier))
+			}
+		}
+		return cells
+	}
+
+	// the tested column is dropped from the results
+	exp := []string{"scvef_0:b", "scvef_2:b"}
+	if got := scan(true); !reflect.DeepEqual(exp, got) {
+		t.Errorf("expected cells %q with filterIfMissing, got %q", exp, got)
+	}
+	exp = []string{"scvef_0:b", "scvef_1:b", "scvef_2:b"}
+	if got := scan(false); !reflect.DeepEqual(exp, got) {
+		t.Errorf("expected cells %q without filterIfMissing, got %q", exp, got)
+	}
+}
+
 func TestMaxResultsPerColumnFamilyScan(t *testing.T) {
 	c := gohbase.NewClient(*host)
 	defer c.Close()
@@ -2374,3 +2708,99 @@ func TestDebugState(t *testing.T) {
 	assert.Equal(t, 1, len(keyRegionCache.(map[string]interface{})))
 	assert.Equal(t, 1, len(clientRegionCache.(map[string]interface{}))) // only have one client
 }
+
+func TestColumnFamilyTimeRange(t *testing.T) {
+	c := gohbase.NewClient(*host)
+	defer c.Close()
+	key := "TestColumnFamilyTimeRange"
+
+	// write versions at timestamps 10, 20 and 30 to both families,
+	// no more than the 3 versions the families of the table keep
+	for ts := uint64(10); ts <= 30; ts += 10 {
+		for _, cf := range []string{"cf", "cf2"} {
+			if err := insertKeyValue(c, key, cf, []byte(strconv.FormatUint(ts, 10)),
+				hrpc.TimestampUint64(ts)); err != nil {
+				t.Fatalf("Failed to put: %s", err)
+			}
+		}
+	}
+
+	expected := map[string][]uint64{
+		"cf":  {10},
+		"cf2": {30, 20},
+	}
+	families := map[string][]string{"cf": nil, "cf2": nil}
+	options := []func(hrpc.Call) error{
+		hrpc.Families(families),
+		hrpc.MaxVersions(math.MaxInt32),
+		hrpc.ColumnFamilyTimeRangeUint64("cf", 10, 20),
+		hrpc.ColumnFamilyTimeRangeUint64("cf2", 20, 40),
+	}
+	check := func(t *testing.T, cells []*hrpc.Cell) {
+		got := map[string][]uint64{}
+		for _, cell := range cells {
+			got[string(cell.Family)] = append(got[string(cell.Family)], *cell.Timestamp)
+		}
+		if !reflect.DeepEqual(expected, got) {
+			t.Errorf("expected timestamps %v, got %v", expected, got)
+		}
+	}
+
+	t.Run("get", func(t *testing.T) {
+		get, err := hrpc.NewGetStr(context.Background(), table, key, options...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		rsp, err := c.Get(get)
+		if err != nil {
+			t.Fatalf("Failed to get: %s", err)
+		}
+		check(t, rsp.Cells)
+	})
+
+	t.Run("scan", func(t *testing.T) {
+		scan, err := hrpc.NewScanRangeStr(context.Background(), table, key, key+"\x00",
+			options...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var cells []*hrpc.Cell
+		scanner := c.Scan(scan)
+		for {
+			r, err := scanner.Next()
+			if err == io.EOF {
+				break
+			} else if err != nil {
+				t.Fatalf("Failed to scan: %s", err)
+			}
+			cells = append(cells, r.Cells...)
+		}
+		check(t, cells)
+	})
+}
+
+func TestGetTableState(t *testing.T) {
+	ac := gohbase.NewAdminClient(*host)
+	check := func(expected pb.Table_State) {
+		t.Helper()
+		if state, err := ac.GetTableState(table); err != nil || state != expected {
+			t.Errorf("expected table %s to be %s, got %s, %v", table, expected, state, err)
+		}
+	}
+	check(pb.Table_ENABLED)
+
+	if err := ac.DisableTable(hrpc.NewDisableTable(context.Background(),
+		[]byte(table))); err != nil {
+		t.Fatal(err)
+	}
+	check(pb.Table_DISABLED)
+	if err := ac.EnableTable(hrpc.NewEnableTable(context.Background(),
+		[]byte(table))); err != nil {
+		t.Fatal(err)
+	}
+	check(pb.Table_ENABLED)
+
+	if _, err := ac.GetTableState("NonExistentTable"); !errors.Is(err, gohbase.TableNotFound) {
+		t.Errorf("expected TableNotFound for a table that doesn't exist, got %v", err)
+	}
+}
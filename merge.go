@@ -0,0 +1,120 @@
+// Copyright (C) 2026  The GoHBase Authors.  All rights reserved.
+// This file is part of GoHBase.
+// Use of this source code is governed by the Apache License 2.0
+// that can be found in the COPYING file.
+
+package gohbase
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/baiweiguo/gohbase/hrpc"
+	"github.com/baiweiguo/gohbase/pb"
+	"github.com/baiweiguo/gohbase/region"
+	"github.com/baiweiguo/gohbase/zk"
+)
+
+func (c *client) MergeRegions(ctx context.Context, regionA, regionB []byte,
+	forcible bool) error {
+	if bytes.Equal(regionA, regionB) {
+		return fmt.Errorf("can't merge region %q with itself", regionA)
+	}
+	encodedA, err := encodedRegionName(regionA)
+	if err != nil {
+		return err
+	}
+	encodedB, err := encodedRegionName(regionB)
+	if err != nil {
+		return err
+	}
+	regions, err := c.metaRegions(ctx, regionA, regionB)
+	if err != nil {
+		return err
+	}
+	regA, regB := regions[0], regions[1]
+	if !bytes.Equal(fullyQualifiedTable(regA), fullyQualifiedTable(regB)) {
+		return fmt.Errorf("regions %s and %s are of different tables", regA, regB)
+	}
+	if !forcible && !adjacentRegions(regA, regB) {
+		return fmt.Errorf("regions %s and %s aren't adjacent", regA, regB)
+	}
+
+	pbmsg, err := c.SendRPC(hrpc.NewMergeRegions(ctx, encodedA, encodedB, forcible))
+	if err != nil {
+		return err
+	}
+	r, ok := pbmsg.(*pb.MergeTableRegionsResponse)
+	if !ok {
+		return errors.New("sendRPC returned not a MergeTableRegionsResponse")
+	}
+	return c.checkProcedureWithBackoff(ctx, r.GetProcId())
+}
+
+// adjacentRegions returns whether a region ends where the other one starts.
+func adjacentRegions(a, b hrpc.RegionInfo) bool {
+	return len(a.StopKey()) > 0 && bytes.Equal(a.StopKey(), b.StartKey()) ||
+		len(b.StopKey()) > 0 && bytes.Equal(b.StopKey(), a.StartKey())
+}
+
+// encodedRegionName returns the encoded name at the end of the full name
+// of a region, which is of the form table,start_key,region_id.encoded_name.
+func encodedRegionName(regionName []byte) ([]byte, error) {
+	if _, _, err := parseRegionName(regionName); err != nil {
+		return nil, err
+	}
+	name := bytes.TrimSuffix(regionName, []byte{'.'})
+	i := bytes.LastIndexByte(name, '.')
+	if len(name) == len(regionName) || i < bytes.LastIndexByte(name, ',') {
+		return nil, fmt.Errorf("region name %q has no encoded name", regionName)
+	}
+	return name[i+1:], nil
+}
+
+// metaRegions looks up the regions with the given full names in hbase:meta over
+// a dedicated connection to its RegionServer, since admin clients only send
+// their RPCs to the master.
+func (c *client) metaRegions(ctx context.Context,
+	regionNames ...[]byte) ([]hrpc.RegionInfo, error) {
+	addr, err := c.zkLookup(ctx, zk.Meta)
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up the RegionServer of hbase:meta: %w", err)
+	}
+	rc, err := c.dialRegionServer(ctx, addr, region.RegionClient)
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+
+	meta := region.NewInfo(0, []byte("hbase"), []byte("meta"), []byte("hbase:meta,,1"),
+		nil, nil)
+	regions := make([]hrpc.RegionInfo, len(regionNames))
+	for i, name := range regionNames {
+		get, err := hrpc.NewGet(ctx, metaTableName, name, hrpc.Families(infoFamily))
+		if err != nil {
+			return nil, err
+		}
+		get.SetRegion(meta)
+		res, err := sendBlocking(ctx, rc, get)
+		if err != nil {
+			return nil, err
+		}
+		if res.Error != nil {
+			return nil, res.Error
+		}
+		r, ok := res.Msg.(*pb.GetResponse)
+		if !ok {
+			return nil, fmt.Errorf("sendRPC returned a %T instead of GetResponse", res.Msg)
+		}
+		if len(r.GetResult().GetCell()) == 0 {
+			return nil, fmt.Errorf("%w: region %q isn't in hbase:meta",
+				ErrCannotFindRegion, name)
+		}
+		if regions[i], _, err = region.ParseRegionInfo(hrpc.ToLocalResult(r.Result)); err != nil {
+			return nil, err
+		}
+	}
+	return regions, nil
+}
@@ -0,0 +1,183 @@
+// Copyright (C) 2026  The GoHBase Authors.  All rights reserved.
+// This file is part of GoHBase.
+// Use of this source code is governed by the Apache License 2.0
+// that can be found in the COPYING file.
+
+package gohbase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/baiweiguo/gohbase/compression"
+	"github.com/baiweiguo/gohbase/hrpc"
+	"github.com/baiweiguo/gohbase/pb"
+	"github.com/baiweiguo/gohbase/region"
+	"github.com/baiweiguo/gohbase/test"
+	mockRegion "github.com/baiweiguo/gohbase/test/mock/region"
+	mockZk "github.com/baiweiguo/gohbase/test/mock/zk"
+	"github.com/baiweiguo/gohbase/zk"
+	"github.com/golang/mock/gomock"
+	"google.golang.org/protobuf/proto"
+)
+
+// metaRowsRegionClient answers the Gets of hbase:meta with the rows
+// of the regions keyed by their names.
+type metaRowsRegionClient struct {
+	addr  string
+	ctype region.ClientType
+	rows  map[string]*pb.Result
+}
+
+func (c *metaRowsRegionClient) Dial(context.Context) error { return nil }
+func (c *metaRowsRegionClient) Close()                     {}
+func (c *metaRowsRegionClient) Addr() string               { return c.addr }
+func (c *metaRowsRegionClient) String() string             { return c.addr }
+
+func (c *metaRowsRegionClient) QueueRPC(call hrpc.Call) {
+	if _, ok := call.(*hrpc.Get); !ok || c.ctype != region.RegionClient ||
+		string(call.Table()) != "hbase:meta" {
+		call.ResultChan() <- hrpc.RPCResult{
+			Error: fmt.Errorf("unexpected %s over %s", call.Name(), c.ctype)}
+		return
+	}
+	call.ResultChan() <- hrpc.RPCResult{
+		Msg: &pb.GetResponse{Result: c.rows[string(call.Key())]}}
+}
+
+func (c *metaRowsRegionClient) QueueBatch(ctx context.Context, calls []hrpc.Call) {
+	for _, call := range calls {
+		c.QueueRPC(call)
+	}
+}
+
+// regionMetaRow returns the row of hbase:meta of the region of table with the
+// given name from start to stop.
+func regionMetaRow(table, name, start, stop string) *pb.Result {
+	info, err := proto.Marshal(&pb.RegionInfo{
+		RegionId: proto.Uint64(1),
+		TableName: &pb.TableName{
+			Namespace: []byte("default"),
+			Qualifier: []byte(table),
+		},
+		StartKey: []byte(start),
+		EndKey:   []byte(stop),
+	})
+	if err != nil {
+		panic(err)
+	}
+	return &pb.Result{Cell: []*pb.Cell{{
+		Row:       []byte(name),
+		Family:    []byte("info"),
+		Qualifier: []byte("regioninfo"),
+		Value:     append([]byte("PBUF"), info...),
+	}, {
+		Row:       []byte(name),
+		Family:    []byte("info"),
+		Qualifier: []byte("server"),
+		Value:     []byte("regionserver:1"),
+	}}}
+}
+
+func TestMergeRegions(t *testing.T) {
+	ctrl := test.NewController(t)
+	defer ctrl.Finish()
+	zkc := mockZk.NewMockClient(ctrl)
+	zkc.EXPECT().LocateResource(zk.Meta).Return("regionserver:1", nil).AnyTimes()
+	c := newMockClient(zkc)
+	c.clientType = region.MasterClient
+	c.adminRegionInfo = region.NewInfo(0, nil, nil, nil, nil, nil)
+
+	const (
+		a     = "test,,1.aaaa."
+		b     = "test,f,1.bbbb."
+		cc    = "test,m,1.cccc."
+		other = "other,,1.dddd."
+	)
+	rows := map[string]*pb.Result{
+		a:     regionMetaRow("test", a, "", "f"),
+		b:     regionMetaRow("test", b, "f", "m"),
+		cc:    regionMetaRow("test", cc, "m", ""),
+		other: regionMetaRow("other", other, "", ""),
+	}
+	c.newRegionClientFn = func(addr string, ctype region.ClientType, _ int, _ time.Duration,
+		_ string, _ time.Duration, _ compression.Codec,
+		_ ...region.ClientOption) hrpc.RegionClient {
+		return &metaRowsRegionClient{addr: addr, ctype: ctype, rows: rows}
+	}
+
+	var (
+		m      sync.Mutex
+		merges []string
+	)
+	master := mockRegion.NewMockRegionClient(ctrl)
+	master.EXPECT().QueueRPC(gomock.Any()).AnyTimes().Do(func(rpc hrpc.Call) {
+		switch r := rpc.ToProto().(type) {
+		case *pb.MergeTableRegionsRequest:
+			m.Lock()
+			merges = append(merges, fmt.Sprintf("%s+%s forcible=%v",
+				r.Region[0].Value, r.Region[1].Value, r.GetForcible()))
+			m.Unlock()
+			rpc.ResultChan() <- hrpc.RPCResult{
+				Msg: &pb.MergeTableRegionsResponse{ProcId: proto.Uint64(7)}}
+		case *pb.GetProcedureResultRequest:
+			if r.GetProcId() != 7 {
+				t.Errorf("expected procedure 7, got %d", r.GetProcId())
+			}
+			rpc.ResultChan() <- hrpc.RPCResult{Msg: &pb.GetProcedureResultResponse{
+				State: pb.GetProcedureResultResponse_FINISHED.Enum()}}
+		default:
+			rpc.ResultChan() <- hrpc.RPCResult{Error: fmt.Errorf("unexpected %s", rpc.Name())}
+		}
+	})
+	c.adminRegionInfo.SetClient(master)
+
+	ctx := context.Background()
+	for name, tc := range map[string]struct {
+		regionA, regionB string
+		forcible         bool
+		merge            string
+		err              error
+	}{
+		"adjacent":         {regionA: a, regionB: b, merge: "aaaa+bbbb forcible=false"},
+		"adjacentReversed": {regionA: cc, regionB: b, merge: "cccc+bbbb forcible=false"},
+		"notAdjacent":      {regionA: a, regionB: cc},
+		"forcible": {regionA: a, regionB: cc, forcible: true,
+			merge: "aaaa+cccc forcible=true"},
+		"differentTables": {regionA: a, regionB: other, forcible: true},
+		"missing":         {regionA: a, regionB: "test,z,1.eeee.", err: ErrCannotFindRegion},
+		"noEncodedName":   {regionA: a, regionB: "test,f,1"},
+		"malformedName":   {regionA: "test", regionB: b},
+		"sameRegionTwice": {regionA: a, regionB: a, forcible: true},
+	} {
+		t.Run(name, func(t *testing.T) {
+			err := c.MergeRegions(ctx, []byte(tc.regionA), []byte(tc.regionB), tc.forcible)
+			m.Lock()
+			got := merges
+			merges = nil
+			m.Unlock()
+			if tc.merge == "" {
+				if err == nil {
+					t.Error("expected an error")
+				} else if tc.err != nil && !errors.Is(err, tc.err) {
+					t.Errorf("expected an error matching %v, got %v", tc.err, err)
+				}
+				if len(got) != 0 {
+					t.Errorf("expected no merge, got %q", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if exp := []string{tc.merge}; !reflect.DeepEqual(exp, got) {
+				t.Errorf("expected merges %q, got %q", exp, got)
+			}
+		})
+	}
+}
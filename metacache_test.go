@@ -7,11 +7,17 @@ package gohbase
 
 import (
 	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
 	"fmt"
+	"math"
 	"reflect"
 	"sort"
 	"strconv"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/baiweiguo/gohbase/hrpc"
 	"github.com/baiweiguo/gohbase/region"
@@ -315,6 +321,155 @@ func TestMetaCacheGet(t *testing.T) {
 	}
 }
 
+// TestMetaCacheGetSignedKeys checks that keys encoding signed integers are routed
+// to the regions containing them in the byte order HBase sorts rows in, in which
+// negative integers in two's complement come after the positive ones.
+func TestMetaCacheGetSignedKeys(t *testing.T) {
+	c := newMockClient(nil)
+	regions := []hrpc.RegionInfo{
+		region.NewInfo(0, nil, []byte("test"),
+			[]byte("test,,1234567890042.56f833d5569a27c7a43fbf547b4924a4."),
+			nil, []byte("\x00\x00\x00\x10")),
+		region.NewInfo(0, nil, []byte("test"),
+			[]byte("test,\x00\x00\x00\x10,1234567890042.56f833d5569a27c7a43fbf547b4924a4."),
+			[]byte("\x00\x00\x00\x10"), []byte("\x80\x00\x00\x00")),
+		region.NewInfo(0, nil, []byte("test"),
+			[]byte("test,\x80\x00\x00\x00,1234567890042.56f833d5569a27c7a43fbf547b4924a4."),
+			[]byte("\x80\x00\x00\x00"), nil),
+	}
+	for _, reg := range regions {
+		c.regions.put(reg)
+	}
+
+	for key, i := range map[int32]int{
+		0:             0,
+		15:            0,
+		16:            1,
+		math.MaxInt32: 1,
+		math.MinInt32: 2,
+		-1:            2,
+	} {
+		k := make([]byte, 4)
+		binary.BigEndian.PutUint32(k, uint32(key))
+		if reg := c.getRegionFromCache([]byte("test"), k); reg != regions[i] {
+			t.Errorf("expected key %d in region %v, got %v", key, regions[i], reg)
+		}
+	}
+}
+
+// TestMetaCacheGetBelowStartKey checks that a key below the start key of the
+// region found in cache, whose name disagrees with its start key, is a cache
+// miss that is looked up instead of being routed to the region.
+func TestMetaCacheGetBelowStartKey(t *testing.T) {
+	c := NewClientWithResolver(singleRegionResolver{},
+		func(addr string) hrpc.RegionClient {
+			return &flakyRegionClient{addr: addr, srv: &flakyServer{}}
+		}).(*client)
+	defer c.Close()
+
+	// the name of the region sorts before its start key
+	offRegion := region.NewInfo(0, nil, []byte("test"),
+		[]byte("test,a,1234567890042.56f833d5569a27c7a43fbf547b4924a4."),
+		[]byte("c"), []byte("f"))
+	c.regions.put(offRegion)
+
+	for _, key := range []string{"b", "b\xff\xff"} {
+		if reg := c.getRegionFromCache([]byte("test"), []byte(key)); reg != nil {
+			t.Errorf("expected a cache miss for key %q, got %v", key, reg)
+		}
+	}
+	for _, key := range []string{"c", "e"} {
+		if reg := c.getRegionFromCache([]byte("test"), []byte(key)); reg != offRegion {
+			t.Errorf("expected key %q in region %v, got %v", key, offRegion, reg)
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	get, err := hrpc.NewGetStr(ctx, "test", "b")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.Get(get); err != nil {
+		t.Fatal(err)
+	}
+	if n := c.Stats().RegionLookups; n != 1 {
+		t.Errorf("expected the key to be looked up once, got %d lookups", n)
+	}
+	reg := c.getRegionFromCache([]byte("test"), []byte("b"))
+	if reg == nil || len(reg.StartKey()) != 0 {
+		t.Errorf("expected the looked up region in cache, got %v", reg)
+	}
+}
+
+func TestInvalidateTableRegions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	c := newMockClient(nil)
+
+	newRegion := func(table, start, stop string) hrpc.RegionInfo {
+		name := table + "," + start + ",1234567890042.56f833d5569a27c7a43fbf547b4924a4."
+		var startKey, stopKey []byte
+		if start != "" {
+			startKey = []byte(start)
+		}
+		if stop != "" {
+			stopKey = []byte(stop)
+		}
+		reg := region.NewInfo(0, nil, []byte(table), []byte(name), startKey, stopKey)
+		c.regions.put(reg)
+		rc := mockRegion.NewMockRegionClient(ctrl)
+		rc.EXPECT().Addr().Return("regionserver:1").AnyTimes()
+		rc.EXPECT().String().Return("mock region client").AnyTimes()
+		reg.SetClient(c.clients.put("regionserver:1", reg, func() hrpc.RegionClient {
+			return rc
+		}))
+		return reg
+	}
+	target := []hrpc.RegionInfo{
+		newRegion("test", "", "foo"),
+		newRegion("test", "foo", ""),
+	}
+	// name of the other table has the name of the target table as prefix
+	other := []hrpc.RegionInfo{
+		newRegion("test1", "", "bar"),
+		newRegion("test1", "bar", ""),
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			c.InvalidateTableRegions("test")
+			c.getRegionFromCache([]byte("test1"), []byte("baz"))
+		}()
+	}
+	wg.Wait()
+
+	for _, reg := range target {
+		if r := c.getRegionFromCache([]byte("test"), reg.StartKey()); r != nil {
+			t.Errorf("expected no region in cache, got %v", r)
+		}
+		if reg.Client() != nil {
+			t.Errorf("expected region %v to have no client", reg)
+		}
+	}
+	for _, reg := range other {
+		if r := c.getRegionFromCache([]byte("test1"), reg.StartKey()); r != reg {
+			t.Errorf("expected region %v in cache, got %v", reg, r)
+		}
+		if reg.Client() == nil {
+			t.Errorf("expected region %v to keep its client", reg)
+		}
+	}
+	for _, regions := range c.clients.regions {
+		if len(regions) != len(other) {
+			t.Errorf("expected %d regions of client, got %d", len(other), len(regions))
+		}
+	}
+}
+
 func TestRegionCacheAge(t *testing.T) {
 	tcases := []struct {
 		cachedRegions []hrpc.RegionInfo
@@ -683,3 +838,164 @@ func TestClientCachePut(t *testing.T) {
 			len(client.clients.regions[regClient]))
 	}
 }
+
+func newRegions(n int) []hrpc.RegionInfo {
+	regs := make([]hrpc.RegionInfo, n)
+	for i := range regs {
+		regs[i] = region.NewInfo(0, nil, []byte("test"),
+			[]byte(fmt.Sprintf("test,%08d,1234567890042.yoloyoloyoloyoloyoloyoloyoloyolo.", i)),
+			[]byte(fmt.Sprintf("%08d", i)), []byte(fmt.Sprintf("%08d", i+1)))
+	}
+	return regs
+}
+
+// putRegions puts regs spread over clients in the cache of c.
+func putRegions(tb testing.TB, c *client, regs []hrpc.RegionInfo, clients []hrpc.RegionClient) {
+	for i, reg := range regs {
+		rc := clients[i%len(clients)]
+		if got := c.clients.put(rc.Addr(), reg,
+			func() hrpc.RegionClient { return rc }); got != rc {
+			tb.Fatalf("expected client %s, got %s", rc, got)
+		}
+	}
+}
+
+// newRegionClients returns region clients that aren't connected
+func newRegionClients(servers int) []hrpc.RegionClient {
+	clients := make([]hrpc.RegionClient, servers)
+	for i := range clients {
+		clients[i] = region.NewClient(fmt.Sprintf("regionserver:%d", i), region.RegionClient,
+			defaultRPCQueueSize, defaultFlushInterval, "root", region.DefaultReadTimeout, nil)
+	}
+	return clients
+}
+
+func TestRegionCacheCapacity(t *testing.T) {
+	const regions, servers = 10000, 10
+	c := newClient("~invalid.quorum~", RegionCacheCapacity(regions, servers))
+	if c.clients.regionsPerClient != regions/servers {
+		t.Errorf("expected a capacity of %d regions per client, got %d",
+			regions/servers, c.clients.regionsPerClient)
+	}
+
+	clients := newRegionClients(servers)
+	putRegions(t, c, newRegions(regions), clients)
+	if len(c.clients.regions) != servers {
+		t.Errorf("expected %d clients in cache, got %d", servers, len(c.clients.regions))
+	}
+	for _, rc := range clients {
+		if n := len(c.clients.regions[rc]); n != regions/servers {
+			t.Errorf("expected %d regions for client %s, got %d", regions/servers, rc, n)
+		}
+	}
+
+	// invalid capacities are ignored
+	c = newClient("~invalid.quorum~", RegionCacheCapacity(regions, 0))
+	if c.clients.regions == nil || c.clients.regionsPerClient != 0 {
+		t.Errorf("expected the default cache, got capacity %d", c.clients.regionsPerClient)
+	}
+}
+
+func BenchmarkClientCachePut(b *testing.B) {
+	const regions, servers = 10000, 10
+	for _, bm := range []struct {
+		name    string
+		options []Option
+	}{
+		{name: "default"},
+		{name: "presized", options: []Option{RegionCacheCapacity(regions, servers)}},
+	} {
+		b.Run(bm.name, func(b *testing.B) {
+			clients := newRegionClients(servers)
+			regs := newRegions(regions)
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				b.StopTimer()
+				c := newClient("~invalid.quorum~", bm.options...)
+				b.StartTimer()
+				putRegions(b, c, regs, clients)
+			}
+		})
+	}
+}
+
+// noResolver fails the lookups of regions.
+type noResolver struct{}
+
+func (noResolver) ResolveRegion(ctx context.Context,
+	table, key []byte) (hrpc.RegionInfo, string, error) {
+	return nil, "", errors.New("unexpected lookup")
+}
+
+func TestLoadRegionCache(t *testing.T) {
+	srv := &flakyServer{}
+	var (
+		m     sync.Mutex
+		dials []string
+	)
+	c := NewClientWithResolver(noResolver{},
+		func(addr string) hrpc.RegionClient { return &flakyRegionClient{addr: addr, srv: srv} },
+		ObserveDials(func(addr string, _ time.Duration, _ error) {
+			m.Lock()
+			dials = append(dials, addr)
+			m.Unlock()
+		})).(*client)
+	defer c.Close()
+
+	newRegion := func(start, stop string) hrpc.RegionInfo {
+		return region.NewInfo(1, nil, []byte("test"),
+			[]byte("test,"+start+",1234567890042.56f833d5569a27c7a43fbf547b4924a4."),
+			[]byte(start), []byte(stop))
+	}
+
+	// overlapping regions aren't loaded
+	err := c.LoadRegionCache([]hrpc.RegionLocation{
+		{Region: newRegion("", "m"), Addr: "regionserver:1"},
+		{Region: newRegion("k", ""), Addr: "regionserver:2"},
+	})
+	if err == nil {
+		t.Error("expected an error loading overlapping regions")
+	}
+	if reg := c.getRegionFromCache([]byte("test"), []byte("a")); reg != nil {
+		t.Errorf("expected no region in cache, got %v", reg)
+	}
+
+	snapshot := []hrpc.RegionLocation{
+		{Region: newRegion("m", ""), Addr: "regionserver:2"},
+		{Region: newRegion("", "m"), Addr: "regionserver:1"},
+	}
+	if err := c.LoadRegionCache(snapshot); err != nil {
+		t.Fatal(err)
+	}
+	if n := srv.dialCount(); n != 0 {
+		t.Errorf("expected no dial before the regions are used, got %d", n)
+	}
+
+	for _, key := range []string{"a", "x", "b"} {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		get, err := hrpc.NewGetStr(ctx, "test", key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := c.Get(get); err != nil {
+			t.Fatal(err)
+		}
+		cancel()
+	}
+
+	// the regions are found in cache and established at their loaded address
+	stats := c.Stats()
+	if stats.RegionLookups != 0 || stats.Reconnects != 0 {
+		t.Errorf("expected no lookup and no reconnect, got %+v", stats)
+	}
+	for _, l := range snapshot {
+		if reg := c.getRegionFromCache([]byte("test"), l.Region.StartKey()); reg != l.Region {
+			t.Errorf("expected region %v in cache, got %v", l.Region, reg)
+		}
+	}
+	m.Lock()
+	defer m.Unlock()
+	if exp := []string{"regionserver:1", "regionserver:2"}; !reflect.DeepEqual(exp, dials) {
+		t.Errorf("expected dials to %q, got %q", exp, dials)
+	}
+}
@@ -177,7 +177,8 @@ func init() {
 
 func newMockRegionClient(addr string, ctype region.ClientType, queueSize int,
 	flushInterval time.Duration, effectiveUser string,
-	readTimeout time.Duration, codec compression.Codec) hrpc.RegionClient {
+	readTimeout time.Duration, codec compression.Codec,
+	options ...region.ClientOption) hrpc.RegionClient {
 	m.Lock()
 	clients[addr]++
 	m.Unlock()
@@ -0,0 +1,65 @@
+// Copyright (C) 2026  The GoHBase Authors.  All rights reserved.
+// This file is part of GoHBase.
+// Use of this source code is governed by the Apache License 2.0
+// that can be found in the COPYING file.
+
+package gohbase
+
+import (
+	"sync"
+	"time"
+)
+
+// nsreStorm detects bursts of NotServingRegionError across many regions, such
+// as during rolling restarts, and spreads out reconnecting them under a shared
+// backoff, see NotServingRegionStorm option. A nil nsreStorm is disabled.
+type nsreStorm struct {
+	threshold int
+	window    time.Duration
+	backoff   time.Duration
+
+	m sync.Mutex
+	// errors are the times of the errors within the latest window
+	errors []time.Time
+	// next is the time of the next reconnect allowed during a storm,
+	// zero if there's no storm
+	next time.Time
+}
+
+func newNSREStorm(threshold int, window, backoff time.Duration) *nsreStorm {
+	return &nsreStorm{threshold: threshold, window: window, backoff: backoff}
+}
+
+// record records a NotServingRegionError of a region at now and returns
+// how long to wait before reconnecting the region.
+func (s *nsreStorm) record(now time.Time) time.Duration {
+	if s == nil {
+		return 0
+	}
+	s.m.Lock()
+	defer s.m.Unlock()
+	i := 0
+	for i < len(s.errors) && now.Sub(s.errors[i]) >= s.window {
+		i++
+	}
+	s.errors = append(s.errors[i:], now)
+
+	if !s.next.IsZero() && now.After(s.next) && len(s.errors) < s.threshold {
+		// the storm is over
+		s.next = time.Time{}
+	}
+	if s.next.IsZero() {
+		if len(s.errors) < s.threshold {
+			return 0
+		}
+		// a storm starts, reconnects wait for the shared backoff
+		s.next = now.Add(s.backoff)
+	}
+	// and are spread out so that at most threshold of them are made per backoff
+	slot := s.next
+	if slot.Before(now) {
+		slot = now
+	}
+	s.next = slot.Add(s.backoff / time.Duration(s.threshold))
+	return slot.Sub(now)
+}
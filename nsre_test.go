@@ -0,0 +1,140 @@
+// Copyright (C) 2026  The GoHBase Authors.  All rights reserved.
+// This file is part of GoHBase.
+// Use of this source code is governed by the Apache License 2.0
+// that can be found in the COPYING file.
+
+package gohbase
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/baiweiguo/gohbase/hrpc"
+	"github.com/baiweiguo/gohbase/pb"
+	"github.com/baiweiguo/gohbase/region"
+)
+
+// regionsResolver resolves the start keys of its regions to them.
+type regionsResolver map[string]hrpc.RegionInfo
+
+func (r regionsResolver) ResolveRegion(ctx context.Context,
+	table, key []byte) (hrpc.RegionInfo, string, error) {
+	reg, ok := r[string(key)]
+	if !ok {
+		return nil, "", TableNotFound
+	}
+	return reg, "regionserver:1", nil
+}
+
+// probeRecorder is a region client recording when regions are probed.
+type probeRecorder struct {
+	m      sync.Mutex
+	probes []time.Time
+}
+
+func (c *probeRecorder) Dial(context.Context) error { return nil }
+func (c *probeRecorder) Close()                     {}
+func (c *probeRecorder) Addr() string               { return "regionserver:1" }
+func (c *probeRecorder) String() string             { return "regionserver:1" }
+
+func (c *probeRecorder) QueueRPC(call hrpc.Call) {
+	c.m.Lock()
+	c.probes = append(c.probes, time.Now())
+	c.m.Unlock()
+	call.ResultChan() <- hrpc.RPCResult{Msg: &pb.GetResponse{Result: &pb.Result{}}}
+}
+
+func (c *probeRecorder) QueueBatch(ctx context.Context, calls []hrpc.Call) {
+	for _, call := range calls {
+		c.QueueRPC(call)
+	}
+}
+
+func (c *probeRecorder) times() []time.Time {
+	c.m.Lock()
+	defer c.m.Unlock()
+	return append([]time.Time(nil), c.probes...)
+}
+
+func TestNotServingRegionStorm(t *testing.T) {
+	const (
+		regions   = 100
+		threshold = 10
+		backoff   = 50 * time.Millisecond
+	)
+	resolver := regionsResolver{}
+	var regs []hrpc.RegionInfo
+	for i := 0; i < regions; i++ {
+		start := []byte(fmt.Sprintf("%03d", i))
+		stop := []byte(fmt.Sprintf("%03d", i+1))
+		if i == regions-1 {
+			stop = nil
+		}
+		reg := region.NewInfo(0, nil, []byte("test"),
+			[]byte(fmt.Sprintf("test,%s,1", start)), start, stop)
+		resolver[string(start)] = reg
+		regs = append(regs, reg)
+	}
+	rc := &probeRecorder{}
+	c := NewClientWithResolver(resolver, func(string) hrpc.RegionClient { return rc },
+		NotServingRegionStorm(threshold, time.Second, backoff)).(*client)
+	defer c.Close()
+	for _, reg := range regs {
+		c.regions.put(reg)
+		c.clients.put(rc.Addr(), reg, func() hrpc.RegionClient { return rc })
+		reg.SetClient(rc)
+	}
+
+	// all the regions fail at once
+	start := time.Now()
+	for _, reg := range regs {
+		c.handleResultError(region.NotServingRegionError{}, reg, rc)
+	}
+	deadline := time.Now().Add(5 * time.Second)
+	for len(rc.times()) < regions {
+		if time.Now().After(deadline) {
+			t.Fatalf("expected %d regions to be reconnected, got %d", regions, len(rc.times()))
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	for _, reg := range regs {
+		if reg.IsUnavailable() {
+			t.Errorf("expected region %s to be available", reg)
+		}
+	}
+
+	probes := rc.times()
+	sort.Slice(probes, func(i, j int) bool { return probes[i].Before(probes[j]) })
+	// only the regions failing before the storm is detected reconnect right away
+	var early int
+	for _, p := range probes {
+		if p.Sub(start) < backoff {
+			early++
+		}
+	}
+	if early >= threshold {
+		t.Errorf("expected less than %d regions to reconnect before the backoff, got %d",
+			threshold, early)
+	}
+	// the others are spread out, at most threshold per backoff
+	// allowing for timers firing late and then bunching up
+	const maxPerBackoff = threshold * 3 / 2
+	for i := range probes {
+		j := i
+		for j < len(probes) && probes[j].Sub(probes[i]) < backoff {
+			j++
+		}
+		if j-i > maxPerBackoff {
+			t.Fatalf("expected at most %d reconnects per %v, got %d from %v",
+				maxPerBackoff, backoff, j-i, probes[i].Sub(start))
+		}
+	}
+	if d := probes[len(probes)-1].Sub(start); d < (regions-threshold)/threshold*backoff {
+		t.Errorf("expected reconnects to be spread out over %v, took %v",
+			(regions-threshold)/threshold*backoff, d)
+	}
+}
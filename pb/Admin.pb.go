@@ -0,0 +1,264 @@
+//*
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// This file contains protocol buffers that are used for Admin service.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.1
+// 	protoc        v3.21.5
+// source: Admin.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// *
+// Compacts the specified region.  Performs a major compaction if specified.
+// <p>
+// This method is asynchronous.
+type CompactRegionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Region *RegionSpecifier `protobuf:"bytes,1,req,name=region" json:"region,omitempty"`
+	Major  *bool            `protobuf:"varint,2,opt,name=major" json:"major,omitempty"`
+	Family []byte           `protobuf:"bytes,3,opt,name=family" json:"family,omitempty"`
+}
+
+func (x *CompactRegionRequest) Reset() {
+	*x = CompactRegionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_Admin_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CompactRegionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompactRegionRequest) ProtoMessage() {}
+
+func (x *CompactRegionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_Admin_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompactRegionRequest.ProtoReflect.Descriptor instead.
+func (*CompactRegionRequest) Descriptor() ([]byte, []int) {
+	return file_Admin_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CompactRegionRequest) GetRegion() *RegionSpecifier {
+	if x != nil {
+		return x.Region
+	}
+	return nil
+}
+
+func (x *CompactRegionRequest) GetMajor() bool {
+	if x != nil && x.Major != nil {
+		return *x.Major
+	}
+	return false
+}
+
+func (x *CompactRegionRequest) GetFamily() []byte {
+	if x != nil {
+		return x.Family
+	}
+	return nil
+}
+
+type CompactRegionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CompactRegionResponse) Reset() {
+	*x = CompactRegionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_Admin_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CompactRegionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompactRegionResponse) ProtoMessage() {}
+
+func (x *CompactRegionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_Admin_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompactRegionResponse.ProtoReflect.Descriptor instead.
+func (*CompactRegionResponse) Descriptor() ([]byte, []int) {
+	return file_Admin_proto_rawDescGZIP(), []int{1}
+}
+
+var File_Admin_proto protoreflect.FileDescriptor
+
+var file_Admin_proto_rawDesc = []byte{
+	0x0a, 0x0b, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x70,
+	0x62, 0x1a, 0x0b, 0x48, 0x42, 0x61, 0x73, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0c,
+	0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x71, 0x0a, 0x14,
+	0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x02, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e,
+	0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f,
+	0x6e, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x61, 0x6a, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x05, 0x6d, 0x61, 0x6a, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x61, 0x6d, 0x69, 0x6c,
+	0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x66, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x22,
+	0x17, 0x0a, 0x15, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xae, 0x01, 0x0a, 0x0c, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x44, 0x0a, 0x0d, 0x43, 0x6f, 0x6d,
+	0x70, 0x61, 0x63, 0x74, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x2e, 0x70, 0x62, 0x2e,
+	0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63,
+	0x74, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x58, 0x0a, 0x17, 0x45, 0x78, 0x65, 0x63, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x1d, 0x2e, 0x70, 0x62, 0x2e,
+	0x43, 0x6f, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x70, 0x62, 0x2e, 0x43,
+	0x6f, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x48, 0x0a, 0x2a, 0x6f, 0x72, 0x67,
+	0x2e, 0x61, 0x70, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x68, 0x61, 0x64, 0x6f, 0x6f, 0x70, 0x2e, 0x68,
+	0x62, 0x61, 0x73, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x67, 0x65,
+	0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x42, 0x0b, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x73, 0x48, 0x01, 0x5a, 0x05, 0x2e, 0x2e, 0x2f, 0x70, 0x62, 0x88, 0x01, 0x01,
+	0xa0, 0x01, 0x01,
+}
+
+var (
+	file_Admin_proto_rawDescOnce sync.Once
+	file_Admin_proto_rawDescData = file_Admin_proto_rawDesc
+)
+
+func file_Admin_proto_rawDescGZIP() []byte {
+	file_Admin_proto_rawDescOnce.Do(func() {
+		file_Admin_proto_rawDescData = protoimpl.X.CompressGZIP(file_Admin_proto_rawDescData)
+	})
+	return file_Admin_proto_rawDescData
+}
+
+var file_Admin_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_Admin_proto_goTypes = []interface{}{
+	(*CompactRegionRequest)(nil),       // 0: pb.CompactRegionRequest
+	(*CompactRegionResponse)(nil),      // 1: pb.CompactRegionResponse
+	(*RegionSpecifier)(nil),            // 2: pb.RegionSpecifier
+	(*CoprocessorServiceRequest)(nil),  // 3: pb.CoprocessorServiceRequest
+	(*CoprocessorServiceResponse)(nil), // 4: pb.CoprocessorServiceResponse
+}
+var file_Admin_proto_depIdxs = []int32{
+	2, // 0: pb.CompactRegionRequest.region:type_name -> pb.RegionSpecifier
+	0, // 1: pb.AdminService.CompactRegion:input_type -> pb.CompactRegionRequest
+	3, // 2: pb.AdminService.ExecRegionServerService:input_type -> pb.CoprocessorServiceRequest
+	1, // 3: pb.AdminService.CompactRegion:output_type -> pb.CompactRegionResponse
+	4, // 4: pb.AdminService.ExecRegionServerService:output_type -> pb.CoprocessorServiceResponse
+	3, // [3:5] is the sub-list for method output_type
+	1, // [1:3] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_Admin_proto_init() }
+func file_Admin_proto_init() {
+	if File_Admin_proto != nil {
+		return
+	}
+	file_HBase_proto_init()
+	file_Client_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_Admin_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CompactRegionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_Admin_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CompactRegionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_Admin_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_Admin_proto_goTypes,
+		DependencyIndexes: file_Admin_proto_depIdxs,
+		MessageInfos:      file_Admin_proto_msgTypes,
+	}.Build()
+	File_Admin_proto = out.File
+	file_Admin_proto_rawDesc = nil
+	file_Admin_proto_goTypes = nil
+	file_Admin_proto_depIdxs = nil
+}
@@ -152,7 +152,7 @@ func (x *GetProcedureResultResponse_State) UnmarshalJSON(b []byte) error {
 
 // Deprecated: Use GetProcedureResultResponse_State.Descriptor instead.
 func (GetProcedureResultResponse_State) EnumDescriptor() ([]byte, []int) {
-	return file_Master_proto_rawDescGZIP(), []int{95, 0}
+	return file_Master_proto_rawDescGZIP(), []int{97, 0}
 }
 
 type SecurityCapabilitiesResponse_Capability int32
@@ -217,7 +217,7 @@ func (x *SecurityCapabilitiesResponse_Capability) UnmarshalJSON(b []byte) error
 
 // Deprecated: Use SecurityCapabilitiesResponse_Capability.Descriptor instead.
 func (SecurityCapabilitiesResponse_Capability) EnumDescriptor() ([]byte, []int) {
-	return file_Master_proto_rawDescGZIP(), []int{106, 0}
+	return file_Master_proto_rawDescGZIP(), []int{108, 0}
 }
 
 type AddColumnRequest struct {
@@ -766,6 +766,133 @@ func (*DispatchMergingRegionsResponse) Descriptor() ([]byte, []int) {
 	return file_Master_proto_rawDescGZIP(), []int{9}
 }
 
+// *
+// Merging the specified regions in a table.
+type MergeTableRegionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Region     []*RegionSpecifier `protobuf:"bytes,1,rep,name=region" json:"region,omitempty"`
+	Forcible   *bool              `protobuf:"varint,3,opt,name=forcible,def=0" json:"forcible,omitempty"`
+	NonceGroup *uint64            `protobuf:"varint,4,opt,name=nonce_group,json=nonceGroup,def=0" json:"nonce_group,omitempty"`
+	Nonce      *uint64            `protobuf:"varint,5,opt,name=nonce,def=0" json:"nonce,omitempty"`
+}
+
+// Default values for MergeTableRegionsRequest fields.
+const (
+	Default_MergeTableRegionsRequest_Forcible   = bool(false)
+	Default_MergeTableRegionsRequest_NonceGroup = uint64(0)
+	Default_MergeTableRegionsRequest_Nonce      = uint64(0)
+)
+
+func (x *MergeTableRegionsRequest) Reset() {
+	*x = MergeTableRegionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_Master_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MergeTableRegionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeTableRegionsRequest) ProtoMessage() {}
+
+func (x *MergeTableRegionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_Master_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeTableRegionsRequest.ProtoReflect.Descriptor instead.
+func (*MergeTableRegionsRequest) Descriptor() ([]byte, []int) {
+	return file_Master_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *MergeTableRegionsRequest) GetRegion() []*RegionSpecifier {
+	if x != nil {
+		return x.Region
+	}
+	return nil
+}
+
+func (x *MergeTableRegionsRequest) GetForcible() bool {
+	if x != nil && x.Forcible != nil {
+		return *x.Forcible
+	}
+	return Default_MergeTableRegionsRequest_Forcible
+}
+
+func (x *MergeTableRegionsRequest) GetNonceGroup() uint64 {
+	if x != nil && x.NonceGroup != nil {
+		return *x.NonceGroup
+	}
+	return Default_MergeTableRegionsRequest_NonceGroup
+}
+
+func (x *MergeTableRegionsRequest) GetNonce() uint64 {
+	if x != nil && x.Nonce != nil {
+		return *x.Nonce
+	}
+	return Default_MergeTableRegionsRequest_Nonce
+}
+
+type MergeTableRegionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProcId *uint64 `protobuf:"varint,1,opt,name=proc_id,json=procId" json:"proc_id,omitempty"`
+}
+
+func (x *MergeTableRegionsResponse) Reset() {
+	*x = MergeTableRegionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_Master_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MergeTableRegionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeTableRegionsResponse) ProtoMessage() {}
+
+func (x *MergeTableRegionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_Master_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeTableRegionsResponse.ProtoReflect.Descriptor instead.
+func (*MergeTableRegionsResponse) Descriptor() ([]byte, []int) {
+	return file_Master_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *MergeTableRegionsResponse) GetProcId() uint64 {
+	if x != nil && x.ProcId != nil {
+		return *x.ProcId
+	}
+	return 0
+}
+
 type AssignRegionRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -777,7 +904,7 @@ type AssignRegionRequest struct {
 func (x *AssignRegionRequest) Reset() {
 	*x = AssignRegionRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_Master_proto_msgTypes[10]
+		mi := &file_Master_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -790,7 +917,7 @@ func (x *AssignRegionRequest) String() string {
 func (*AssignRegionRequest) ProtoMessage() {}
 
 func (x *AssignRegionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_Master_proto_msgTypes[10]
+	mi := &file_Master_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -803,7 +930,7 @@ func (x *AssignRegionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AssignRegionRequest.ProtoReflect.Descriptor instead.
 func (*AssignRegionRequest) Descriptor() ([]byte, []int) {
-	return file_Master_proto_rawDescGZIP(), []int{10}
+	return file_Master_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *AssignRegionRequest) GetRegion() *RegionSpecifier {
@@ -822,7 +949,7 @@ type AssignRegionResponse struct {
 func (x *AssignRegionResponse) Reset() {
 	*x = AssignRegionResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_Master_proto_msgTypes[11]
+		mi := &file_Master_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -835,7 +962,7 @@ func (x *AssignRegionResponse) String() string {
 func (*AssignRegionResponse) ProtoMessage() {}
 
 func (x *AssignRegionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_Master_proto_msgTypes[11]
+	mi := &file_Master_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -848,7 +975,7 @@ func (x *AssignRegionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AssignRegionResponse.ProtoReflect.Descriptor instead.
 func (*AssignRegionResponse) Descriptor() ([]byte, []int) {
-	return file_Master_proto_rawDescGZIP(), []int{11}
+	return file_Master_proto_rawDescGZIP(), []int{13}
 }
 
 type UnassignRegionRequest struct {
@@ -868,7 +995,7 @@ const (
 func (x *UnassignRegionRequest) Reset() {
 	*x = UnassignRegionRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_Master_proto_msgTypes[12]
+		mi := &file_Master_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -881,7 +1008,7 @@ func (x *UnassignRegionRequest) String() string {
 func (*UnassignRegionRequest) ProtoMessage() {}
 
 func (x *UnassignRegionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_Master_proto_msgTypes[12]
+	mi := &file_Master_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -894,7 +1021,7 @@ func (x *UnassignRegionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UnassignRegionRequest.ProtoReflect.Descriptor instead.
 func (*UnassignRegionRequest) Descriptor() ([]byte, []int) {
-	return file_Master_proto_rawDescGZIP(), []int{12}
+	return file_Master_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *UnassignRegionRequest) GetRegion() *RegionSpecifier {
@@ -920,7 +1047,7 @@ type UnassignRegionResponse struct {
 func (x *UnassignRegionResponse) Reset() {
 	*x = UnassignRegionResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_Master_proto_msgTypes[13]
+		mi := &file_Master_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -933,7 +1060,7 @@ func (x *UnassignRegionResponse) String() string {
 func (*UnassignRegionResponse) ProtoMessage() {}
 
 func (x *UnassignRegionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_Master_proto_msgTypes[13]
+	mi := &file_Master_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -946,7 +1073,7 @@ func (x *UnassignRegionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UnassignRegionResponse.ProtoReflect.Descriptor instead.
 func (*UnassignRegionResponse) Descriptor() ([]byte, []int) {
-	return file_Master_proto_rawDescGZIP(), []int{13}
+	return file_Master_proto_rawDescGZIP(), []int{15}
 }
 
 type OfflineRegionRequest struct {
@@ -960,7 +1087,7 @@ type OfflineRegionRequest struct {
 func (x *OfflineRegionRequest) Reset() {
 	*x = OfflineRegionRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_Master_proto_msgTypes[14]
+		mi := &file_Master_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -973,7 +1100,7 @@ func (x *OfflineRegionRequest) String() string {
 func (*OfflineRegionRequest) ProtoMessage() {}
 
 func (x *OfflineRegionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_Master_proto_msgTypes[14]
+	mi := &file_Master_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -986,7 +1113,7 @@ func (x *OfflineRegionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OfflineRegionRequest.ProtoReflect.Descriptor instead.
 func (*OfflineRegionRequest) Descriptor() ([]byte, []int) {
-	return file_Master_proto_rawDescGZIP(), []int{14}
+	return file_Master_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *OfflineRegionRequest) GetRegion() *RegionSpecifier {
@@ -1005,7 +1132,7 @@ type OfflineRegionResponse struct {
 func (x *OfflineRegionResponse) Reset() {
 	*x = OfflineRegionResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_Master_proto_msgTypes[15]
+		mi := &file_Master_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1018,7 +1145,7 @@ func (x *OfflineRegionResponse) String() string {
 func (*OfflineRegionResponse) ProtoMessage() {}
 
 func (x *OfflineRegionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_Master_proto_msgTypes[15]
+	mi := &file_Master_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1031,7 +1158,7 @@ func (x *OfflineRegionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OfflineRegionResponse.ProtoReflect.Descriptor instead.
 func (*OfflineRegionResponse) Descriptor() ([]byte, []int) {
-	return file_Master_proto_rawDescGZIP(), []int{15}
+	return file_Master_proto_rawDescGZIP(), []int{17}
 }
 
 type CreateTableRequest struct {
@@ -1054,7 +1181,7 @@ const (
 func (x *CreateTableRequest) Reset() {
 	*x = CreateTableRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_Master_proto_msgTypes[16]
+		mi := &file_Master_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1067,7 +1194,7 @@ func (x *CreateTableRequest) String() string {
 func (*CreateTableRequest) ProtoMessage() {}
 
 func (x *CreateTableRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_Master_proto_msgTypes[16]
+	mi := &file_Master_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1080,7 +1207,7 @@ func (x *CreateTableRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateTableRequest.ProtoReflect.Descriptor instead.
 func (*CreateTableRequest) Descriptor() ([]byte, []int) {
-	return file_Master_proto_rawDescGZIP(), []int{16}
+	return file_Master_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *CreateTableRequest) GetTableSchema() *TableSchema {
@@ -1122,7 +1249,7 @@ type CreateTableResponse struct {
 func (x *CreateTableResponse) Reset() {
 	*x = CreateTableResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_Master_proto_msgTypes[17]
+		mi := &file_Master_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1135,7 +1262,7 @@ func (x *CreateTableResponse) String() string {
 func (*CreateTableResponse) ProtoMessage() {}
 
 func (x *CreateTableResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_Master_proto_msgTypes[17]
+	mi := &file_Master_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1148,7 +1275,7 @@ func (x *CreateTableResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateTableResponse.ProtoReflect.Descriptor instead.
 func (*CreateTableResponse) Descriptor() ([]byte, []int) {
-	return file_Master_proto_rawDescGZIP(), []int{17}
+	return file_Master_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *CreateTableResponse) GetProcId() uint64 {
@@ -1177,7 +1304,7 @@ const (
 func (x *DeleteTableRequest) Reset() {
 	*x = DeleteTableRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_Master_proto_msgTypes[18]
+		mi := &file_Master_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1190,7 +1317,7 @@ func (x *DeleteTableRequest) String() string {
 func (*DeleteTableRequest) ProtoMessage() {}
 
 func (x *DeleteTableRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_Master_proto_msgTypes[18]
+	mi := &file_Master_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1203,7 +1330,7 @@ func (x *DeleteTableRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteTableRequest.ProtoReflect.Descriptor instead.
 func (*DeleteTableRequest) Descriptor() ([]byte, []int) {
-	return file_Master_proto_rawDescGZIP(), []int{18}
+	return file_Master_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *DeleteTableRequest) GetTableName() *TableName {
@@ -1238,7 +1365,7 @@ type DeleteTableResponse struct {
 func (x *DeleteTableResponse) Reset() {
 	*x = DeleteTableResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_Master_proto_msgTypes[19]
+		mi := &file_Master_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1251,7 +1378,7 @@ func (x *DeleteTableResponse) String() string {
 func (*DeleteTableResponse) ProtoMessage() {}
 
 func (x *DeleteTableResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_Master_proto_msgTypes[19]
+	mi := &file_Master_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1264,7 +1391,7 @@ func (x *DeleteTableResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteTableResponse.ProtoReflect.Descriptor instead.
 func (*DeleteTableResponse) Descriptor() ([]byte, []int) {
-	return file_Master_proto_rawDescGZIP(), []int{19}
+	return file_Master_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *DeleteTableResponse) GetProcId() uint64 {
@@ -1295,7 +1422,7 @@ const (
 func (x *TruncateTableRequest) Reset() {
 	*x = TruncateTableRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_Master_proto_msgTypes[20]
+		mi := &file_Master_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1308,7 +1435,7 @@ func (x *TruncateTableRequest) String() string {
 func (*TruncateTableRequest) ProtoMessage() {}
 
 func (x *TruncateTableRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_Master_proto_msgTypes[20]
+	mi := &file_Master_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1321,7 +1448,7 @@ func (x *TruncateTableRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TruncateTableRequest.ProtoReflect.Descriptor instead.
 func (*TruncateTableRequest) Descriptor() ([]byte, []int) {
-	return file_Master_proto_rawDescGZIP(), []int{20}
+	return file_Master_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *TruncateTableRequest) GetTableName() *TableName {
@@ -1361,7 +1488,7 @@ type TruncateTableResponse struct {
 func (x *TruncateTableResponse) Reset() {
 	*x = TruncateTableResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_Master_proto_msgTypes[21]
+		mi := &file_Master_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1374,7 +1501,7 @@ func (x *TruncateTableResponse) String() string {
 func (*TruncateTableResponse) ProtoMessage() {}
 
 func (x *TruncateTableResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_Master_proto_msgTypes[21]
+	mi := &file_Master_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1387,7 +1514,7 @@ func (x *TruncateTableResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TruncateTableResponse.ProtoReflect.Descriptor instead.
 func (*TruncateTableResponse) Descriptor() ([]byte, []int) {
-	return file_Master_proto_rawDescGZIP(), []int{21}
+	return file_Master_proto_rawDescGZIP(), []int{23}
 }
 
 type EnableTableRequest struct {
@@ -1409,7 +1536,7 @@ const (
 func (x *EnableTableRequest) Reset() {
 	*x = EnableTableRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_Master_proto_msgTypes[22]
+		mi := &file_Master_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1422,7 +1549,7 @@ func (x *EnableTableRequest) String() string {
 func (*EnableTableRequest) ProtoMessage() {}
 
 func (x *EnableTableRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_Master_proto_msgTypes[22]
+	mi := &file_Master_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1435,7 +1562,7 @@ func (x *EnableTableRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EnableTableRequest.ProtoReflect.Descriptor instead.
 func (*EnableTableRequest) Descriptor() ([]byte, []int) {
-	return file_Master_proto_rawDescGZIP(), []int{22}
+	return file_Master_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *EnableTableRequest) GetTableName() *TableName {
@@ -1470,7 +1597,7 @@ type EnableTableResponse struct {
 func (x *EnableTableResponse) Reset() {
 	*x = EnableTableResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_Master_proto_msgTypes[23]
+		mi := &file_Master_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1483,7 +1610,7 @@ func (x *EnableTableResponse) String() string {
 func (*EnableTableResponse) ProtoMessage() {}
 
 func (x *EnableTableResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_Master_proto_msgTypes[23]
+	mi := &file_Master_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1496,7 +1623,7 @@ func (x *EnableTableResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EnableTableResponse.ProtoReflect.Descriptor instead.
 func (*EnableTableResponse) Descriptor() ([]byte, []int) {
-	return file_Master_proto_rawDescGZIP(), []int{23}
+	return file_Master_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *EnableTableResponse) GetProcId() uint64 {
@@ -1525,7 +1652,7 @@ const (
 func (x *DisableTableRequest) Reset() {
 	*x = DisableTableRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_Master_proto_msgTypes[24]
+		mi := &file_Master_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1538,7 +1665,7 @@ func (x *DisableTableRequest) String() string {
 func (*DisableTableRequest) ProtoMessage() {}
 
 func (x *DisableTableRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_Master_proto_msgTypes[24]
+	mi := &file_Master_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1551,7 +1678,7 @@ func (x *DisableTableRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DisableTableRequest.ProtoReflect.Descriptor instead.
 func (*DisableTableRequest) Descriptor() ([]byte, []int) {
-	return file_Master_proto_rawDescGZIP(), []int{24}
+	return file_Master_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *DisableTableRequest) GetTableName() *TableName {
@@ -1586,7 +1713,7 @@ type DisableTableResponse struct {
 func (x *DisableTableResponse) Reset() {
 	*x = DisableTableResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_Master_proto_msgTypes[25]
+		mi := &file_Master_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1599,7 +1726,7 @@ func (x *DisableTableResponse) String() string {
 func (*DisableTableResponse) ProtoMessage() {}
 
 func (x *DisableTableResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_Master_proto_msgTypes[25]
+	mi := &file_Master_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1612,7 +1739,7 @@ func (x *DisableTableResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DisableTableResponse.ProtoReflect.Descriptor instead.
 func (*DisableTableResponse) Descriptor() ([]byte, []int) {
-	return file_Master_proto_rawDescGZIP(), []int{25}
+	return file_Master_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *DisableTableResponse) GetProcId() uint64 {
@@ -1642,7 +1769,7 @@ const (
 func (x *ModifyTableRequest) Reset() {
 	*x = ModifyTableRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_Master_proto_msgTypes[26]
+		mi := &file_Master_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1655,7 +1782,7 @@ func (x *ModifyTableRequest) String() string {
 func (*ModifyTableRequest) ProtoMessage() {}
 
 func (x *ModifyTableRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_Master_proto_msgTypes[26]
+	mi := &file_Master_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1668,7 +1795,7 @@ func (x *ModifyTableRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ModifyTableRequest.ProtoReflect.Descriptor instead.
 func (*ModifyTableRequest) Descriptor() ([]byte, []int) {
-	return file_Master_proto_rawDescGZIP(), []int{26}
+	return file_Master_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *ModifyTableRequest) GetTableName() *TableName {
@@ -1708,7 +1835,7 @@ type ModifyTableResponse struct {
 func (x *ModifyTableResponse) Reset() {
 	*x = ModifyTableResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_Master_proto_msgTypes[27]
+		mi := &file_Master_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1721,7 +1848,7 @@ func (x *ModifyTableResponse) String() string {
 func (*ModifyTableResponse) ProtoMessage() {}
 
 func (x *ModifyTableResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_Master_proto_msgTypes[27]
+	mi := &file_Master_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1734,7 +1861,7 @@ func (x *ModifyTableResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ModifyTableResponse.ProtoReflect.Descriptor instead.
 func (*ModifyTableResponse) Descriptor() ([]byte, []int) {
-	return file_Master_proto_rawDescGZIP(), []int{27}
+	return file_Master_proto_rawDescGZIP(), []int{29}
 }
 
 type CreateNamespaceRequest struct {
@@ -1756,7 +1883,7 @@ const (
 func (x *CreateNamespaceRequest) Reset() {
 	*x = CreateNamespaceRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_Master_proto_msgTypes[28]
+		mi := &file_Master_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1769,7 +1896,7 @@ func (x *CreateNamespaceRequest) String() string {
 func (*CreateNamespaceRequest) ProtoMessage() {}
 
 func (x *CreateNamespaceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_Master_proto_msgTypes[28]
+	mi := &file_Master_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1782,7 +1909,7 @@ func (x *CreateNamespaceRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateNamespaceRequest.ProtoReflect.Descriptor instead.
 func (*CreateNamespaceRequest) Descriptor() ([]byte, []int) {
-	return file_Master_proto_rawDescGZIP(), []int{28}
+	return file_Master_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *CreateNamespaceRequest) GetNamespaceDescriptor() *NamespaceDescriptor {
@@ -1815,7 +1942,7 @@ type CreateNamespaceResponse struct {
 func (x *CreateNamespaceResponse) Reset() {
 	*x = CreateNamespaceResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_Master_proto_msgTypes[29]
+		mi := &file_Master_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1828,7 +1955,7 @@ func (x *CreateNamespaceResponse) String() string {
 func (*CreateNamespaceResponse) ProtoMessage() {}
 
 func (x *CreateNamespaceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_Master_proto_msgTypes[29]
+	mi := &file_Master_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1841,7 +1968,7 @@ func (x *CreateNamespaceResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateNamespaceResponse.ProtoReflect.Descriptor instead.
 func (*CreateNamespaceResponse) Descriptor() ([]byte, []int) {
-	return file_Master_proto_rawDescGZIP(), []int{29}
+	return file_Master_proto_rawDescGZIP(), []int{31}
 }
 
 type DeleteNamespaceRequest struct {
@@ -1863,7 +1990,7 @@ const (
 func (x *DeleteNamespaceRequest) Reset() {
 	*x = DeleteNamespaceRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_Master_proto_msgTypes[30]
+		mi := &file_Master_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1876,7 +2003,7 @@ func (x *DeleteNamespaceRequest) String() string {
 func (*DeleteNamespaceRequest) ProtoMessage() {}
 
 func (x *DeleteNamespaceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_Master_proto_msgTypes[30]
+	mi := &file_Master_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1889,7 +2016,7 @@ func (x *DeleteNamespaceRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteNamespaceRequest.ProtoReflect.Descriptor instead.
 func (*DeleteNamespaceRequest) Descriptor() ([]byte, []int) {
-	return file_Master_proto_rawDescGZIP(), []int{30}
+	return file_Master_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *DeleteNamespaceRequest) GetNamespaceName() string {
@@ -1922,7 +2049,7 @@ type DeleteNamespaceResponse struct {
 func (x *DeleteNamespaceResponse) Reset() {
 	*x = DeleteNamespaceResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_Master_proto_msgTypes[31]
+		mi := &file_Master_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1935,7 +2062,7 @@ func (x *DeleteNamespaceResponse) String() string {
 func (*DeleteNamespaceResponse) ProtoMessage() {}
 
 func (x *DeleteNamespaceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_Master_proto_msgTypes[31]
+	mi := &file_Master_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1948,7 +2075,7 @@ func (x *DeleteNamespaceResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteNamespaceResponse.ProtoReflect.Descriptor instead.
 func (*DeleteNamespaceResponse) Descriptor() ([]byte, []int) {
-	return file_Master_proto_rawDescGZIP(), []int{31}
+	return file_Master_proto_rawDescGZIP(), []int{33}
 }
 
 type ModifyNamespaceRequest struct {
@@ -1970,7 +2097,7 @@ const (
 func (x *ModifyNamespaceRequest) Reset() {
 	*x = ModifyNamespaceRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_Master_proto_msgTypes[32]
+		mi := &file_Master_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1983,7 +2110,7 @@ func (x *ModifyNamespaceRequest) String() string {
 func (*ModifyNamespaceRequest) ProtoMessage() {}
 
 func (x *ModifyNamespaceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_Master_proto_msgTypes[32]
+	mi := &file_Master_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1996,7 +2123,7 @@ func (x *ModifyNamespaceRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ModifyNamespaceRequest.ProtoReflect.Descriptor instead.
 func (*ModifyNamespaceRequest) Descriptor() ([]byte, []int) {
-	return file_Master_proto_rawDescGZIP(), []int{32}
+	return file_Master_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *ModifyNamespaceRequest) GetNamespaceDescriptor() *NamespaceDescriptor {
@@ -2029,7 +2156,7 @@ type ModifyNamespaceResponse struct {
 func (x *ModifyNamespaceResponse) Reset() {
 	*x = ModifyNamespaceResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_Master_proto_msgTypes[33]
+		mi := &file_Master_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2042,7 +2169,7 @@ func (x *ModifyNamespaceResponse) String() string {
 func (*ModifyNamespaceResponse) ProtoMessage() {}
 
 func (x *ModifyNamespaceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_Master_proto_msgTypes[33]
+	mi := &file_Master_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2055,7 +2182,7 @@ func (x *ModifyNamespaceResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ModifyNamespaceResponse.ProtoReflect.Descriptor instead.
 func (*ModifyNamespaceResponse) Descriptor() ([]byte, []int) {
-	return file_Master_proto_rawDescGZIP(), []int{33}
+	return file_Master_proto_rawDescGZIP(), []int{35}
 }
 
 type GetNamespaceDescriptorRequest struct {
@@ -2069,7 +2196,7 @@ type GetNamespaceDescriptorRequest struct {
 func (x *GetNamespaceDescriptorRequest) Reset() {
 	*x = GetNamespaceDescriptorRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_Master_proto_msgTypes[34]
+		mi := &file_Master_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2082,7 +2209,7 @@ func (x *GetNamespaceDescriptorRequest) String() string {
 func (*GetNamespaceDescriptorRequest) ProtoMessage() {}
 
 func (x *GetNamespaceDescriptorRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_Master_proto_msgTypes[34]
+	mi := &file_Master_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2095,7 +2222,7 @@ func (x *GetNamespaceDescriptorRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetNamespaceDescriptorRequest.ProtoReflect.Descriptor instead.
 func (*GetNamespaceDescriptorRequest) Descriptor() ([]byte, []int) {
-	return file_Master_proto_rawDescGZIP(), []int{34}
+	return file_Master_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *GetNamespaceDescriptorRequest) GetNamespaceName() string {
@@ -2116,7 +2243,7 @@ type GetNamespaceDescriptorResponse struct {
 func (x *GetNamespaceDescriptorResponse) Reset() {
 	*x = GetNamespaceDescriptorResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_Master_proto_msgTypes[35]
+		mi := &file_Master_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2129,7 +2256,7 @@ func (x *GetNamespaceDescriptorResponse) String() string {
 func (*GetNamespaceDescriptorResponse) ProtoMessage() {}
 
 func (x *GetNamespaceDescriptorResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_Master_proto_msgTypes[35]
+	mi := &file_Master_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2142,7 +2269,7 @@ func (x *GetNamespaceDescriptorResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetNamespaceDescriptorResponse.ProtoReflect.Descriptor instead.
 func (*GetNamespaceDescriptorResponse) Descriptor() ([]byte, []int) {
-	return file_Master_proto_rawDescGZIP(), []int{35}
+	return file_Master_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *GetNamespaceDescriptorResponse) GetNamespaceDescriptor() *NamespaceDescriptor {
@@ -2161,7 +2288,7 @@ type ListNamespaceDescriptorsRequest struct {
 func (x *ListNamespaceDescriptorsRequest) Reset() {
 	*x = ListNamespaceDescriptorsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_Master_proto_msgTypes[36]
+		mi := &file_Master_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2174,7 +2301,7 @@ func (x *ListNamespaceDescriptorsRequest) String() string {
 func (*ListNamespaceDescriptorsRequest) ProtoMessage() {}
 
 func (x *ListNamespaceDescriptorsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_Master_proto_msgTypes[36]
+	mi := &file_Master_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2187,7 +2314,7 @@ func (x *ListNamespaceDescriptorsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListNamespaceDescriptorsRequest.ProtoReflect.Descriptor instead.
 func (*ListNamespaceDescriptorsRequest) Descriptor() ([]byte, []int) {
-	return file_Master_proto_rawDescGZIP(), []int{36}
+	return file_Master_proto_rawDescGZIP(), []int{38}
 }
 
 type ListNamespaceDescriptorsResponse struct {
@@ -2201,7 +2328,7 @@ type ListNamespaceDescriptorsResponse struct {
 func (x *ListNamespaceDescriptorsResponse) Reset() {
 	*x = ListNamespaceDescriptorsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_Master_proto_msgTypes[37]
+		mi := &file_Master_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2214,7 +2341,7 @@ func (x *ListNamespaceDescriptorsResponse) String() string {
 func (*ListNamespaceDescriptorsResponse) ProtoMessage() {}
 
 func (x *ListNamespaceDescriptorsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_Master_proto_msgTypes[37]
+	mi := &file_Master_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2227,7 +2354,7 @@ func (x *ListNamespaceDescriptorsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListNamespaceDescriptorsResponse.ProtoReflect.Descriptor instead.
 func (*ListNamespaceDescriptorsResponse) Descriptor() ([]byte, []int) {
-	return file_Master_proto_rawDescGZIP(), []int{37}
+	return file_Master_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *ListNamespaceDescriptorsResponse) GetNamespaceDescriptor() []*NamespaceDescriptor {
@@ -2248,7 +2375,7 @@ type ListTableDescriptorsByNamespaceRequest struct {
 func (x *ListTableDescriptorsByNamespaceRequest) Reset() {
 	*x = ListTableDescriptorsByNamespaceRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_Master_proto_msgTypes[38]
+		mi := &file_Master_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2261,7 +2388,7 @@ func (x *ListTableDescriptorsByNamespaceRequest) String() string {
 func (*ListTableDescriptorsByNamespaceRequest) ProtoMessage() {}
 
 func (x *ListTableDescriptorsByNamespaceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_Master_proto_msgTypes[38]
+	mi := &file_Master_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2274,7 +2401,7 @@ func (x *ListTableDescriptorsByNamespaceRequest) ProtoReflect() protoreflect.Mes
 
 // Deprecated: Use ListTableDescriptorsByNamespaceRequest.ProtoReflect.Descriptor instead.
 func (*ListTableDescriptorsByNamespaceRequest) Descriptor() ([]byte, []int) {
-	return file_Master_proto_rawDescGZIP(), []int{38}
+	return file_Master_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *ListTableDescriptorsByNamespaceRequest) GetNamespaceName() string {
@@ -2295,7 +2422,7 @@ type ListTableDescriptorsByNamespaceResponse struct {
 func (x *ListTableDescriptorsByNamespaceResponse) Reset() {
 	*x = ListTableDescriptorsByNamespaceResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_Master_proto_msgTypes[39]
+		mi := &file_Master_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2308,7 +2435,7 @@ func (x *ListTableDescriptorsByNamespaceResponse) String() string {
 func (*ListTableDescriptorsByNamespaceResponse) ProtoMessage() {}
 
 func (x *ListTableDescriptorsByNamespaceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_Master_proto_msgTypes[39]
+	mi := &file_Master_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2321,7 +2448,7 @@ func (x *ListTableDescriptorsByNamespaceResponse) ProtoReflect() protoreflect.Me
 
 // Deprecated: Use ListTableDescriptorsByNamespaceResponse.ProtoReflect.Descriptor instead.
 func (*ListTableDescriptorsByNamespaceResponse) Descriptor() ([]byte, []int) {
-	return file_Master_proto_rawDescGZIP(), []int{39}
+	return file_Master_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *ListTableDescriptorsByNamespaceResponse) GetTableSchema() []*TableSchema {
@@ -2342,7 +2469,7 @@ type ListTableNamesByNamespaceRequest struct {
 func (x *ListTableNamesByNamespaceRequest) Reset() {
 	*x = ListTableNamesByNamespaceRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_Master_proto_msgTypes[40]
+		mi := &file_Master_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2355,7 +2482,7 @@ func (x *ListTableNamesByNamespaceRequest) String() string {
 func (*ListTableNamesByNamespaceRequest) ProtoMessage() {}
 
 func (x *ListTableNamesByNamespaceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_Master_proto_msgTypes[40]
+	mi := &file_Master_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2368,7 +2495,7 @@ func (x *ListTableNamesByNamespaceRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListTableNamesByNamespaceRequest.ProtoReflect.Descriptor instead.
 func (*ListTableNamesByNamespaceRequest) Descriptor() ([]byte, []int) {
-	return file_Master_proto_rawDescGZIP(), []int{40}
+	return file_Master_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *ListTableNamesByNamespaceRequest) GetNamespaceName() string {
@@ -2389,7 +2516,7 @@ type ListTableNamesByNamespaceResponse struct {
 func (x *ListTableNamesByNamespaceResponse) Reset() {
 	*x = ListTableNamesByNamespaceResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_Master_proto_msgTypes[41]
+		mi := &file_Master_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2402,7 +2529,7 @@ func (x *ListTableNamesByNamespaceResponse) String() string {
 func (*ListTableNamesByNamespaceResponse) ProtoMessage() {}
 
 func (x *ListTableNamesByNamespaceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_Master_proto_msgTypes[41]
+	mi := &file_Master_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2415,7 +2542,7 @@ func (x *ListTableNamesByNamespaceResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use ListTableNamesByNamespaceResponse.ProtoReflect.Descriptor instead.
 func (*ListTableNamesByNamespaceResponse) Descriptor() ([]byte, []int) {
-	return file_Master_proto_rawDescGZIP(), []int{41}
+	return file_Master_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *ListTableNamesByNamespaceResponse) GetTableName() []*TableName {
@@ -2434,7 +2561,7 @@ type ShutdownRequest struct {
 func (x *ShutdownRequest) Reset() {
 	*x = ShutdownRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_Master_proto_msgTypes[42]
+		mi := &file_Master_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2447,7 +2574,7 @@ func (x *ShutdownRequest) String() string {
 func (*ShutdownRequest) ProtoMessage() {}
 
 func (x *ShutdownRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_Master_proto_msgTypes[42]
+	mi := &file_Master_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2460,7 +2587,7 @@ func (x *ShutdownRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ShutdownRequest.ProtoReflect.Descriptor instead.
 func (*ShutdownRequest) Descriptor() ([]byte, []int) {
-	return file_Master_proto_rawDescGZIP(), []int{42}
+	return file_Master_proto_rawDescGZIP(), []int{44}
 }
 
 type ShutdownResponse struct {
@@ -2472,7 +2599,7 @@ type ShutdownResponse struct {
 func (x *ShutdownResponse) Reset() {
 	*x = ShutdownResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_Master_proto_msgTypes[43]
+		mi := &file_Master_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2485,7 +2612,7 @@ func (x *ShutdownResponse) String() string {
 func (*ShutdownResponse) ProtoMessage() {}
 
 func (x *ShutdownResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_Master_proto_msgTypes[43]
+	mi := &file_Master_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2498,7 +2625,7 @@ func (x *ShutdownResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ShutdownResponse.ProtoReflect.Descriptor instead.
 func (*ShutdownResponse) Descriptor() ([]byte, []int) {
-	return file_Master_proto_rawDescGZIP(), []int{43}
+	return file_Master_proto_rawDescGZIP(), []int{45}
 }
 
 type StopMasterRequest struct {
@@ -2510,7 +2637,7 @@ type StopMasterRequest struct {
 func (x *StopMasterRequest) Reset() {
 	*x = StopMasterRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_Master_proto_msgTypes[44]
+		mi := &file_Master_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2523,7 +2650,7 @@ func (x *StopMasterRequest) String() string {
 func (*StopMasterRequest) ProtoMessage() {}
 
 func (x *StopMasterRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_Master_proto_msgTypes[44]
+	mi := &file_Master_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2536,7 +2663,7 @@ func (x *StopMasterRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StopMasterRequest.ProtoReflect.Descriptor instead.
 func (*StopMasterRequest) Descriptor() ([]byte, []int) {
-	return file_Master_proto_rawDescGZIP(), []int{44}
+	return file_Master_proto_rawDescGZIP(), []int{46}
 }
 
 type StopMasterResponse struct {
@@ -2548,7 +2675,7 @@ type StopMasterResponse struct {
 func (x *StopMasterResponse) Reset() {
 	*x = StopMasterResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_Master_proto_msgTypes[45]
+		mi := &file_Master_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2561,7 +2688,7 @@ func (x *StopMasterResponse) String() string {
 func (*StopMasterResponse) ProtoMessage() {}
 
 func (x *StopMasterResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_Master_proto_msgTypes[45]
+	mi := &file_Master_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2574,7 +2701,7 @@ func (x *StopMasterResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StopMasterResponse.ProtoReflect.Descriptor instead.
 func (*StopMasterResponse) Descriptor() ([]byte, []int) {
-	return file_Master_proto_rawDescGZIP(), []int{45}
+	return file_Master_proto_rawDescGZIP(), []int{47}
 }
 
 type BalanceRequest struct {
@@ -2588,7 +2715,7 @@ type BalanceRequest struct {
 func (x *BalanceRequest) Reset() {
 	*x = BalanceRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_Master_proto_msgTypes[46]
+		mi := &file_Master_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2601,7 +2728,7 @@ func (x *BalanceRequest) String() string {
 func (*BalanceRequest) ProtoMessage() {}
 
 func (x *BalanceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_Master_proto_msgTypes[46]
+	mi := &file_Master_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2614,7 +2741,7 @@ func (x *BalanceRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BalanceRequest.ProtoReflect.Descriptor instead.
 func (*BalanceRequest) Descriptor() ([]byte, []int) {
-	return file_Master_proto_rawDescGZIP(), []int{46}
+	return file_Master_proto_rawDescGZIP(), []int{48}
 }
 
 func (x *BalanceRequest) GetForce() bool {
@@ -2635,7 +2762,7 @@ type BalanceResponse struct {
 func (x *BalanceResponse) Reset() {
 	*x = BalanceResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_Master_proto_msgTypes[47]
+		mi := &file_Master_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2648,7 +2775,7 @@ func (x *BalanceResponse) String() string {
 func (*BalanceResponse) ProtoMessage() {}
 
 func (x *BalanceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_Master_proto_msgTypes[47]
+	mi := &file_Master_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2661,7 +2788,7 @@ func (x *BalanceResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BalanceResponse.ProtoReflect.Descriptor instead.
 func (*BalanceResponse) Descriptor() ([]byte, []int) {
-	return file_Master_proto_rawDescGZIP(), []int{47}
+	return file_Master_proto_rawDescGZIP(), []int{49}
 }
 
 func (x *BalanceResponse) GetBalancerRan() bool {
@@ -2683,7 +2810,7 @@ type SetBalancerRunningRequest struct {
 func (x *SetBalancerRunningRequest) Reset() {
 	*x = SetBalancerRunningRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_Master_proto_msgTypes[48]
+		mi := &file_Master_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2696,7 +2823,7 @@ func (x *SetBalancerRunningRequest) String() string {
 func (*SetBalancerRunningRequest) ProtoMessage() {}
 
 func (x *SetBalancerRunningRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_Master_proto_msgTypes[48]
+	mi := &file_Master_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2709,7 +2836,7 @@ func (x *SetBalancerRunningRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetBalancerRunningRequest.ProtoReflect.Descriptor instead.
 func (*SetBalancerRunningRequest) Descriptor() ([]byte, []int) {
-	return file_Master_proto_rawDescGZIP(), []int{48}
+	return file_Master_proto_rawDescGZIP(), []int{50}
 }
 
 func (x *SetBalancerRunningRequest) GetOn() bool {
@@ -2737,7 +2864,7 @@ type SetBalancerRunningResponse struct {
 func (x *SetBalancerRunningResponse) Reset() {
 	*x = SetBalancerRunningResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_Master_proto_msgTypes[49]
+		mi := &file_Master_proto_msgTypes[51]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2750,7 +2877,7 @@ func (x *SetBalancerRunningResponse) String() string {
 func (*SetBalancerRunningResponse) ProtoMessage() {}
 
 func (x *SetBalancerRunningResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_Master_proto_msgTypes[49]
+	mi := &file_Master_proto_msgTypes[51]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2763,7 +2890,7 @@ func (x *SetBalancerRunningResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetBalancerRunningResponse.ProtoReflect.Descriptor instead.
 func (*SetBalancerRunningResponse) Descriptor() ([]byte, []int) {
-	return file_Master_proto_rawDescGZIP(), []int{49}
+	return file_Master_proto_rawDescGZIP(), []int{51}
 }
 
 func (x *SetBalancerRunningResponse) GetPrevBalanceValue() bool {
@@ -2782,7 +2909,7 @@ type IsBalancerEnabledRequest struct {
 func (x *IsBalancerEnabledRequest) Reset() {
 	*x = IsBalancerEnabledRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_Master_proto_msgTypes[50]
+		mi := &file_Master_proto_msgTypes[52]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2795,7 +2922,7 @@ func (x *IsBalancerEnabledRequest) String() string {
 func (*IsBalancerEnabledRequest) ProtoMessage() {}
 
 func (x *IsBalancerEnabledRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_Master_proto_msgTypes[50]
+	mi := &file_Master_proto_msgTypes[52]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2808,7 +2935,7 @@ func (x *IsBalancerEnabledRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IsBalancerEnabledRequest.ProtoReflect.Descriptor instead.
 func (*IsBalancerEnabledRequest) Descriptor() ([]byte, []int) {
-	return file_Master_proto_rawDescGZIP(), []int{50}
+	return file_Master_proto_rawDescGZIP(), []int{52}
 }
 
 type IsBalancerEnabledResponse struct {
@@ -2822,7 +2949,7 @@ type IsBalancerEnabledResponse struct {
 func (x *IsBalancerEnabledResponse) Reset() {
 	*x = IsBalancerEnabledResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_Master_proto_msgTypes[51]
+		mi := &file_Master_proto_msgTypes[53]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2835,7 +2962,7 @@ func (x *IsBalancerEnabledResponse) String() string {
 func (*IsBalancerEnabledResponse) ProtoMessage() {}
 
 func (x *IsBalancerEnabledResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_Master_proto_msgTypes[51]
+	mi := &file_Master_proto_msgTypes[53]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2848,7 +2975,7 @@ func (x *IsBalancerEnabledResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IsBalancerEnabledResponse.ProtoReflect.Descriptor instead.
 func (*IsBalancerEnabledResponse) Descriptor() ([]byte, []int) {
-	return file_Master_proto_rawDescGZIP(), []int{51}
+	return file_Master_proto_rawDescGZIP(), []int{53}
 }
 
 func (x *IsBalancerEnabledResponse) GetEnabled() bool {
@@ -2871,7 +2998,7 @@ type SetSplitOrMergeEnabledRequest struct {
 func (x *SetSplitOrMergeEnabledRequest) Reset() {
 	*x = SetSplitOrMergeEnabledRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_Master_proto_msgTypes[52]
+		mi := &file_Master_proto_msgTypes[54]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2884,7 +3011,7 @@ func (x *SetSplitOrMergeEnabledRequest) String() string {
 func (*SetSplitOrMergeEnabledRequest) ProtoMessage() {}
 
 func (x *SetSplitOrMergeEnabledRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_Master_proto_msgTypes[52]
+	mi := &file_Master_proto_msgTypes[54]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2897,7 +3024,7 @@ func (x *SetSplitOrMergeEnabledRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetSplitOrMergeEnabledRequest.ProtoReflect.Descriptor instead.
 func (*SetSplitOrMergeEnabledRequest) Descriptor() ([]byte, []int) {
-	return file_Master_proto_rawDescGZIP(), []int{52}
+	return file_Master_proto_rawDescGZIP(), []int{54}
 }
 
 func (x *SetSplitOrMergeEnabledRequest) GetEnabled() bool {
@@ -2932,7 +3059,7 @@ type SetSplitOrMergeEnabledResponse struct {
 func (x *SetSplitOrMergeEnabledResponse) Reset() {
 	*x = SetSplitOrMergeEnabledResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_Master_proto_msgTypes[53]
+		mi := &file_Master_proto_msgTypes[55]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2945,7 +3072,7 @@ func (x *SetSplitOrMergeEnabledResponse) String() string {
 func (*SetSplitOrMergeEnabledResponse) ProtoMessage() {}
 
 func (x *SetSplitOrMergeEnabledResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_Master_proto_msgTypes[53]
+	mi := &file_Master_proto_msgTypes[55]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2958,7 +3085,7 @@ func (x *SetSplitOrMergeEnabledResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetSplitOrMergeEnabledResponse.ProtoReflect.Descriptor instead.
 func (*SetSplitOrMergeEnabledResponse) Descriptor() ([]byte, []int) {
-	return file_Master_proto_rawDescGZIP(), []int{53}
+	return file_Master_proto_rawDescGZIP(), []int{55}
 }
 
 func (x *SetSplitOrMergeEnabledResponse) GetPrevValue() []bool {
@@ -2979,7 +3106,7 @@ type IsSplitOrMergeEnabledRequest struct {
 func (x *IsSplitOrMergeEnabledRequest) Reset() {
 	*x = IsSplitOrMergeEnabledRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_Master_proto_msgTypes[54]
+		mi := &file_Master_proto_msgTypes[56]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2992,7 +3119,7 @@ func (x *IsSplitOrMergeEnabledRequest) String() string {
 func (*IsSplitOrMergeEnabledRequest) ProtoMessage() {}
 
 func (x *IsSplitOrMergeEnabledRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_Master_proto_msgTypes[54]
+	mi := &file_Master_proto_msgTypes[56]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3005,7 +3132,7 @@ func (x *IsSplitOrMergeEnabledRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IsSplitOrMergeEnabledRequest.ProtoReflect.Descriptor instead.
 func (*IsSplitOrMergeEnabledRequest) Descriptor() ([]byte, []int) {
-	return file_Master_proto_rawDescGZIP(), []int{54}
+	return file_Master_proto_rawDescGZIP(), []int{56}
 }
 
 func (x *IsSplitOrMergeEnabledRequest) GetSwitchType() MasterSwitchType {
@@ -3026,7 +3153,7 @@ type IsSplitOrMergeEnabledResponse struct {
 func (x *IsSplitOrMergeEnabledResponse) Reset() {
 	*x = IsSplitOrMergeEnabledResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_Master_proto_msgTypes[55]
+		mi := &file_Master_proto_msgTypes[57]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3039,7 +3166,7 @@ func (x *IsSplitOrMergeEnabledResponse) String() string {
 func (*IsSplitOrMergeEnabledResponse) ProtoMessage() {}
 
 func (x *IsSplitOrMergeEnabledResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_Master_proto_msgTypes[55]
+	mi := &file_Master_proto_msgTypes[57]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3052,7 +3179,7 @@ func (x *IsSplitOrMergeEnabledResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IsSplitOrMergeEnabledResponse.ProtoReflect.Descriptor instead.
 func (*IsSplitOrMergeEnabledResponse) Descriptor() ([]byte, []int) {
-	return file_Master_proto_rawDescGZIP(), []int{55}
+	return file_Master_proto_rawDescGZIP(), []int{57}
 }
 
 func (x *IsSplitOrMergeEnabledResponse) GetEnabled() bool {
@@ -3071,7 +3198,7 @@ type NormalizeRequest struct {
 func (x *NormalizeRequest) Reset() {
 	*x = NormalizeRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_Master_proto_msgTypes[56]
+		mi := &file_Master_proto_msgTypes[58]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3084,7 +3211,7 @@ func (x *NormalizeRequest) String() string {
 func (*NormalizeRequest) ProtoMessage() {}
 
 func (x *NormalizeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_Master_proto_msgTypes[56]
+	mi := &file_Master_proto_msgTypes[58]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3097,7 +3224,7 @@ func (x *NormalizeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NormalizeRequest.ProtoReflect.Descriptor instead.
 func (*NormalizeRequest) Descriptor() ([]byte, []int) {
-	return file_Master_proto_rawDescGZIP(), []int{56}
+	return file_Master_proto_rawDescGZIP(), []int{58}
 }
 
 type NormalizeResponse struct {
@@ -3111,7 +3238,7 @@ type NormalizeResponse struct {
 func (x *NormalizeResponse) Reset() {
 	*x = NormalizeResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_Master_proto_msgTypes[57]
+		mi := &file_Master_proto_msgTypes[59]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3124,7 +3251,7 @@ func (x *NormalizeResponse) String() string {
 func (*NormalizeResponse) ProtoMessage() {}
 
 func (x *NormalizeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_Master_proto_msgTypes[57]
+	mi := &file_Master_proto_msgTypes[59]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3137,7 +3264,7 @@ func (x *NormalizeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NormalizeResponse.ProtoReflect.Descriptor instead.
 func (*NormalizeResponse) Descriptor() ([]byte, []int) {
-	return file_Master_proto_rawDescGZIP(), []int{57}
+	return file_Master_proto_rawDescGZIP(), []int{59}
 }
 
 func (x *NormalizeResponse) GetNormalizerRan() bool {
@@ -3158,7 +3285,7 @@ type SetNormalizerRunningRequest struct {
 func (x *SetNormalizerRunningRequest) Reset() {
 	*x = SetNormalizerRunningRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_Master_proto_msgTypes[58]
+		mi := &file_Master_proto_msgTypes[60]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3171,7 +3298,7 @@ func (x *SetNormalizerRunningRequest) String() string {
 func (*SetNormalizerRunningRequest) ProtoMessage() {}
 
 func (x *SetNormalizerRunningRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_Master_proto_msgTypes[58]
+	mi := &file_Master_proto_msgTypes[60]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3184,7 +3311,7 @@ func (x *SetNormalizerRunningRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetNormalizerRunningRequest.ProtoReflect.Descriptor instead.
 func (*SetNormalizerRunningRequest) Descriptor() ([]byte, []int) {
-	return file_Master_proto_rawDescGZIP(), []int{58}
+	return file_Master_proto_rawDescGZIP(), []int{60}
 }
 
 func (x *SetNormalizerRunningRequest) GetOn() bool {
@@ -3205,7 +3332,7 @@ type SetNormalizerRunningResponse struct {
 func (x *SetNormalizerRunningResponse) Reset() {
 	*x = SetNormalizerRunningResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_Master_proto_msgTypes[59]
+		mi := &file_Master_proto_msgTypes[61]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3218,7 +3345,7 @@ func (x *SetNormalizerRunningResponse) String() string {
 func (*SetNormalizerRunningResponse) ProtoMessage() {}
 
 func (x *SetNormalizerRunningResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_Master_proto_msgTypes[59]
+	mi := &file_Master_proto_msgTypes[61]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3231,7 +3358,7 @@ func (x *SetNormalizerRunningResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetNormalizerRunningResponse.ProtoReflect.Descriptor instead.
 func (*SetNormalizerRunningResponse) Descriptor() ([]byte, []int) {
-	return file_Master_proto_rawDescGZIP(), []int{59}
+	return file_Master_proto_rawDescGZIP(), []int{61}
 }
 
 func (x *SetNormalizerRunningResponse) GetPrevNormalizerValue() bool {
@@ -3250,7 +3377,7 @@ type IsNormalizerEnabledRequest struct {
 func (x *IsNormalizerEnabledRequest) Reset() {
 	*x = IsNormalizerEnabledRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_Master_proto_msgTypes[60]
+		mi := &file_Master_proto_msgTypes[62]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3263,7 +3390,7 @@ func (x *IsNormalizerEnabledRequest) String() string {
 func (*IsNormalizerEnabledRequest) ProtoMessage() {}
 
 func (x *IsNormalizerEnabledRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_Master_proto_msgTypes[60]
+	mi := &file_Master_proto_msgTypes[62]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3276,7 +3403,7 @@ func (x *IsNormalizerEnabledRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IsNormalizerEnabledRequest.ProtoReflect.Descriptor instead.
 func (*IsNormalizerEnabledRequest) Descriptor() ([]byte, []int) {
-	return file_Master_proto_rawDescGZIP(), []int{60}
+	return file_Master_proto_rawDescGZIP(), []int{62}
 }
 
 type IsNormalizerEnabledResponse struct {
@@ -3290,7 +3417,7 @@ type IsNormalizerEnabledResponse struct {
 func (x *IsNormalizerEnabledResponse) Reset() {
 	*x = IsNormalizerEnabledResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_Master_proto_msgTypes[61]
+		mi := &file_Master_proto_msgTypes[63]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3303,7 +3430,7 @@ func (x *IsNormalizerEnabledResponse) String() string {
 func (*IsNormalizerEnabledResponse) ProtoMessage() {}
 
 func (x *IsNormalizerEnabledResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_Master_proto_msgTypes[61]
+	mi := &file_Master_proto_msgTypes[63]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3316,7 +3443,7 @@ func (x *IsNormalizerEnabledResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IsNormalizerEnabledResponse.ProtoReflect.Descriptor instead.
 func (*IsNormalizerEnabledResponse) Descriptor() ([]byte, []int) {
-	return file_Master_proto_rawDescGZIP(), []int{61}
+	return file_Master_proto_rawDescGZIP(), []int{63}
 }
 
 func (x *IsNormalizerEnabledResponse) GetEnabled() bool {
@@ -3335,7 +3462,7 @@ type RunCatalogScanRequest struct {
 func (x *RunCatalogScanRequest) Reset() {
 	*x = RunCatalogScanRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_Master_proto_msgTypes[62]
+		mi := &file_Master_proto_msgTypes[64]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3348,7 +3475,7 @@ func (x *RunCatalogScanRequest) String() string {
 func (*RunCatalogScanRequest) ProtoMessage() {}
 
 func (x *RunCatalogScanRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_Master_proto_msgTypes[62]
+	mi := &file_Master_proto_msgTypes[64]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3361,7 +3488,7 @@ func (x *RunCatalogScanRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RunCatalogScanRequest.ProtoReflect.Descriptor instead.
 func (*RunCatalogScanRequest) Descriptor() ([]byte, []int) {
-	return file_Master_proto_rawDescGZIP(), []int{62}
+	return file_Master_proto_rawDescGZIP(), []int{64}
 }
 
 type RunCatalogScanResponse struct {
@@ -3375,7 +3502,7 @@ type RunCatalogScanResponse struct {
 func (x *RunCatalogScanResponse) Reset() {
 	*x = RunCatalogScanResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_Master_proto_msgTypes[63]
+		mi := &file_Master_proto_msgTypes[65]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3388,7 +3515,7 @@ func (x *RunCatalogScanResponse) String() string {
 func (*RunCatalogScanResponse) ProtoMessage() {}
 
 func (x *RunCatalogScanResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_Master_proto_msgTypes[63]
+	mi := &file_Master_proto_msgTypes[65]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3401,7 +3528,7 @@ func (x *RunCatalogScanResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RunCatalogScanResponse.ProtoReflect.Descriptor instead.
 func (*RunCatalogScanResponse) Descriptor() ([]byte, []int) {
-	return file_Master_proto_rawDescGZIP(), []int{63}
+	return file_Master_proto_rawDescGZIP(), []int{65}
 }
 
 func (x *RunCatalogScanResponse) GetScanResult() int32 {
@@ -3422,7 +3549,7 @@ type EnableCatalogJanitorRequest struct {
 func (x *EnableCatalogJanitorRequest) Reset() {
 	*x = EnableCatalogJanitorRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_Master_proto_msgTypes[64]
+		mi := &file_Master_proto_msgTypes[66]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3435,7 +3562,7 @@ func (x *EnableCatalogJanitorRequest) String() string {
 func (*EnableCatalogJanitorRequest) ProtoMessage() {}
 
 func (x *EnableCatalogJanitorRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_Master_proto_msgTypes[64]
+	mi := &file_Master_proto_msgTypes[66]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3448,7 +3575,7 @@ func (x *EnableCatalogJanitorRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EnableCatalogJanitorRequest.ProtoReflect.Descriptor instead.
 func (*EnableCatalogJanitorRequest) Descriptor() ([]byte, []int) {
-	return file_Master_proto_rawDescGZIP(), []int{64}
+	return file_Master_proto_rawDescGZIP(), []int{66}
 }
 
 func (x *EnableCatalogJanitorRequest) GetEnable() bool {
@@ -3469,7 +3596,7 @@ type EnableCatalogJanitorResponse struct {
 func (x *EnableCatalogJanitorResponse) Reset() {
 	*x = EnableCatalogJanitorResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_Master_proto_msgTypes[65]
+		mi := &file_Master_proto_msgTypes[67]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3482,7 +3609,7 @@ func (x *EnableCatalogJanitorResponse) String() string {
 func (*EnableCatalogJanitorResponse) ProtoMessage() {}
 
 func (x *EnableCatalogJanitorResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_Master_proto_msgTypes[65]
+	mi := &file_Master_proto_msgTypes[67]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3495,7 +3622,7 @@ func (x *EnableCatalogJanitorResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EnableCatalogJanitorResponse.ProtoReflect.Descriptor instead.
 func (*EnableCatalogJanitorResponse) Descriptor() ([]byte, []int) {
-	return file_Master_proto_rawDescGZIP(), []int{65}
+	return file_Master_proto_rawDescGZIP(), []int{67}
 }
 
 func (x *EnableCatalogJanitorResponse) GetPrevValue() bool {
@@ -3514,7 +3641,7 @@ type IsCatalogJanitorEnabledRequest struct {
 func (x *IsCatalogJanitorEnabledRequest) Reset() {
 	*x = IsCatalogJanitorEnabledRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_Master_proto_msgTypes[66]
+		mi := &file_Master_proto_msgTypes[68]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3527,7 +3654,7 @@ func (x *IsCatalogJanitorEnabledRequest) String() string {
 func (*IsCatalogJanitorEnabledRequest) ProtoMessage() {}
 
 func (x *IsCatalogJanitorEnabledRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_Master_proto_msgTypes[66]
+	mi := &file_Master_proto_msgTypes[68]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3540,7 +3667,7 @@ func (x *IsCatalogJanitorEnabledRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IsCatalogJanitorEnabledRequest.ProtoReflect.Descriptor instead.
 func (*IsCatalogJanitorEnabledRequest) Descriptor() ([]byte, []int) {
-	return file_Master_proto_rawDescGZIP(), []int{66}
+	return file_Master_proto_rawDescGZIP(), []int{68}
 }
 
 type IsCatalogJanitorEnabledResponse struct {
@@ -3554,7 +3681,7 @@ type IsCatalogJanitorEnabledResponse struct {
 func (x *IsCatalogJanitorEnabledResponse) Reset() {
 	*x = IsCatalogJanitorEnabledResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_Master_proto_msgTypes[67]
+		mi := &file_Master_proto_msgTypes[69]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3567,7 +3694,7 @@ func (x *IsCatalogJanitorEnabledResponse) String() string {
 func (*IsCatalogJanitorEnabledResponse) ProtoMessage() {}
 
 func (x *IsCatalogJanitorEnabledResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_Master_proto_msgTypes[67]
+	mi := &file_Master_proto_msgTypes[69]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3580,7 +3707,7 @@ func (x *IsCatalogJanitorEnabledResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IsCatalogJanitorEnabledResponse.ProtoReflect.Descriptor instead.
 func (*IsCatalogJanitorEnabledResponse) Descriptor() ([]byte, []int) {
-	return file_Master_proto_rawDescGZIP(), []int{67}
+	return file_Master_proto_rawDescGZIP(), []int{69}
 }
 
 func (x *IsCatalogJanitorEnabledResponse) GetValue() bool {
@@ -3601,7 +3728,7 @@ type SnapshotRequest struct {
 func (x *SnapshotRequest) Reset() {
 	*x = SnapshotRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_Master_proto_msgTypes[68]
+		mi := &file_Master_proto_msgTypes[70]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3614,7 +3741,7 @@ func (x *SnapshotRequest) String() string {
 func (*SnapshotRequest) ProtoMessage() {}
 
 func (x *SnapshotRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_Master_proto_msgTypes[68]
+	mi := &file_Master_proto_msgTypes[70]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3627,7 +3754,7 @@ func (x *SnapshotRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SnapshotRequest.ProtoReflect.Descriptor instead.
 func (*SnapshotRequest) Descriptor() ([]byte, []int) {
-	return file_Master_proto_rawDescGZIP(), []int{68}
+	return file_Master_proto_rawDescGZIP(), []int{70}
 }
 
 func (x *SnapshotRequest) GetSnapshot() *SnapshotDescription {
@@ -3648,7 +3775,7 @@ type SnapshotResponse struct {
 func (x *SnapshotResponse) Reset() {
 	*x = SnapshotResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_Master_proto_msgTypes[69]
+		mi := &file_Master_proto_msgTypes[71]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3661,7 +3788,7 @@ func (x *SnapshotResponse) String() string {
 func (*SnapshotResponse) ProtoMessage() {}
 
 func (x *SnapshotResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_Master_proto_msgTypes[69]
+	mi := &file_Master_proto_msgTypes[71]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3674,7 +3801,7 @@ func (x *SnapshotResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SnapshotResponse.ProtoReflect.Descriptor instead.
 func (*SnapshotResponse) Descriptor() ([]byte, []int) {
-	return file_Master_proto_rawDescGZIP(), []int{69}
+	return file_Master_proto_rawDescGZIP(), []int{71}
 }
 
 func (x *SnapshotResponse) GetExpectedTimeout() int64 {
@@ -3693,7 +3820,7 @@ type GetCompletedSnapshotsRequest struct {
 func (x *GetCompletedSnapshotsRequest) Reset() {
 	*x = GetCompletedSnapshotsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_Master_proto_msgTypes[70]
+		mi := &file_Master_proto_msgTypes[72]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3706,7 +3833,7 @@ func (x *GetCompletedSnapshotsRequest) String() string {
 func (*GetCompletedSnapshotsRequest) ProtoMessage() {}
 
 func (x *GetCompletedSnapshotsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_Master_proto_msgTypes[70]
+	mi := &file_Master_proto_msgTypes[72]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3719,7 +3846,7 @@ func (x *GetCompletedSnapshotsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCompletedSnapshotsRequest.ProtoReflect.Descriptor instead.
 func (*GetCompletedSnapshotsRequest) Descriptor() ([]byte, []int) {
-	return file_Master_proto_rawDescGZIP(), []int{70}
+	return file_Master_proto_rawDescGZIP(), []int{72}
 }
 
 type GetCompletedSnapshotsResponse struct {
@@ -3733,7 +3860,7 @@ type GetCompletedSnapshotsResponse struct {
 func (x *GetCompletedSnapshotsResponse) Reset() {
 	*x = GetCompletedSnapshotsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_Master_proto_msgTypes[71]
+		mi := &file_Master_proto_msgTypes[73]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3746,7 +3873,7 @@ func (x *GetCompletedSnapshotsResponse) String() string {
 func (*GetCompletedSnapshotsResponse) ProtoMessage() {}
 
 func (x *GetCompletedSnapshotsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_Master_proto_msgTypes[71]
+	mi := &file_Master_proto_msgTypes[73]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3759,7 +3886,7 @@ func (x *GetCompletedSnapshotsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCompletedSnapshotsResponse.ProtoReflect.Descriptor instead.
 func (*GetCompletedSnapshotsResponse) Descriptor() ([]byte, []int) {
-	return file_Master_proto_rawDescGZIP(), []int{71}
+	return file_Master_proto_rawDescGZIP(), []int{73}
 }
 
 func (x *GetCompletedSnapshotsResponse) GetSnapshots() []*SnapshotDescription {
@@ -3780,7 +3907,7 @@ type DeleteSnapshotRequest struct {
 func (x *DeleteSnapshotRequest) Reset() {
 	*x = DeleteSnapshotRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_Master_proto_msgTypes[72]
+		mi := &file_Master_proto_msgTypes[74]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3793,7 +3920,7 @@ func (x *DeleteSnapshotRequest) String() string {
 func (*DeleteSnapshotRequest) ProtoMessage() {}
 
 func (x *DeleteSnapshotRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_Master_proto_msgTypes[72]
+	mi := &file_Master_proto_msgTypes[74]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3806,7 +3933,7 @@ func (x *DeleteSnapshotRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteSnapshotRequest.ProtoReflect.Descriptor instead.
 func (*DeleteSnapshotRequest) Descriptor() ([]byte, []int) {
-	return file_Master_proto_rawDescGZIP(), []int{72}
+	return file_Master_proto_rawDescGZIP(), []int{74}
 }
 
 func (x *DeleteSnapshotRequest) GetSnapshot() *SnapshotDescription {
@@ -3825,7 +3952,7 @@ type DeleteSnapshotResponse struct {
 func (x *DeleteSnapshotResponse) Reset() {
 	*x = DeleteSnapshotResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_Master_proto_msgTypes[73]
+		mi := &file_Master_proto_msgTypes[75]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3838,7 +3965,7 @@ func (x *DeleteSnapshotResponse) String() string {
 func (*DeleteSnapshotResponse) ProtoMessage() {}
 
 func (x *DeleteSnapshotResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_Master_proto_msgTypes[73]
+	mi := &file_Master_proto_msgTypes[75]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3851,7 +3978,7 @@ func (x *DeleteSnapshotResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteSnapshotResponse.ProtoReflect.Descriptor instead.
 func (*DeleteSnapshotResponse) Descriptor() ([]byte, []int) {
-	return file_Master_proto_rawDescGZIP(), []int{73}
+	return file_Master_proto_rawDescGZIP(), []int{75}
 }
 
 type RestoreSnapshotRequest struct {
@@ -3865,7 +3992,7 @@ type RestoreSnapshotRequest struct {
 func (x *RestoreSnapshotRequest) Reset() {
 	*x = RestoreSnapshotRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_Master_proto_msgTypes[74]
+		mi := &file_Master_proto_msgTypes[76]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3878,7 +4005,7 @@ func (x *RestoreSnapshotRequest) String() string {
 func (*RestoreSnapshotRequest) ProtoMessage() {}
 
 func (x *RestoreSnapshotRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_Master_proto_msgTypes[74]
+	mi := &file_Master_proto_msgTypes[76]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3891,7 +4018,7 @@ func (x *RestoreSnapshotRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RestoreSnapshotRequest.ProtoReflect.Descriptor instead.
 func (*RestoreSnapshotRequest) Descriptor() ([]byte, []int) {
-	return file_Master_proto_rawDescGZIP(), []int{74}
+	return file_Master_proto_rawDescGZIP(), []int{76}
 }
 
 func (x *RestoreSnapshotRequest) GetSnapshot() *SnapshotDescription {
@@ -3910,7 +4037,7 @@ type RestoreSnapshotResponse struct {
 func (x *RestoreSnapshotResponse) Reset() {
 	*x = RestoreSnapshotResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_Master_proto_msgTypes[75]
+		mi := &file_Master_proto_msgTypes[77]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3923,7 +4050,7 @@ func (x *RestoreSnapshotResponse) String() string {
 func (*RestoreSnapshotResponse) ProtoMessage() {}
 
 func (x *RestoreSnapshotResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_Master_proto_msgTypes[75]
+	mi := &file_Master_proto_msgTypes[77]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3936,7 +4063,7 @@ func (x *RestoreSnapshotResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RestoreSnapshotResponse.ProtoReflect.Descriptor instead.
 func (*RestoreSnapshotResponse) Descriptor() ([]byte, []int) {
-	return file_Master_proto_rawDescGZIP(), []int{75}
+	return file_Master_proto_rawDescGZIP(), []int{77}
 }
 
 // if you don't send the snapshot, then you will get it back
@@ -3952,7 +4079,7 @@ type IsSnapshotDoneRequest struct {
 func (x *IsSnapshotDoneRequest) Reset() {
 	*x = IsSnapshotDoneRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_Master_proto_msgTypes[76]
+		mi := &file_Master_proto_msgTypes[78]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3965,7 +4092,7 @@ func (x *IsSnapshotDoneRequest) String() string {
 func (*IsSnapshotDoneRequest) ProtoMessage() {}
 
 func (x *IsSnapshotDoneRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_Master_proto_msgTypes[76]
+	mi := &file_Master_proto_msgTypes[78]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3978,7 +4105,7 @@ func (x *IsSnapshotDoneRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IsSnapshotDoneRequest.ProtoReflect.Descriptor instead.
 func (*IsSnapshotDoneRequest) Descriptor() ([]byte, []int) {
-	return file_Master_proto_rawDescGZIP(), []int{76}
+	return file_Master_proto_rawDescGZIP(), []int{78}
 }
 
 func (x *IsSnapshotDoneRequest) GetSnapshot() *SnapshotDescription {
@@ -4005,7 +4132,7 @@ const (
 func (x *IsSnapshotDoneResponse) Reset() {
 	*x = IsSnapshotDoneResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_Master_proto_msgTypes[77]
+		mi := &file_Master_proto_msgTypes[79]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4018,7 +4145,7 @@ func (x *IsSnapshotDoneResponse) String() string {
 func (*IsSnapshotDoneResponse) ProtoMessage() {}
 
 func (x *IsSnapshotDoneResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_Master_proto_msgTypes[77]
+	mi := &file_Master_proto_msgTypes[79]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4031,7 +4158,7 @@ func (x *IsSnapshotDoneResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IsSnapshotDoneResponse.ProtoReflect.Descriptor instead.
 func (*IsSnapshotDoneResponse) Descriptor() ([]byte, []int) {
-	return file_Master_proto_rawDescGZIP(), []int{77}
+	return file_Master_proto_rawDescGZIP(), []int{79}
 }
 
 func (x *IsSnapshotDoneResponse) GetDone() bool {
@@ -4059,7 +4186,7 @@ type IsRestoreSnapshotDoneRequest struct {
 func (x *IsRestoreSnapshotDoneRequest) Reset() {
 	*x = IsRestoreSnapshotDoneRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_Master_proto_msgTypes[78]
+		mi := &file_Master_proto_msgTypes[80]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4072,7 +4199,7 @@ func (x *IsRestoreSnapshotDoneRequest) String() string {
 func (*IsRestoreSnapshotDoneRequest) ProtoMessage() {}
 
 func (x *IsRestoreSnapshotDoneRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_Master_proto_msgTypes[78]
+	mi := &file_Master_proto_msgTypes[80]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4085,7 +4212,7 @@ func (x *IsRestoreSnapshotDoneRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IsRestoreSnapshotDoneRequest.ProtoReflect.Descriptor instead.
 func (*IsRestoreSnapshotDoneRequest) Descriptor() ([]byte, []int) {
-	return file_Master_proto_rawDescGZIP(), []int{78}
+	return file_Master_proto_rawDescGZIP(), []int{80}
 }
 
 func (x *IsRestoreSnapshotDoneRequest) GetSnapshot() *SnapshotDescription {
@@ -4111,7 +4238,7 @@ const (
 func (x *IsRestoreSnapshotDoneResponse) Reset() {
 	*x = IsRestoreSnapshotDoneResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_Master_proto_msgTypes[79]
+		mi := &file_Master_proto_msgTypes[81]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4124,7 +4251,7 @@ func (x *IsRestoreSnapshotDoneResponse) String() string {
 func (*IsRestoreSnapshotDoneResponse) ProtoMessage() {}
 
 func (x *IsRestoreSnapshotDoneResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_Master_proto_msgTypes[79]
+	mi := &file_Master_proto_msgTypes[81]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4137,7 +4264,7 @@ func (x *IsRestoreSnapshotDoneResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IsRestoreSnapshotDoneResponse.ProtoReflect.Descriptor instead.
 func (*IsRestoreSnapshotDoneResponse) Descriptor() ([]byte, []int) {
-	return file_Master_proto_rawDescGZIP(), []int{79}
+	return file_Master_proto_rawDescGZIP(), []int{81}
 }
 
 func (x *IsRestoreSnapshotDoneResponse) GetDone() bool {
@@ -4158,7 +4285,7 @@ type GetSchemaAlterStatusRequest struct {
 func (x *GetSchemaAlterStatusRequest) Reset() {
 	*x = GetSchemaAlterStatusRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_Master_proto_msgTypes[80]
+		mi := &file_Master_proto_msgTypes[82]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4171,7 +4298,7 @@ func (x *GetSchemaAlterStatusRequest) String() string {
 func (*GetSchemaAlterStatusRequest) ProtoMessage() {}
 
 func (x *GetSchemaAlterStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_Master_proto_msgTypes[80]
+	mi := &file_Master_proto_msgTypes[82]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4184,7 +4311,7 @@ func (x *GetSchemaAlterStatusRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSchemaAlterStatusRequest.ProtoReflect.Descriptor instead.
 func (*GetSchemaAlterStatusRequest) Descriptor() ([]byte, []int) {
-	return file_Master_proto_rawDescGZIP(), []int{80}
+	return file_Master_proto_rawDescGZIP(), []int{82}
 }
 
 func (x *GetSchemaAlterStatusRequest) GetTableName() *TableName {
@@ -4206,7 +4333,7 @@ type GetSchemaAlterStatusResponse struct {
 func (x *GetSchemaAlterStatusResponse) Reset() {
 	*x = GetSchemaAlterStatusResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_Master_proto_msgTypes[81]
+		mi := &file_Master_proto_msgTypes[83]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4219,7 +4346,7 @@ func (x *GetSchemaAlterStatusResponse) String() string {
 func (*GetSchemaAlterStatusResponse) ProtoMessage() {}
 
 func (x *GetSchemaAlterStatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_Master_proto_msgTypes[81]
+	mi := &file_Master_proto_msgTypes[83]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4232,7 +4359,7 @@ func (x *GetSchemaAlterStatusResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSchemaAlterStatusResponse.ProtoReflect.Descriptor instead.
 func (*GetSchemaAlterStatusResponse) Descriptor() ([]byte, []int) {
-	return file_Master_proto_rawDescGZIP(), []int{81}
+	return file_Master_proto_rawDescGZIP(), []int{83}
 }
 
 func (x *GetSchemaAlterStatusResponse) GetYetToUpdateRegions() uint32 {
@@ -4268,7 +4395,7 @@ const (
 func (x *GetTableDescriptorsRequest) Reset() {
 	*x = GetTableDescriptorsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_Master_proto_msgTypes[82]
+		mi := &file_Master_proto_msgTypes[84]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4281,7 +4408,7 @@ func (x *GetTableDescriptorsRequest) String() string {
 func (*GetTableDescriptorsRequest) ProtoMessage() {}
 
 func (x *GetTableDescriptorsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_Master_proto_msgTypes[82]
+	mi := &file_Master_proto_msgTypes[84]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4294,7 +4421,7 @@ func (x *GetTableDescriptorsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTableDescriptorsRequest.ProtoReflect.Descriptor instead.
 func (*GetTableDescriptorsRequest) Descriptor() ([]byte, []int) {
-	return file_Master_proto_rawDescGZIP(), []int{82}
+	return file_Master_proto_rawDescGZIP(), []int{84}
 }
 
 func (x *GetTableDescriptorsRequest) GetTableNames() []*TableName {
@@ -4336,7 +4463,7 @@ type GetTableDescriptorsResponse struct {
 func (x *GetTableDescriptorsResponse) Reset() {
 	*x = GetTableDescriptorsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_Master_proto_msgTypes[83]
+		mi := &file_Master_proto_msgTypes[85]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4349,7 +4476,7 @@ func (x *GetTableDescriptorsResponse) String() string {
 func (*GetTableDescriptorsResponse) ProtoMessage() {}
 
 func (x *GetTableDescriptorsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_Master_proto_msgTypes[83]
+	mi := &file_Master_proto_msgTypes[85]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4362,7 +4489,7 @@ func (x *GetTableDescriptorsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTableDescriptorsResponse.ProtoReflect.Descriptor instead.
 func (*GetTableDescriptorsResponse) Descriptor() ([]byte, []int) {
-	return file_Master_proto_rawDescGZIP(), []int{83}
+	return file_Master_proto_rawDescGZIP(), []int{85}
 }
 
 func (x *GetTableDescriptorsResponse) GetTableSchema() []*TableSchema {
@@ -4390,7 +4517,7 @@ const (
 func (x *GetTableNamesRequest) Reset() {
 	*x = GetTableNamesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_Master_proto_msgTypes[84]
+		mi := &file_Master_proto_msgTypes[86]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4403,7 +4530,7 @@ func (x *GetTableNamesRequest) String() string {
 func (*GetTableNamesRequest) ProtoMessage() {}
 
 func (x *GetTableNamesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_Master_proto_msgTypes[84]
+	mi := &file_Master_proto_msgTypes[86]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4416,7 +4543,7 @@ func (x *GetTableNamesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTableNamesRequest.ProtoReflect.Descriptor instead.
 func (*GetTableNamesRequest) Descriptor() ([]byte, []int) {
-	return file_Master_proto_rawDescGZIP(), []int{84}
+	return file_Master_proto_rawDescGZIP(), []int{86}
 }
 
 func (x *GetTableNamesRequest) GetRegex() string {
@@ -4451,7 +4578,7 @@ type GetTableNamesResponse struct {
 func (x *GetTableNamesResponse) Reset() {
 	*x = GetTableNamesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_Master_proto_msgTypes[85]
+		mi := &file_Master_proto_msgTypes[87]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4464,7 +4591,7 @@ func (x *GetTableNamesResponse) String() string {
 func (*GetTableNamesResponse) ProtoMessage() {}
 
 func (x *GetTableNamesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_Master_proto_msgTypes[85]
+	mi := &file_Master_proto_msgTypes[87]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4477,7 +4604,7 @@ func (x *GetTableNamesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTableNamesResponse.ProtoReflect.Descriptor instead.
 func (*GetTableNamesResponse) Descriptor() ([]byte, []int) {
-	return file_Master_proto_rawDescGZIP(), []int{85}
+	return file_Master_proto_rawDescGZIP(), []int{87}
 }
 
 func (x *GetTableNamesResponse) GetTableNames() []*TableName {
@@ -4496,7 +4623,7 @@ type GetClusterStatusRequest struct {
 func (x *GetClusterStatusRequest) Reset() {
 	*x = GetClusterStatusRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_Master_proto_msgTypes[86]
+		mi := &file_Master_proto_msgTypes[88]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4509,7 +4636,7 @@ func (x *GetClusterStatusRequest) String() string {
 func (*GetClusterStatusRequest) ProtoMessage() {}
 
 func (x *GetClusterStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_Master_proto_msgTypes[86]
+	mi := &file_Master_proto_msgTypes[88]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4522,7 +4649,7 @@ func (x *GetClusterStatusRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetClusterStatusRequest.ProtoReflect.Descriptor instead.
 func (*GetClusterStatusRequest) Descriptor() ([]byte, []int) {
-	return file_Master_proto_rawDescGZIP(), []int{86}
+	return file_Master_proto_rawDescGZIP(), []int{88}
 }
 
 type GetClusterStatusResponse struct {
@@ -4536,7 +4663,7 @@ type GetClusterStatusResponse struct {
 func (x *GetClusterStatusResponse) Reset() {
 	*x = GetClusterStatusResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_Master_proto_msgTypes[87]
+		mi := &file_Master_proto_msgTypes[89]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4549,7 +4676,7 @@ func (x *GetClusterStatusResponse) String() string {
 func (*GetClusterStatusResponse) ProtoMessage() {}
 
 func (x *GetClusterStatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_Master_proto_msgTypes[87]
+	mi := &file_Master_proto_msgTypes[89]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4562,7 +4689,7 @@ func (x *GetClusterStatusResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetClusterStatusResponse.ProtoReflect.Descriptor instead.
 func (*GetClusterStatusResponse) Descriptor() ([]byte, []int) {
-	return file_Master_proto_rawDescGZIP(), []int{87}
+	return file_Master_proto_rawDescGZIP(), []int{89}
 }
 
 func (x *GetClusterStatusResponse) GetClusterStatus() *ClusterStatus {
@@ -4581,7 +4708,7 @@ type IsMasterRunningRequest struct {
 func (x *IsMasterRunningRequest) Reset() {
 	*x = IsMasterRunningRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_Master_proto_msgTypes[88]
+		mi := &file_Master_proto_msgTypes[90]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4594,7 +4721,7 @@ func (x *IsMasterRunningRequest) String() string {
 func (*IsMasterRunningRequest) ProtoMessage() {}
 
 func (x *IsMasterRunningRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_Master_proto_msgTypes[88]
+	mi := &file_Master_proto_msgTypes[90]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4607,7 +4734,7 @@ func (x *IsMasterRunningRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IsMasterRunningRequest.ProtoReflect.Descriptor instead.
 func (*IsMasterRunningRequest) Descriptor() ([]byte, []int) {
-	return file_Master_proto_rawDescGZIP(), []int{88}
+	return file_Master_proto_rawDescGZIP(), []int{90}
 }
 
 type IsMasterRunningResponse struct {
@@ -4621,7 +4748,7 @@ type IsMasterRunningResponse struct {
 func (x *IsMasterRunningResponse) Reset() {
 	*x = IsMasterRunningResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_Master_proto_msgTypes[89]
+		mi := &file_Master_proto_msgTypes[91]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4634,7 +4761,7 @@ func (x *IsMasterRunningResponse) String() string {
 func (*IsMasterRunningResponse) ProtoMessage() {}
 
 func (x *IsMasterRunningResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_Master_proto_msgTypes[89]
+	mi := &file_Master_proto_msgTypes[91]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4647,7 +4774,7 @@ func (x *IsMasterRunningResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IsMasterRunningResponse.ProtoReflect.Descriptor instead.
 func (*IsMasterRunningResponse) Descriptor() ([]byte, []int) {
-	return file_Master_proto_rawDescGZIP(), []int{89}
+	return file_Master_proto_rawDescGZIP(), []int{91}
 }
 
 func (x *IsMasterRunningResponse) GetIsMasterRunning() bool {
@@ -4668,7 +4795,7 @@ type ExecProcedureRequest struct {
 func (x *ExecProcedureRequest) Reset() {
 	*x = ExecProcedureRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_Master_proto_msgTypes[90]
+		mi := &file_Master_proto_msgTypes[92]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4681,7 +4808,7 @@ func (x *ExecProcedureRequest) String() string {
 func (*ExecProcedureRequest) ProtoMessage() {}
 
 func (x *ExecProcedureRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_Master_proto_msgTypes[90]
+	mi := &file_Master_proto_msgTypes[92]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4694,7 +4821,7 @@ func (x *ExecProcedureRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExecProcedureRequest.ProtoReflect.Descriptor instead.
 func (*ExecProcedureRequest) Descriptor() ([]byte, []int) {
-	return file_Master_proto_rawDescGZIP(), []int{90}
+	return file_Master_proto_rawDescGZIP(), []int{92}
 }
 
 func (x *ExecProcedureRequest) GetProcedure() *ProcedureDescription {
@@ -4716,7 +4843,7 @@ type ExecProcedureResponse struct {
 func (x *ExecProcedureResponse) Reset() {
 	*x = ExecProcedureResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_Master_proto_msgTypes[91]
+		mi := &file_Master_proto_msgTypes[93]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4729,7 +4856,7 @@ func (x *ExecProcedureResponse) String() string {
 func (*ExecProcedureResponse) ProtoMessage() {}
 
 func (x *ExecProcedureResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_Master_proto_msgTypes[91]
+	mi := &file_Master_proto_msgTypes[93]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4742,7 +4869,7 @@ func (x *ExecProcedureResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExecProcedureResponse.ProtoReflect.Descriptor instead.
 func (*ExecProcedureResponse) Descriptor() ([]byte, []int) {
-	return file_Master_proto_rawDescGZIP(), []int{91}
+	return file_Master_proto_rawDescGZIP(), []int{93}
 }
 
 func (x *ExecProcedureResponse) GetExpectedTimeout() int64 {
@@ -4770,7 +4897,7 @@ type IsProcedureDoneRequest struct {
 func (x *IsProcedureDoneRequest) Reset() {
 	*x = IsProcedureDoneRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_Master_proto_msgTypes[92]
+		mi := &file_Master_proto_msgTypes[94]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4783,7 +4910,7 @@ func (x *IsProcedureDoneRequest) String() string {
 func (*IsProcedureDoneRequest) ProtoMessage() {}
 
 func (x *IsProcedureDoneRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_Master_proto_msgTypes[92]
+	mi := &file_Master_proto_msgTypes[94]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4796,7 +4923,7 @@ func (x *IsProcedureDoneRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IsProcedureDoneRequest.ProtoReflect.Descriptor instead.
 func (*IsProcedureDoneRequest) Descriptor() ([]byte, []int) {
-	return file_Master_proto_rawDescGZIP(), []int{92}
+	return file_Master_proto_rawDescGZIP(), []int{94}
 }
 
 func (x *IsProcedureDoneRequest) GetProcedure() *ProcedureDescription {
@@ -4823,7 +4950,7 @@ const (
 func (x *IsProcedureDoneResponse) Reset() {
 	*x = IsProcedureDoneResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_Master_proto_msgTypes[93]
+		mi := &file_Master_proto_msgTypes[95]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4836,7 +4963,7 @@ func (x *IsProcedureDoneResponse) String() string {
 func (*IsProcedureDoneResponse) ProtoMessage() {}
 
 func (x *IsProcedureDoneResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_Master_proto_msgTypes[93]
+	mi := &file_Master_proto_msgTypes[95]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4849,7 +4976,7 @@ func (x *IsProcedureDoneResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IsProcedureDoneResponse.ProtoReflect.Descriptor instead.
 func (*IsProcedureDoneResponse) Descriptor() ([]byte, []int) {
-	return file_Master_proto_rawDescGZIP(), []int{93}
+	return file_Master_proto_rawDescGZIP(), []int{95}
 }
 
 func (x *IsProcedureDoneResponse) GetDone() bool {
@@ -4877,7 +5004,7 @@ type GetProcedureResultRequest struct {
 func (x *GetProcedureResultRequest) Reset() {
 	*x = GetProcedureResultRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_Master_proto_msgTypes[94]
+		mi := &file_Master_proto_msgTypes[96]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4890,7 +5017,7 @@ func (x *GetProcedureResultRequest) String() string {
 func (*GetProcedureResultRequest) ProtoMessage() {}
 
 func (x *GetProcedureResultRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_Master_proto_msgTypes[94]
+	mi := &file_Master_proto_msgTypes[96]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4903,7 +5030,7 @@ func (x *GetProcedureResultRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetProcedureResultRequest.ProtoReflect.Descriptor instead.
 func (*GetProcedureResultRequest) Descriptor() ([]byte, []int) {
-	return file_Master_proto_rawDescGZIP(), []int{94}
+	return file_Master_proto_rawDescGZIP(), []int{96}
 }
 
 func (x *GetProcedureResultRequest) GetProcId() uint64 {
@@ -4928,7 +5055,7 @@ type GetProcedureResultResponse struct {
 func (x *GetProcedureResultResponse) Reset() {
 	*x = GetProcedureResultResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_Master_proto_msgTypes[95]
+		mi := &file_Master_proto_msgTypes[97]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4941,7 +5068,7 @@ func (x *GetProcedureResultResponse) String() string {
 func (*GetProcedureResultResponse) ProtoMessage() {}
 
 func (x *GetProcedureResultResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_Master_proto_msgTypes[95]
+	mi := &file_Master_proto_msgTypes[97]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4954,7 +5081,7 @@ func (x *GetProcedureResultResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetProcedureResultResponse.ProtoReflect.Descriptor instead.
 func (*GetProcedureResultResponse) Descriptor() ([]byte, []int) {
-	return file_Master_proto_rawDescGZIP(), []int{95}
+	return file_Master_proto_rawDescGZIP(), []int{97}
 }
 
 func (x *GetProcedureResultResponse) GetState() GetProcedureResultResponse_State {
@@ -5009,7 +5136,7 @@ const (
 func (x *AbortProcedureRequest) Reset() {
 	*x = AbortProcedureRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_Master_proto_msgTypes[96]
+		mi := &file_Master_proto_msgTypes[98]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5022,7 +5149,7 @@ func (x *AbortProcedureRequest) String() string {
 func (*AbortProcedureRequest) ProtoMessage() {}
 
 func (x *AbortProcedureRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_Master_proto_msgTypes[96]
+	mi := &file_Master_proto_msgTypes[98]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5035,7 +5162,7 @@ func (x *AbortProcedureRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AbortProcedureRequest.ProtoReflect.Descriptor instead.
 func (*AbortProcedureRequest) Descriptor() ([]byte, []int) {
-	return file_Master_proto_rawDescGZIP(), []int{96}
+	return file_Master_proto_rawDescGZIP(), []int{98}
 }
 
 func (x *AbortProcedureRequest) GetProcId() uint64 {
@@ -5063,7 +5190,7 @@ type AbortProcedureResponse struct {
 func (x *AbortProcedureResponse) Reset() {
 	*x = AbortProcedureResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_Master_proto_msgTypes[97]
+		mi := &file_Master_proto_msgTypes[99]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5076,7 +5203,7 @@ func (x *AbortProcedureResponse) String() string {
 func (*AbortProcedureResponse) ProtoMessage() {}
 
 func (x *AbortProcedureResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_Master_proto_msgTypes[97]
+	mi := &file_Master_proto_msgTypes[99]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5089,7 +5216,7 @@ func (x *AbortProcedureResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AbortProcedureResponse.ProtoReflect.Descriptor instead.
 func (*AbortProcedureResponse) Descriptor() ([]byte, []int) {
-	return file_Master_proto_rawDescGZIP(), []int{97}
+	return file_Master_proto_rawDescGZIP(), []int{99}
 }
 
 func (x *AbortProcedureResponse) GetIsProcedureAborted() bool {
@@ -5108,7 +5235,7 @@ type ListProceduresRequest struct {
 func (x *ListProceduresRequest) Reset() {
 	*x = ListProceduresRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_Master_proto_msgTypes[98]
+		mi := &file_Master_proto_msgTypes[100]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5121,7 +5248,7 @@ func (x *ListProceduresRequest) String() string {
 func (*ListProceduresRequest) ProtoMessage() {}
 
 func (x *ListProceduresRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_Master_proto_msgTypes[98]
+	mi := &file_Master_proto_msgTypes[100]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5134,7 +5261,7 @@ func (x *ListProceduresRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListProceduresRequest.ProtoReflect.Descriptor instead.
 func (*ListProceduresRequest) Descriptor() ([]byte, []int) {
-	return file_Master_proto_rawDescGZIP(), []int{98}
+	return file_Master_proto_rawDescGZIP(), []int{100}
 }
 
 type ListProceduresResponse struct {
@@ -5148,7 +5275,7 @@ type ListProceduresResponse struct {
 func (x *ListProceduresResponse) Reset() {
 	*x = ListProceduresResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_Master_proto_msgTypes[99]
+		mi := &file_Master_proto_msgTypes[101]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5161,7 +5288,7 @@ func (x *ListProceduresResponse) String() string {
 func (*ListProceduresResponse) ProtoMessage() {}
 
 func (x *ListProceduresResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_Master_proto_msgTypes[99]
+	mi := &file_Master_proto_msgTypes[101]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5174,7 +5301,7 @@ func (x *ListProceduresResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListProceduresResponse.ProtoReflect.Descriptor instead.
 func (*ListProceduresResponse) Descriptor() ([]byte, []int) {
-	return file_Master_proto_rawDescGZIP(), []int{99}
+	return file_Master_proto_rawDescGZIP(), []int{101}
 }
 
 func (x *ListProceduresResponse) GetProcedure() []*Procedure {
@@ -5201,7 +5328,7 @@ type SetQuotaRequest struct {
 func (x *SetQuotaRequest) Reset() {
 	*x = SetQuotaRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_Master_proto_msgTypes[100]
+		mi := &file_Master_proto_msgTypes[102]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5214,7 +5341,7 @@ func (x *SetQuotaRequest) String() string {
 func (*SetQuotaRequest) ProtoMessage() {}
 
 func (x *SetQuotaRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_Master_proto_msgTypes[100]
+	mi := &file_Master_proto_msgTypes[102]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5227,7 +5354,7 @@ func (x *SetQuotaRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetQuotaRequest.ProtoReflect.Descriptor instead.
 func (*SetQuotaRequest) Descriptor() ([]byte, []int) {
-	return file_Master_proto_rawDescGZIP(), []int{100}
+	return file_Master_proto_rawDescGZIP(), []int{102}
 }
 
 func (x *SetQuotaRequest) GetUserName() string {
@@ -5288,7 +5415,7 @@ type SetQuotaResponse struct {
 func (x *SetQuotaResponse) Reset() {
 	*x = SetQuotaResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_Master_proto_msgTypes[101]
+		mi := &file_Master_proto_msgTypes[103]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5301,7 +5428,7 @@ func (x *SetQuotaResponse) String() string {
 func (*SetQuotaResponse) ProtoMessage() {}
 
 func (x *SetQuotaResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_Master_proto_msgTypes[101]
+	mi := &file_Master_proto_msgTypes[103]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5314,7 +5441,7 @@ func (x *SetQuotaResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetQuotaResponse.ProtoReflect.Descriptor instead.
 func (*SetQuotaResponse) Descriptor() ([]byte, []int) {
-	return file_Master_proto_rawDescGZIP(), []int{101}
+	return file_Master_proto_rawDescGZIP(), []int{103}
 }
 
 type MajorCompactionTimestampRequest struct {
@@ -5328,7 +5455,7 @@ type MajorCompactionTimestampRequest struct {
 func (x *MajorCompactionTimestampRequest) Reset() {
 	*x = MajorCompactionTimestampRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_Master_proto_msgTypes[102]
+		mi := &file_Master_proto_msgTypes[104]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5341,7 +5468,7 @@ func (x *MajorCompactionTimestampRequest) String() string {
 func (*MajorCompactionTimestampRequest) ProtoMessage() {}
 
 func (x *MajorCompactionTimestampRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_Master_proto_msgTypes[102]
+	mi := &file_Master_proto_msgTypes[104]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5354,7 +5481,7 @@ func (x *MajorCompactionTimestampRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MajorCompactionTimestampRequest.ProtoReflect.Descriptor instead.
 func (*MajorCompactionTimestampRequest) Descriptor() ([]byte, []int) {
-	return file_Master_proto_rawDescGZIP(), []int{102}
+	return file_Master_proto_rawDescGZIP(), []int{104}
 }
 
 func (x *MajorCompactionTimestampRequest) GetTableName() *TableName {
@@ -5375,7 +5502,7 @@ type MajorCompactionTimestampForRegionRequest struct {
 func (x *MajorCompactionTimestampForRegionRequest) Reset() {
 	*x = MajorCompactionTimestampForRegionRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_Master_proto_msgTypes[103]
+		mi := &file_Master_proto_msgTypes[105]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5388,7 +5515,7 @@ func (x *MajorCompactionTimestampForRegionRequest) String() string {
 func (*MajorCompactionTimestampForRegionRequest) ProtoMessage() {}
 
 func (x *MajorCompactionTimestampForRegionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_Master_proto_msgTypes[103]
+	mi := &file_Master_proto_msgTypes[105]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5401,7 +5528,7 @@ func (x *MajorCompactionTimestampForRegionRequest) ProtoReflect() protoreflect.M
 
 // Deprecated: Use MajorCompactionTimestampForRegionRequest.ProtoReflect.Descriptor instead.
 func (*MajorCompactionTimestampForRegionRequest) Descriptor() ([]byte, []int) {
-	return file_Master_proto_rawDescGZIP(), []int{103}
+	return file_Master_proto_rawDescGZIP(), []int{105}
 }
 
 func (x *MajorCompactionTimestampForRegionRequest) GetRegion() *RegionSpecifier {
@@ -5422,7 +5549,7 @@ type MajorCompactionTimestampResponse struct {
 func (x *MajorCompactionTimestampResponse) Reset() {
 	*x = MajorCompactionTimestampResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_Master_proto_msgTypes[104]
+		mi := &file_Master_proto_msgTypes[106]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5435,7 +5562,7 @@ func (x *MajorCompactionTimestampResponse) String() string {
 func (*MajorCompactionTimestampResponse) ProtoMessage() {}
 
 func (x *MajorCompactionTimestampResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_Master_proto_msgTypes[104]
+	mi := &file_Master_proto_msgTypes[106]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5448,7 +5575,7 @@ func (x *MajorCompactionTimestampResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MajorCompactionTimestampResponse.ProtoReflect.Descriptor instead.
 func (*MajorCompactionTimestampResponse) Descriptor() ([]byte, []int) {
-	return file_Master_proto_rawDescGZIP(), []int{104}
+	return file_Master_proto_rawDescGZIP(), []int{106}
 }
 
 func (x *MajorCompactionTimestampResponse) GetCompactionTimestamp() int64 {
@@ -5467,7 +5594,7 @@ type SecurityCapabilitiesRequest struct {
 func (x *SecurityCapabilitiesRequest) Reset() {
 	*x = SecurityCapabilitiesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_Master_proto_msgTypes[105]
+		mi := &file_Master_proto_msgTypes[107]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5480,7 +5607,7 @@ func (x *SecurityCapabilitiesRequest) String() string {
 func (*SecurityCapabilitiesRequest) ProtoMessage() {}
 
 func (x *SecurityCapabilitiesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_Master_proto_msgTypes[105]
+	mi := &file_Master_proto_msgTypes[107]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5493,7 +5620,7 @@ func (x *SecurityCapabilitiesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SecurityCapabilitiesRequest.ProtoReflect.Descriptor instead.
 func (*SecurityCapabilitiesRequest) Descriptor() ([]byte, []int) {
-	return file_Master_proto_rawDescGZIP(), []int{105}
+	return file_Master_proto_rawDescGZIP(), []int{107}
 }
 
 type SecurityCapabilitiesResponse struct {
@@ -5507,7 +5634,7 @@ type SecurityCapabilitiesResponse struct {
 func (x *SecurityCapabilitiesResponse) Reset() {
 	*x = SecurityCapabilitiesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_Master_proto_msgTypes[106]
+		mi := &file_Master_proto_msgTypes[108]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5520,7 +5647,7 @@ func (x *SecurityCapabilitiesResponse) String() string {
 func (*SecurityCapabilitiesResponse) ProtoMessage() {}
 
 func (x *SecurityCapabilitiesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_Master_proto_msgTypes[106]
+	mi := &file_Master_proto_msgTypes[108]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5533,7 +5660,7 @@ func (x *SecurityCapabilitiesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SecurityCapabilitiesResponse.ProtoReflect.Descriptor instead.
 func (*SecurityCapabilitiesResponse) Descriptor() ([]byte, []int) {
-	return file_Master_proto_rawDescGZIP(), []int{106}
+	return file_Master_proto_rawDescGZIP(), []int{108}
 }
 
 func (x *SecurityCapabilitiesResponse) GetCapabilities() []SecurityCapabilitiesResponse_Capability {
@@ -5613,7 +5740,21 @@ var file_Master_proto_rawDesc = []byte{
 	0x62, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x3a, 0x05, 0x66, 0x61, 0x6c, 0x73, 0x65,
 	0x52, 0x08, 0x66, 0x6f, 0x72, 0x63, 0x69, 0x62, 0x6c, 0x65, 0x22, 0x20, 0x0a, 0x1e, 0x44, 0x69,
 	0x73, 0x70, 0x61, 0x74, 0x63, 0x68, 0x4d, 0x65, 0x72, 0x67, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x67,
-	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x42, 0x0a, 0x13,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xa7, 0x01, 0x0a,
+	0x18, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x67, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x06, 0x72, 0x65, 0x67,
+	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x52,
+	0x65, 0x67, 0x69, 0x6f, 0x6e, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x06,
+	0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x08, 0x66, 0x6f, 0x72, 0x63, 0x69, 0x62,
+	0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x3a, 0x05, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x52,
+	0x08, 0x66, 0x6f, 0x72, 0x63, 0x69, 0x62, 0x6c, 0x65, 0x12, 0x22, 0x0a, 0x0b, 0x6e, 0x6f, 0x6e,
+	0x63, 0x65, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x3a, 0x01,
+	0x30, 0x52, 0x0a, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x17, 0x0a,
+	0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x3a, 0x01, 0x30, 0x52,
+	0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x22, 0x34, 0x0a, 0x19, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x54,
+	0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x70, 0x72, 0x6f, 0x63, 0x49, 0x64, 0x22, 0x42, 0x0a, 0x13,
 	0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
 	0x65, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
 	0x02, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x53,
@@ -6086,7 +6227,7 @@ var file_Master_proto_rawDesc = []byte{
 	0x49, 0x53, 0x49, 0x42, 0x49, 0x4c, 0x49, 0x54, 0x59, 0x10, 0x04, 0x2a, 0x28, 0x0a, 0x10, 0x4d,
 	0x61, 0x73, 0x74, 0x65, 0x72, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x54, 0x79, 0x70, 0x65, 0x12,
 	0x09, 0x0a, 0x05, 0x53, 0x50, 0x4c, 0x49, 0x54, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x4d, 0x45,
-	0x52, 0x47, 0x45, 0x10, 0x01, 0x32, 0xdf, 0x22, 0x0a, 0x0d, 0x4d, 0x61, 0x73, 0x74, 0x65, 0x72,
+	0x52, 0x47, 0x45, 0x10, 0x01, 0x32, 0xb1, 0x23, 0x0a, 0x0d, 0x4d, 0x61, 0x73, 0x74, 0x65, 0x72,
 	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x59, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x53, 0x63,
 	0x68, 0x65, 0x6d, 0x61, 0x41, 0x6c, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
 	0x1f, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x41, 0x6c,
@@ -6134,242 +6275,247 @@ var file_Master_proto_rawDesc = []byte{
 	0x4d, 0x65, 0x72, 0x67, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
 	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x69, 0x73, 0x70, 0x61,
 	0x74, 0x63, 0x68, 0x4d, 0x65, 0x72, 0x67, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x0c, 0x41, 0x73, 0x73,
-	0x69, 0x67, 0x6e, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x41,
-	0x73, 0x73, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x52, 0x65,
-	0x67, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x0e,
-	0x55, 0x6e, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x19,
-	0x2e, 0x70, 0x62, 0x2e, 0x55, 0x6e, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x67, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x55,
-	0x6e, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x0d, 0x4f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65,
-	0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x4f, 0x66, 0x66, 0x6c,
-	0x69, 0x6e, 0x65, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x4f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x67,
-	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x0b, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x16, 0x2e, 0x70, 0x62, 0x2e,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x61,
-	0x62, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x0d, 0x74,
-	0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x18, 0x2e, 0x70,
-	0x62, 0x2e, 0x54, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x72, 0x75, 0x6e,
-	0x63, 0x61, 0x74, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x3e, 0x0a, 0x0b, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65,
-	0x12, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x61, 0x62, 0x6c,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x6e,
-	0x61, 0x62, 0x6c, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x41, 0x0a, 0x0c, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x61, 0x62, 0x6c,
-	0x65, 0x12, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x61,
-	0x62, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x70, 0x62, 0x2e,
-	0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x0b, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x54, 0x61,
-	0x62, 0x6c, 0x65, 0x12, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x54,
-	0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x70, 0x62,
-	0x2e, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x61,
-	0x62, 0x6c, 0x65, 0x12, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54,
-	0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x70, 0x62,
-	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x53, 0x68, 0x75, 0x74, 0x64, 0x6f, 0x77, 0x6e,
-	0x12, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x68, 0x75, 0x74, 0x64, 0x6f, 0x77, 0x6e, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x68, 0x75, 0x74, 0x64,
-	0x6f, 0x77, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x0a, 0x53,
-	0x74, 0x6f, 0x70, 0x4d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x12, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x53,
-	0x74, 0x6f, 0x70, 0x4d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x4d, 0x61, 0x73, 0x74, 0x65, 0x72,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x07, 0x42, 0x61, 0x6c, 0x61,
-	0x6e, 0x63, 0x65, 0x12, 0x12, 0x2e, 0x70, 0x62, 0x2e, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x42, 0x61, 0x6c,
-	0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x12,
-	0x53, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72, 0x52, 0x75, 0x6e, 0x6e, 0x69,
-	0x6e, 0x67, 0x12, 0x1d, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e,
-	0x63, 0x65, 0x72, 0x52, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1e, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63,
-	0x65, 0x72, 0x52, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x50, 0x0a, 0x11, 0x49, 0x73, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72, 0x45,
-	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x1c, 0x2e, 0x70, 0x62, 0x2e, 0x49, 0x73, 0x42, 0x61,
-	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x70, 0x62, 0x2e, 0x49, 0x73, 0x42, 0x61, 0x6c, 0x61,
-	0x6e, 0x63, 0x65, 0x72, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x5f, 0x0a, 0x16, 0x53, 0x65, 0x74, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x4f,
-	0x72, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x21, 0x2e,
-	0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x4f, 0x72, 0x4d, 0x65, 0x72,
-	0x67, 0x65, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x22, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x4f, 0x72,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x11, 0x4d, 0x65, 0x72,
+	0x67, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1c,
+	0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65,
+	0x67, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x70,
+	0x62, 0x2e, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x67, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x0c, 0x41,
+	0x73, 0x73, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x17, 0x2e, 0x70, 0x62,
+	0x2e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e,
+	0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47,
+	0x0a, 0x0e, 0x55, 0x6e, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e,
+	0x12, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x6e, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x52, 0x65,
+	0x67, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x62,
+	0x2e, 0x55, 0x6e, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x0d, 0x4f, 0x66, 0x66, 0x6c, 0x69,
+	0x6e, 0x65, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x4f, 0x66,
+	0x66, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x4f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x52,
+	0x65, 0x67, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a,
+	0x0b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x16, 0x2e, 0x70,
+	0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x54, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a,
+	0x0d, 0x74, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x18,
+	0x2e, 0x70, 0x62, 0x2e, 0x54, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x54, 0x61, 0x62, 0x6c,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x72,
+	0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x0b, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x61, 0x62,
+	0x6c, 0x65, 0x12, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x61,
+	0x62, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x70, 0x62, 0x2e,
+	0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x0c, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x61,
+	0x62, 0x6c, 0x65, 0x12, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65,
+	0x54, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x70,
+	0x62, 0x2e, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x0b, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79,
+	0x54, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x6f, 0x64, 0x69, 0x66,
+	0x79, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e,
+	0x70, 0x62, 0x2e, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x54, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e,
+	0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x53, 0x68, 0x75, 0x74, 0x64, 0x6f,
+	0x77, 0x6e, 0x12, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x68, 0x75, 0x74, 0x64, 0x6f, 0x77, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x68, 0x75,
+	0x74, 0x64, 0x6f, 0x77, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a,
+	0x0a, 0x53, 0x74, 0x6f, 0x70, 0x4d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x12, 0x15, 0x2e, 0x70, 0x62,
+	0x2e, 0x53, 0x74, 0x6f, 0x70, 0x4d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x4d, 0x61, 0x73, 0x74,
+	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x07, 0x42, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x12, 0x2e, 0x70, 0x62, 0x2e, 0x42, 0x61, 0x6c, 0x61, 0x6e,
+	0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x42,
+	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53,
+	0x0a, 0x12, 0x53, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72, 0x52, 0x75, 0x6e,
+	0x6e, 0x69, 0x6e, 0x67, 0x12, 0x1d, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x42, 0x61, 0x6c,
+	0x61, 0x6e, 0x63, 0x65, 0x72, 0x52, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x72, 0x52, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x11, 0x49, 0x73, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x72, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x1c, 0x2e, 0x70, 0x62, 0x2e, 0x49, 0x73,
+	0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x70, 0x62, 0x2e, 0x49, 0x73, 0x42, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5f, 0x0a, 0x16, 0x53, 0x65, 0x74, 0x53, 0x70, 0x6c, 0x69,
+	0x74, 0x4f, 0x72, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12,
+	0x21, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x4f, 0x72, 0x4d,
+	0x65, 0x72, 0x67, 0x65, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x22, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x53, 0x70, 0x6c, 0x69, 0x74,
+	0x4f, 0x72, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x15, 0x49, 0x73, 0x53, 0x70, 0x6c, 0x69,
+	0x74, 0x4f, 0x72, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12,
+	0x20, 0x2e, 0x70, 0x62, 0x2e, 0x49, 0x73, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x4f, 0x72, 0x4d, 0x65,
+	0x72, 0x67, 0x65, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x21, 0x2e, 0x70, 0x62, 0x2e, 0x49, 0x73, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x4f, 0x72,
 	0x4d, 0x65, 0x72, 0x67, 0x65, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x15, 0x49, 0x73, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x4f,
-	0x72, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x20, 0x2e,
-	0x70, 0x62, 0x2e, 0x49, 0x73, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x4f, 0x72, 0x4d, 0x65, 0x72, 0x67,
-	0x65, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x21, 0x2e, 0x70, 0x62, 0x2e, 0x49, 0x73, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x4f, 0x72, 0x4d, 0x65,
-	0x72, 0x67, 0x65, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x38, 0x0a, 0x09, 0x4e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x12,
-	0x14, 0x2e, 0x70, 0x62, 0x2e, 0x4e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x4e, 0x6f, 0x72, 0x6d, 0x61,
-	0x6c, 0x69, 0x7a, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x59, 0x0a, 0x14,
-	0x53, 0x65, 0x74, 0x4e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x72, 0x52, 0x75, 0x6e,
-	0x6e, 0x69, 0x6e, 0x67, 0x12, 0x1f, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x4e, 0x6f, 0x72,
-	0x6d, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x72, 0x52, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x4e, 0x6f,
-	0x72, 0x6d, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x72, 0x52, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x13, 0x49, 0x73, 0x4e, 0x6f, 0x72,
-	0x6d, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x72, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x1e,
-	0x2e, 0x70, 0x62, 0x2e, 0x49, 0x73, 0x4e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x72,
-	0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f,
-	0x2e, 0x70, 0x62, 0x2e, 0x49, 0x73, 0x4e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x72,
-	0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x47, 0x0a, 0x0e, 0x52, 0x75, 0x6e, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x53, 0x63, 0x61,
-	0x6e, 0x12, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x75, 0x6e, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f,
-	0x67, 0x53, 0x63, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70,
-	0x62, 0x2e, 0x52, 0x75, 0x6e, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x53, 0x63, 0x61, 0x6e,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x59, 0x0a, 0x14, 0x45, 0x6e, 0x61, 0x62,
-	0x6c, 0x65, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x4a, 0x61, 0x6e, 0x69, 0x74, 0x6f, 0x72,
-	0x12, 0x1f, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x61, 0x74, 0x61,
-	0x6c, 0x6f, 0x67, 0x4a, 0x61, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x20, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x61, 0x74,
-	0x61, 0x6c, 0x6f, 0x67, 0x4a, 0x61, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x62, 0x0a, 0x17, 0x49, 0x73, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67,
-	0x4a, 0x61, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x22,
-	0x2e, 0x70, 0x62, 0x2e, 0x49, 0x73, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x4a, 0x61, 0x6e,
-	0x69, 0x74, 0x6f, 0x72, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x23, 0x2e, 0x70, 0x62, 0x2e, 0x49, 0x73, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f,
-	0x67, 0x4a, 0x61, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52, 0x0a, 0x11, 0x45, 0x78, 0x65, 0x63, 0x4d,
-	0x61, 0x73, 0x74, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x1d, 0x2e, 0x70,
-	0x62, 0x2e, 0x43, 0x6f, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x53, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x70, 0x62,
-	0x2e, 0x43, 0x6f, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x53, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x53,
-	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x6e, 0x61,
-	0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x70,
-	0x62, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x5c, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74,
-	0x65, 0x64, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x12, 0x20, 0x2e, 0x70, 0x62,
-	0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x53, 0x6e, 0x61,
-	0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x09, 0x4e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x69, 0x7a,
+	0x65, 0x12, 0x14, 0x2e, 0x70, 0x62, 0x2e, 0x4e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x69, 0x7a, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x4e, 0x6f, 0x72,
+	0x6d, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x59,
+	0x0a, 0x14, 0x53, 0x65, 0x74, 0x4e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x72, 0x52,
+	0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x1f, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x4e,
+	0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x72, 0x52, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74,
+	0x4e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x72, 0x52, 0x75, 0x6e, 0x6e, 0x69, 0x6e,
+	0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x13, 0x49, 0x73, 0x4e,
+	0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x72, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64,
+	0x12, 0x1e, 0x2e, 0x70, 0x62, 0x2e, 0x49, 0x73, 0x4e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x69, 0x7a,
+	0x65, 0x72, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1f, 0x2e, 0x70, 0x62, 0x2e, 0x49, 0x73, 0x4e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x69, 0x7a,
+	0x65, 0x72, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x47, 0x0a, 0x0e, 0x52, 0x75, 0x6e, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x53,
+	0x63, 0x61, 0x6e, 0x12, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x75, 0x6e, 0x43, 0x61, 0x74, 0x61,
+	0x6c, 0x6f, 0x67, 0x53, 0x63, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a,
+	0x2e, 0x70, 0x62, 0x2e, 0x52, 0x75, 0x6e, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x53, 0x63,
+	0x61, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x59, 0x0a, 0x14, 0x45, 0x6e,
+	0x61, 0x62, 0x6c, 0x65, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x4a, 0x61, 0x6e, 0x69, 0x74,
+	0x6f, 0x72, 0x12, 0x1f, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x61,
+	0x74, 0x61, 0x6c, 0x6f, 0x67, 0x4a, 0x61, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x43,
+	0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x4a, 0x61, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x62, 0x0a, 0x17, 0x49, 0x73, 0x43, 0x61, 0x74, 0x61, 0x6c,
+	0x6f, 0x67, 0x4a, 0x61, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64,
+	0x12, 0x22, 0x2e, 0x70, 0x62, 0x2e, 0x49, 0x73, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x4a,
+	0x61, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x70, 0x62, 0x2e, 0x49, 0x73, 0x43, 0x61, 0x74, 0x61,
+	0x6c, 0x6f, 0x67, 0x4a, 0x61, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65,
+	0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52, 0x0a, 0x11, 0x45, 0x78, 0x65,
+	0x63, 0x4d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x1d,
+	0x2e, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e,
+	0x70, 0x62, 0x2e, 0x43, 0x6f, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a,
+	0x08, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x53,
+	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14,
+	0x2e, 0x70, 0x62, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x6c,
+	0x65, 0x74, 0x65, 0x64, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x12, 0x20, 0x2e,
 	0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x53,
-	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x47, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68,
-	0x6f, 0x74, 0x12, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x6e,
-	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e,
-	0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x0e, 0x49, 0x73, 0x53,
-	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x44, 0x6f, 0x6e, 0x65, 0x12, 0x19, 0x2e, 0x70, 0x62,
-	0x2e, 0x49, 0x73, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x44, 0x6f, 0x6e, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x49, 0x73, 0x53, 0x6e,
-	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x44, 0x6f, 0x6e, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x4a, 0x0a, 0x0f, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x53, 0x6e, 0x61,
-	0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f,
-	0x72, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x53, 0x6e,
-	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c,
-	0x0a, 0x15, 0x49, 0x73, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73,
-	0x68, 0x6f, 0x74, 0x44, 0x6f, 0x6e, 0x65, 0x12, 0x20, 0x2e, 0x70, 0x62, 0x2e, 0x49, 0x73, 0x52,
-	0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x44, 0x6f,
-	0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x70, 0x62, 0x2e, 0x49,
+	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x21, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65,
+	0x64, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x47, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x12, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x0e, 0x49,
+	0x73, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x44, 0x6f, 0x6e, 0x65, 0x12, 0x19, 0x2e,
+	0x70, 0x62, 0x2e, 0x49, 0x73, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x44, 0x6f, 0x6e,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x49, 0x73,
+	0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x44, 0x6f, 0x6e, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x0f, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x53,
+	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73,
+	0x74, 0x6f, 0x72, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65,
+	0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x5c, 0x0a, 0x15, 0x49, 0x73, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x53, 0x6e, 0x61,
+	0x70, 0x73, 0x68, 0x6f, 0x74, 0x44, 0x6f, 0x6e, 0x65, 0x12, 0x20, 0x2e, 0x70, 0x62, 0x2e, 0x49,
 	0x73, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
-	0x44, 0x6f, 0x6e, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x0d,
-	0x45, 0x78, 0x65, 0x63, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x64, 0x75, 0x72, 0x65, 0x12, 0x18, 0x2e,
-	0x70, 0x62, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x64, 0x75, 0x72, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x78, 0x65,
-	0x63, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x64, 0x75, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x4b, 0x0a, 0x14, 0x45, 0x78, 0x65, 0x63, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x64,
-	0x75, 0x72, 0x65, 0x57, 0x69, 0x74, 0x68, 0x52, 0x65, 0x74, 0x12, 0x18, 0x2e, 0x70, 0x62, 0x2e,
-	0x45, 0x78, 0x65, 0x63, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x64, 0x75, 0x72, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x50, 0x72,
-	0x6f, 0x63, 0x65, 0x64, 0x75, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x4a, 0x0a, 0x0f, 0x49, 0x73, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x64, 0x75, 0x72, 0x65, 0x44, 0x6f,
-	0x6e, 0x65, 0x12, 0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x49, 0x73, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x64,
-	0x75, 0x72, 0x65, 0x44, 0x6f, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b,
-	0x2e, 0x70, 0x62, 0x2e, 0x49, 0x73, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x64, 0x75, 0x72, 0x65, 0x44,
-	0x6f, 0x6e, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x0f, 0x4d,
-	0x6f, 0x64, 0x69, 0x66, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x1a,
-	0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70,
-	0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x62, 0x2e,
-	0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x0f, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x1a, 0x2e, 0x70, 0x62, 0x2e,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x0f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4e, 0x61, 0x6d,
-	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4e, 0x61,
+	0x44, 0x6f, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x70, 0x62,
+	0x2e, 0x49, 0x73, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x44, 0x6f, 0x6e, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44,
+	0x0a, 0x0d, 0x45, 0x78, 0x65, 0x63, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x64, 0x75, 0x72, 0x65, 0x12,
+	0x18, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x64, 0x75,
+	0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x45,
+	0x78, 0x65, 0x63, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x64, 0x75, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a, 0x14, 0x45, 0x78, 0x65, 0x63, 0x50, 0x72, 0x6f, 0x63,
+	0x65, 0x64, 0x75, 0x72, 0x65, 0x57, 0x69, 0x74, 0x68, 0x52, 0x65, 0x74, 0x12, 0x18, 0x2e, 0x70,
+	0x62, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x64, 0x75, 0x72, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x78, 0x65, 0x63,
+	0x50, 0x72, 0x6f, 0x63, 0x65, 0x64, 0x75, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x4a, 0x0a, 0x0f, 0x49, 0x73, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x64, 0x75, 0x72, 0x65,
+	0x44, 0x6f, 0x6e, 0x65, 0x12, 0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x49, 0x73, 0x50, 0x72, 0x6f, 0x63,
+	0x65, 0x64, 0x75, 0x72, 0x65, 0x44, 0x6f, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1b, 0x2e, 0x70, 0x62, 0x2e, 0x49, 0x73, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x64, 0x75, 0x72,
+	0x65, 0x44, 0x6f, 0x6e, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a,
+	0x0f, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x12, 0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x4e, 0x61, 0x6d, 0x65,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70,
+	0x62, 0x2e, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x0f, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x1a, 0x2e, 0x70,
+	0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x0f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4e,
+	0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x5f, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x12, 0x21, 0x2e, 0x70, 0x62,
+	0x2e, 0x47, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x44, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22,
+	0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x65, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x23,
+	0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x61, 0x6d,
+	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7a, 0x0a, 0x1f, 0x4c, 0x69, 0x73,
+	0x74, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72,
+	0x73, 0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x2a, 0x2e, 0x70,
+	0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x6f, 0x72, 0x73, 0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f,
+	0x72, 0x73, 0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x68, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x61, 0x62,
+	0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x12, 0x24, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x61, 0x62, 0x6c,
+	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x42, 0x79, 0x4e, 0x61,
 	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x5f, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x44,
-	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x12, 0x21, 0x2e, 0x70, 0x62, 0x2e, 0x47,
-	0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72,
-	0x69, 0x70, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x70,
-	0x62, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x44, 0x65,
-	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x65, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
-	0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x23, 0x2e, 0x70,
-	0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x44,
-	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x24, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73,
-	0x70, 0x61, 0x63, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7a, 0x0a, 0x1f, 0x4c, 0x69, 0x73, 0x74, 0x54,
-	0x61, 0x62, 0x6c, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x73, 0x42,
-	0x79, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x2a, 0x2e, 0x70, 0x62, 0x2e,
-	0x4c, 0x69, 0x73, 0x74, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x6f, 0x72, 0x73, 0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74,
-	0x54, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x73,
-	0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x68, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x61, 0x62, 0x6c, 0x65,
-	0x4e, 0x61, 0x6d, 0x65, 0x73, 0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
-	0x12, 0x24, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x4e,
-	0x61, 0x6d, 0x65, 0x73, 0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74,
-	0x54, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65,
-	0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a,
-	0x08, 0x53, 0x65, 0x74, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x12, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x53,
-	0x65, 0x74, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14,
-	0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6c, 0x0a, 0x1f, 0x67, 0x65, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x4d,
+	0x35, 0x0a, 0x08, 0x53, 0x65, 0x74, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x12, 0x13, 0x2e, 0x70, 0x62,
+	0x2e, 0x53, 0x65, 0x74, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x14, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6c, 0x0a, 0x1f, 0x67, 0x65, 0x74, 0x4c, 0x61, 0x73,
+	0x74, 0x4d, 0x61, 0x6a, 0x6f, 0x72, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x23, 0x2e, 0x70, 0x62, 0x2e, 0x4d,
 	0x61, 0x6a, 0x6f, 0x72, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x23, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x61, 0x6a,
-	0x6f, 0x72, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x70,
-	0x62, 0x2e, 0x4d, 0x61, 0x6a, 0x6f, 0x72, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x7e, 0x0a, 0x28, 0x67, 0x65, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x4d, 0x61, 0x6a,
-	0x6f, 0x72, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x46, 0x6f, 0x72, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x2c,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24,
 	0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x61, 0x6a, 0x6f, 0x72, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x46, 0x6f, 0x72, 0x52,
-	0x65, 0x67, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x70,
-	0x62, 0x2e, 0x4d, 0x61, 0x6a, 0x6f, 0x72, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x53, 0x0a, 0x12, 0x67, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x64, 0x75,
-	0x72, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x1d, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65,
-	0x74, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x64, 0x75, 0x72, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74,
-	0x50, 0x72, 0x6f, 0x63, 0x65, 0x64, 0x75, 0x72, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x17, 0x67, 0x65, 0x74, 0x53, 0x65,
-	0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69,
-	0x65, 0x73, 0x12, 0x1f, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79,
-	0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74,
-	0x79, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x0e, 0x41, 0x62, 0x6f, 0x72, 0x74, 0x50, 0x72,
-	0x6f, 0x63, 0x65, 0x64, 0x75, 0x72, 0x65, 0x12, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x62, 0x6f,
-	0x72, 0x74, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x64, 0x75, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x62, 0x6f, 0x72, 0x74, 0x50, 0x72, 0x6f,
-	0x63, 0x65, 0x64, 0x75, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47,
-	0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x64, 0x75, 0x72, 0x65, 0x73,
-	0x12, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x64,
-	0x75, 0x72, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x62,
-	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x64, 0x75, 0x72, 0x65, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x49, 0x0a, 0x2a, 0x6f, 0x72, 0x67, 0x2e, 0x61,
-	0x70, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x68, 0x61, 0x64, 0x6f, 0x6f, 0x70, 0x2e, 0x68, 0x62, 0x61,
-	0x73, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x67, 0x65, 0x6e, 0x65,
-	0x72, 0x61, 0x74, 0x65, 0x64, 0x42, 0x0c, 0x4d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x50, 0x72, 0x6f,
-	0x74, 0x6f, 0x73, 0x48, 0x01, 0x5a, 0x05, 0x2e, 0x2e, 0x2f, 0x70, 0x62, 0x88, 0x01, 0x01, 0xa0,
-	0x01, 0x01,
+	0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7e, 0x0a, 0x28, 0x67, 0x65, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x4d,
+	0x61, 0x6a, 0x6f, 0x72, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x46, 0x6f, 0x72, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e,
+	0x12, 0x2c, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x61, 0x6a, 0x6f, 0x72, 0x43, 0x6f, 0x6d, 0x70, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x46, 0x6f,
+	0x72, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24,
+	0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x61, 0x6a, 0x6f, 0x72, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x12, 0x67, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x63, 0x65,
+	0x64, 0x75, 0x72, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x1d, 0x2e, 0x70, 0x62, 0x2e,
+	0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x64, 0x75, 0x72, 0x65, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x70, 0x62, 0x2e, 0x47,
+	0x65, 0x74, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x64, 0x75, 0x72, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x17, 0x67, 0x65, 0x74,
+	0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69,
+	0x74, 0x69, 0x65, 0x73, 0x12, 0x1f, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69,
+	0x74, 0x79, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x63, 0x75, 0x72,
+	0x69, 0x74, 0x79, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x0e, 0x41, 0x62, 0x6f, 0x72, 0x74,
+	0x50, 0x72, 0x6f, 0x63, 0x65, 0x64, 0x75, 0x72, 0x65, 0x12, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x41,
+	0x62, 0x6f, 0x72, 0x74, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x64, 0x75, 0x72, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x62, 0x6f, 0x72, 0x74, 0x50,
+	0x72, 0x6f, 0x63, 0x65, 0x64, 0x75, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x47, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x64, 0x75, 0x72,
+	0x65, 0x73, 0x12, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x63,
+	0x65, 0x64, 0x75, 0x72, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e,
+	0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x64, 0x75, 0x72, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x49, 0x0a, 0x2a, 0x6f, 0x72, 0x67,
+	0x2e, 0x61, 0x70, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x68, 0x61, 0x64, 0x6f, 0x6f, 0x70, 0x2e, 0x68,
+	0x62, 0x61, 0x73, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x67, 0x65,
+	0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x42, 0x0c, 0x4d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x73, 0x48, 0x01, 0x5a, 0x05, 0x2e, 0x2e, 0x2f, 0x70, 0x62, 0x88, 0x01,
+	0x01, 0xa0, 0x01, 0x01,
 }
 
 var (
@@ -6385,7 +6531,7 @@ func file_Master_proto_rawDescGZIP() []byte {
 }
 
 var file_Master_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_Master_proto_msgTypes = make([]protoimpl.MessageInfo, 107)
+var file_Master_proto_msgTypes = make([]protoimpl.MessageInfo, 109)
 var file_Master_proto_goTypes = []interface{}{
 	(MasterSwitchType)(0),                            // 0: pb.MasterSwitchType
 	(GetProcedureResultResponse_State)(0),            // 1: pb.GetProcedureResultResponse.State
@@ -6400,286 +6546,291 @@ var file_Master_proto_goTypes = []interface{}{
 	(*MoveRegionResponse)(nil),                       // 10: pb.MoveRegionResponse
 	(*DispatchMergingRegionsRequest)(nil),            // 11: pb.DispatchMergingRegionsRequest
 	(*DispatchMergingRegionsResponse)(nil),           // 12: pb.DispatchMergingRegionsResponse
-	(*AssignRegionRequest)(nil),                      // 13: pb.AssignRegionRequest
-	(*AssignRegionResponse)(nil),                     // 14: pb.AssignRegionResponse
-	(*UnassignRegionRequest)(nil),                    // 15: pb.UnassignRegionRequest
-	(*UnassignRegionResponse)(nil),                   // 16: pb.UnassignRegionResponse
-	(*OfflineRegionRequest)(nil),                     // 17: pb.OfflineRegionRequest
-	(*OfflineRegionResponse)(nil),                    // 18: pb.OfflineRegionResponse
-	(*CreateTableRequest)(nil),                       // 19: pb.CreateTableRequest
-	(*CreateTableResponse)(nil),                      // 20: pb.CreateTableResponse
-	(*DeleteTableRequest)(nil),                       // 21: pb.DeleteTableRequest
-	(*DeleteTableResponse)(nil),                      // 22: pb.DeleteTableResponse
-	(*TruncateTableRequest)(nil),                     // 23: pb.TruncateTableRequest
-	(*TruncateTableResponse)(nil),                    // 24: pb.TruncateTableResponse
-	(*EnableTableRequest)(nil),                       // 25: pb.EnableTableRequest
-	(*EnableTableResponse)(nil),                      // 26: pb.EnableTableResponse
-	(*DisableTableRequest)(nil),                      // 27: pb.DisableTableRequest
-	(*DisableTableResponse)(nil),                     // 28: pb.DisableTableResponse
-	(*ModifyTableRequest)(nil),                       // 29: pb.ModifyTableRequest
-	(*ModifyTableResponse)(nil),                      // 30: pb.ModifyTableResponse
-	(*CreateNamespaceRequest)(nil),                   // 31: pb.CreateNamespaceRequest
-	(*CreateNamespaceResponse)(nil),                  // 32: pb.CreateNamespaceResponse
-	(*DeleteNamespaceRequest)(nil),                   // 33: pb.DeleteNamespaceRequest
-	(*DeleteNamespaceResponse)(nil),                  // 34: pb.DeleteNamespaceResponse
-	(*ModifyNamespaceRequest)(nil),                   // 35: pb.ModifyNamespaceRequest
-	(*ModifyNamespaceResponse)(nil),                  // 36: pb.ModifyNamespaceResponse
-	(*GetNamespaceDescriptorRequest)(nil),            // 37: pb.GetNamespaceDescriptorRequest
-	(*GetNamespaceDescriptorResponse)(nil),           // 38: pb.GetNamespaceDescriptorResponse
-	(*ListNamespaceDescriptorsRequest)(nil),          // 39: pb.ListNamespaceDescriptorsRequest
-	(*ListNamespaceDescriptorsResponse)(nil),         // 40: pb.ListNamespaceDescriptorsResponse
-	(*ListTableDescriptorsByNamespaceRequest)(nil),   // 41: pb.ListTableDescriptorsByNamespaceRequest
-	(*ListTableDescriptorsByNamespaceResponse)(nil),  // 42: pb.ListTableDescriptorsByNamespaceResponse
-	(*ListTableNamesByNamespaceRequest)(nil),         // 43: pb.ListTableNamesByNamespaceRequest
-	(*ListTableNamesByNamespaceResponse)(nil),        // 44: pb.ListTableNamesByNamespaceResponse
-	(*ShutdownRequest)(nil),                          // 45: pb.ShutdownRequest
-	(*ShutdownResponse)(nil),                         // 46: pb.ShutdownResponse
-	(*StopMasterRequest)(nil),                        // 47: pb.StopMasterRequest
-	(*StopMasterResponse)(nil),                       // 48: pb.StopMasterResponse
-	(*BalanceRequest)(nil),                           // 49: pb.BalanceRequest
-	(*BalanceResponse)(nil),                          // 50: pb.BalanceResponse
-	(*SetBalancerRunningRequest)(nil),                // 51: pb.SetBalancerRunningRequest
-	(*SetBalancerRunningResponse)(nil),               // 52: pb.SetBalancerRunningResponse
-	(*IsBalancerEnabledRequest)(nil),                 // 53: pb.IsBalancerEnabledRequest
-	(*IsBalancerEnabledResponse)(nil),                // 54: pb.IsBalancerEnabledResponse
-	(*SetSplitOrMergeEnabledRequest)(nil),            // 55: pb.SetSplitOrMergeEnabledRequest
-	(*SetSplitOrMergeEnabledResponse)(nil),           // 56: pb.SetSplitOrMergeEnabledResponse
-	(*IsSplitOrMergeEnabledRequest)(nil),             // 57: pb.IsSplitOrMergeEnabledRequest
-	(*IsSplitOrMergeEnabledResponse)(nil),            // 58: pb.IsSplitOrMergeEnabledResponse
-	(*NormalizeRequest)(nil),                         // 59: pb.NormalizeRequest
-	(*NormalizeResponse)(nil),                        // 60: pb.NormalizeResponse
-	(*SetNormalizerRunningRequest)(nil),              // 61: pb.SetNormalizerRunningRequest
-	(*SetNormalizerRunningResponse)(nil),             // 62: pb.SetNormalizerRunningResponse
-	(*IsNormalizerEnabledRequest)(nil),               // 63: pb.IsNormalizerEnabledRequest
-	(*IsNormalizerEnabledResponse)(nil),              // 64: pb.IsNormalizerEnabledResponse
-	(*RunCatalogScanRequest)(nil),                    // 65: pb.RunCatalogScanRequest
-	(*RunCatalogScanResponse)(nil),                   // 66: pb.RunCatalogScanResponse
-	(*EnableCatalogJanitorRequest)(nil),              // 67: pb.EnableCatalogJanitorRequest
-	(*EnableCatalogJanitorResponse)(nil),             // 68: pb.EnableCatalogJanitorResponse
-	(*IsCatalogJanitorEnabledRequest)(nil),           // 69: pb.IsCatalogJanitorEnabledRequest
-	(*IsCatalogJanitorEnabledResponse)(nil),          // 70: pb.IsCatalogJanitorEnabledResponse
-	(*SnapshotRequest)(nil),                          // 71: pb.SnapshotRequest
-	(*SnapshotResponse)(nil),                         // 72: pb.SnapshotResponse
-	(*GetCompletedSnapshotsRequest)(nil),             // 73: pb.GetCompletedSnapshotsRequest
-	(*GetCompletedSnapshotsResponse)(nil),            // 74: pb.GetCompletedSnapshotsResponse
-	(*DeleteSnapshotRequest)(nil),                    // 75: pb.DeleteSnapshotRequest
-	(*DeleteSnapshotResponse)(nil),                   // 76: pb.DeleteSnapshotResponse
-	(*RestoreSnapshotRequest)(nil),                   // 77: pb.RestoreSnapshotRequest
-	(*RestoreSnapshotResponse)(nil),                  // 78: pb.RestoreSnapshotResponse
-	(*IsSnapshotDoneRequest)(nil),                    // 79: pb.IsSnapshotDoneRequest
-	(*IsSnapshotDoneResponse)(nil),                   // 80: pb.IsSnapshotDoneResponse
-	(*IsRestoreSnapshotDoneRequest)(nil),             // 81: pb.IsRestoreSnapshotDoneRequest
-	(*IsRestoreSnapshotDoneResponse)(nil),            // 82: pb.IsRestoreSnapshotDoneResponse
-	(*GetSchemaAlterStatusRequest)(nil),              // 83: pb.GetSchemaAlterStatusRequest
-	(*GetSchemaAlterStatusResponse)(nil),             // 84: pb.GetSchemaAlterStatusResponse
-	(*GetTableDescriptorsRequest)(nil),               // 85: pb.GetTableDescriptorsRequest
-	(*GetTableDescriptorsResponse)(nil),              // 86: pb.GetTableDescriptorsResponse
-	(*GetTableNamesRequest)(nil),                     // 87: pb.GetTableNamesRequest
-	(*GetTableNamesResponse)(nil),                    // 88: pb.GetTableNamesResponse
-	(*GetClusterStatusRequest)(nil),                  // 89: pb.GetClusterStatusRequest
-	(*GetClusterStatusResponse)(nil),                 // 90: pb.GetClusterStatusResponse
-	(*IsMasterRunningRequest)(nil),                   // 91: pb.IsMasterRunningRequest
-	(*IsMasterRunningResponse)(nil),                  // 92: pb.IsMasterRunningResponse
-	(*ExecProcedureRequest)(nil),                     // 93: pb.ExecProcedureRequest
-	(*ExecProcedureResponse)(nil),                    // 94: pb.ExecProcedureResponse
-	(*IsProcedureDoneRequest)(nil),                   // 95: pb.IsProcedureDoneRequest
-	(*IsProcedureDoneResponse)(nil),                  // 96: pb.IsProcedureDoneResponse
-	(*GetProcedureResultRequest)(nil),                // 97: pb.GetProcedureResultRequest
-	(*GetProcedureResultResponse)(nil),               // 98: pb.GetProcedureResultResponse
-	(*AbortProcedureRequest)(nil),                    // 99: pb.AbortProcedureRequest
-	(*AbortProcedureResponse)(nil),                   // 100: pb.AbortProcedureResponse
-	(*ListProceduresRequest)(nil),                    // 101: pb.ListProceduresRequest
-	(*ListProceduresResponse)(nil),                   // 102: pb.ListProceduresResponse
-	(*SetQuotaRequest)(nil),                          // 103: pb.SetQuotaRequest
-	(*SetQuotaResponse)(nil),                         // 104: pb.SetQuotaResponse
-	(*MajorCompactionTimestampRequest)(nil),          // 105: pb.MajorCompactionTimestampRequest
-	(*MajorCompactionTimestampForRegionRequest)(nil), // 106: pb.MajorCompactionTimestampForRegionRequest
-	(*MajorCompactionTimestampResponse)(nil),         // 107: pb.MajorCompactionTimestampResponse
-	(*SecurityCapabilitiesRequest)(nil),              // 108: pb.SecurityCapabilitiesRequest
-	(*SecurityCapabilitiesResponse)(nil),             // 109: pb.SecurityCapabilitiesResponse
-	(*TableName)(nil),                                // 110: pb.TableName
-	(*ColumnFamilySchema)(nil),                       // 111: pb.ColumnFamilySchema
-	(*RegionSpecifier)(nil),                          // 112: pb.RegionSpecifier
-	(*ServerName)(nil),                               // 113: pb.ServerName
-	(*TableSchema)(nil),                              // 114: pb.TableSchema
-	(*NamespaceDescriptor)(nil),                      // 115: pb.NamespaceDescriptor
-	(*SnapshotDescription)(nil),                      // 116: pb.SnapshotDescription
-	(*ClusterStatus)(nil),                            // 117: pb.ClusterStatus
-	(*ProcedureDescription)(nil),                     // 118: pb.ProcedureDescription
-	(*ForeignExceptionMessage)(nil),                  // 119: pb.ForeignExceptionMessage
-	(*Procedure)(nil),                                // 120: pb.Procedure
-	(*ThrottleRequest)(nil),                          // 121: pb.ThrottleRequest
-	(*CoprocessorServiceRequest)(nil),                // 122: pb.CoprocessorServiceRequest
-	(*CoprocessorServiceResponse)(nil),               // 123: pb.CoprocessorServiceResponse
+	(*MergeTableRegionsRequest)(nil),                 // 13: pb.MergeTableRegionsRequest
+	(*MergeTableRegionsResponse)(nil),                // 14: pb.MergeTableRegionsResponse
+	(*AssignRegionRequest)(nil),                      // 15: pb.AssignRegionRequest
+	(*AssignRegionResponse)(nil),                     // 16: pb.AssignRegionResponse
+	(*UnassignRegionRequest)(nil),                    // 17: pb.UnassignRegionRequest
+	(*UnassignRegionResponse)(nil),                   // 18: pb.UnassignRegionResponse
+	(*OfflineRegionRequest)(nil),                     // 19: pb.OfflineRegionRequest
+	(*OfflineRegionResponse)(nil),                    // 20: pb.OfflineRegionResponse
+	(*CreateTableRequest)(nil),                       // 21: pb.CreateTableRequest
+	(*CreateTableResponse)(nil),                      // 22: pb.CreateTableResponse
+	(*DeleteTableRequest)(nil),                       // 23: pb.DeleteTableRequest
+	(*DeleteTableResponse)(nil),                      // 24: pb.DeleteTableResponse
+	(*TruncateTableRequest)(nil),                     // 25: pb.TruncateTableRequest
+	(*TruncateTableResponse)(nil),                    // 26: pb.TruncateTableResponse
+	(*EnableTableRequest)(nil),                       // 27: pb.EnableTableRequest
+	(*EnableTableResponse)(nil),                      // 28: pb.EnableTableResponse
+	(*DisableTableRequest)(nil),                      // 29: pb.DisableTableRequest
+	(*DisableTableResponse)(nil),                     // 30: pb.DisableTableResponse
+	(*ModifyTableRequest)(nil),                       // 31: pb.ModifyTableRequest
+	(*ModifyTableResponse)(nil),                      // 32: pb.ModifyTableResponse
+	(*CreateNamespaceRequest)(nil),                   // 33: pb.CreateNamespaceRequest
+	(*CreateNamespaceResponse)(nil),                  // 34: pb.CreateNamespaceResponse
+	(*DeleteNamespaceRequest)(nil),                   // 35: pb.DeleteNamespaceRequest
+	(*DeleteNamespaceResponse)(nil),                  // 36: pb.DeleteNamespaceResponse
+	(*ModifyNamespaceRequest)(nil),                   // 37: pb.ModifyNamespaceRequest
+	(*ModifyNamespaceResponse)(nil),                  // 38: pb.ModifyNamespaceResponse
+	(*GetNamespaceDescriptorRequest)(nil),            // 39: pb.GetNamespaceDescriptorRequest
+	(*GetNamespaceDescriptorResponse)(nil),           // 40: pb.GetNamespaceDescriptorResponse
+	(*ListNamespaceDescriptorsRequest)(nil),          // 41: pb.ListNamespaceDescriptorsRequest
+	(*ListNamespaceDescriptorsResponse)(nil),         // 42: pb.ListNamespaceDescriptorsResponse
+	(*ListTableDescriptorsByNamespaceRequest)(nil),   // 43: pb.ListTableDescriptorsByNamespaceRequest
+	(*ListTableDescriptorsByNamespaceResponse)(nil),  // 44: pb.ListTableDescriptorsByNamespaceResponse
+	(*ListTableNamesByNamespaceRequest)(nil),         // 45: pb.ListTableNamesByNamespaceRequest
+	(*ListTableNamesByNamespaceResponse)(nil),        // 46: pb.ListTableNamesByNamespaceResponse
+	(*ShutdownRequest)(nil),                          // 47: pb.ShutdownRequest
+	(*ShutdownResponse)(nil),                         // 48: pb.ShutdownResponse
+	(*StopMasterRequest)(nil),                        // 49: pb.StopMasterRequest
+	(*StopMasterResponse)(nil),                       // 50: pb.StopMasterResponse
+	(*BalanceRequest)(nil),                           // 51: pb.BalanceRequest
+	(*BalanceResponse)(nil),                          // 52: pb.BalanceResponse
+	(*SetBalancerRunningRequest)(nil),                // 53: pb.SetBalancerRunningRequest
+	(*SetBalancerRunningResponse)(nil),               // 54: pb.SetBalancerRunningResponse
+	(*IsBalancerEnabledRequest)(nil),                 // 55: pb.IsBalancerEnabledRequest
+	(*IsBalancerEnabledResponse)(nil),                // 56: pb.IsBalancerEnabledResponse
+	(*SetSplitOrMergeEnabledRequest)(nil),            // 57: pb.SetSplitOrMergeEnabledRequest
+	(*SetSplitOrMergeEnabledResponse)(nil),           // 58: pb.SetSplitOrMergeEnabledResponse
+	(*IsSplitOrMergeEnabledRequest)(nil),             // 59: pb.IsSplitOrMergeEnabledRequest
+	(*IsSplitOrMergeEnabledResponse)(nil),            // 60: pb.IsSplitOrMergeEnabledResponse
+	(*NormalizeRequest)(nil),                         // 61: pb.NormalizeRequest
+	(*NormalizeResponse)(nil),                        // 62: pb.NormalizeResponse
+	(*SetNormalizerRunningRequest)(nil),              // 63: pb.SetNormalizerRunningRequest
+	(*SetNormalizerRunningResponse)(nil),             // 64: pb.SetNormalizerRunningResponse
+	(*IsNormalizerEnabledRequest)(nil),               // 65: pb.IsNormalizerEnabledRequest
+	(*IsNormalizerEnabledResponse)(nil),              // 66: pb.IsNormalizerEnabledResponse
+	(*RunCatalogScanRequest)(nil),                    // 67: pb.RunCatalogScanRequest
+	(*RunCatalogScanResponse)(nil),                   // 68: pb.RunCatalogScanResponse
+	(*EnableCatalogJanitorRequest)(nil),              // 69: pb.EnableCatalogJanitorRequest
+	(*EnableCatalogJanitorResponse)(nil),             // 70: pb.EnableCatalogJanitorResponse
+	(*IsCatalogJanitorEnabledRequest)(nil),           // 71: pb.IsCatalogJanitorEnabledRequest
+	(*IsCatalogJanitorEnabledResponse)(nil),          // 72: pb.IsCatalogJanitorEnabledResponse
+	(*SnapshotRequest)(nil),                          // 73: pb.SnapshotRequest
+	(*SnapshotResponse)(nil),                         // 74: pb.SnapshotResponse
+	(*GetCompletedSnapshotsRequest)(nil),             // 75: pb.GetCompletedSnapshotsRequest
+	(*GetCompletedSnapshotsResponse)(nil),            // 76: pb.GetCompletedSnapshotsResponse
+	(*DeleteSnapshotRequest)(nil),                    // 77: pb.DeleteSnapshotRequest
+	(*DeleteSnapshotResponse)(nil),                   // 78: pb.DeleteSnapshotResponse
+	(*RestoreSnapshotRequest)(nil),                   // 79: pb.RestoreSnapshotRequest
+	(*RestoreSnapshotResponse)(nil),                  // 80: pb.RestoreSnapshotResponse
+	(*IsSnapshotDoneRequest)(nil),                    // 81: pb.IsSnapshotDoneRequest
+	(*IsSnapshotDoneResponse)(nil),                   // 82: pb.IsSnapshotDoneResponse
+	(*IsRestoreSnapshotDoneRequest)(nil),             // 83: pb.IsRestoreSnapshotDoneRequest
+	(*IsRestoreSnapshotDoneResponse)(nil),            // 84: pb.IsRestoreSnapshotDoneResponse
+	(*GetSchemaAlterStatusRequest)(nil),              // 85: pb.GetSchemaAlterStatusRequest
+	(*GetSchemaAlterStatusResponse)(nil),             // 86: pb.GetSchemaAlterStatusResponse
+	(*GetTableDescriptorsRequest)(nil),               // 87: pb.GetTableDescriptorsRequest
+	(*GetTableDescriptorsResponse)(nil),              // 88: pb.GetTableDescriptorsResponse
+	(*GetTableNamesRequest)(nil),                     // 89: pb.GetTableNamesRequest
+	(*GetTableNamesResponse)(nil),                    // 90: pb.GetTableNamesResponse
+	(*GetClusterStatusRequest)(nil),                  // 91: pb.GetClusterStatusRequest
+	(*GetClusterStatusResponse)(nil),                 // 92: pb.GetClusterStatusResponse
+	(*IsMasterRunningRequest)(nil),                   // 93: pb.IsMasterRunningRequest
+	(*IsMasterRunningResponse)(nil),                  // 94: pb.IsMasterRunningResponse
+	(*ExecProcedureRequest)(nil),                     // 95: pb.ExecProcedureRequest
+	(*ExecProcedureResponse)(nil),                    // 96: pb.ExecProcedureResponse
+	(*IsProcedureDoneRequest)(nil),                   // 97: pb.IsProcedureDoneRequest
+	(*IsProcedureDoneResponse)(nil),                  // 98: pb.IsProcedureDoneResponse
+	(*GetProcedureResultRequest)(nil),                // 99: pb.GetProcedureResultRequest
+	(*GetProcedureResultResponse)(nil),               // 100: pb.GetProcedureResultResponse
+	(*AbortProcedureRequest)(nil),                    // 101: pb.AbortProcedureRequest
+	(*AbortProcedureResponse)(nil),                   // 102: pb.AbortProcedureResponse
+	(*ListProceduresRequest)(nil),                    // 103: pb.ListProceduresRequest
+	(*ListProceduresResponse)(nil),                   // 104: pb.ListProceduresResponse
+	(*SetQuotaRequest)(nil),                          // 105: pb.SetQuotaRequest
+	(*SetQuotaResponse)(nil),                         // 106: pb.SetQuotaResponse
+	(*MajorCompactionTimestampRequest)(nil),          // 107: pb.MajorCompactionTimestampRequest
+	(*MajorCompactionTimestampForRegionRequest)(nil), // 108: pb.MajorCompactionTimestampForRegionRequest
+	(*MajorCompactionTimestampResponse)(nil),         // 109: pb.MajorCompactionTimestampResponse
+	(*SecurityCapabilitiesRequest)(nil),              // 110: pb.SecurityCapabilitiesRequest
+	(*SecurityCapabilitiesResponse)(nil),             // 111: pb.SecurityCapabilitiesResponse
+	(*TableName)(nil),                                // 112: pb.TableName
+	(*ColumnFamilySchema)(nil),                       // 113: pb.ColumnFamilySchema
+	(*RegionSpecifier)(nil),                          // 114: pb.RegionSpecifier
+	(*ServerName)(nil),                               // 115: pb.ServerName
+	(*TableSchema)(nil),                              // 116: pb.TableSchema
+	(*NamespaceDescriptor)(nil),                      // 117: pb.NamespaceDescriptor
+	(*SnapshotDescription)(nil),                      // 118: pb.SnapshotDescription
+	(*ClusterStatus)(nil),                            // 119: pb.ClusterStatus
+	(*ProcedureDescription)(nil),                     // 120: pb.ProcedureDescription
+	(*ForeignExceptionMessage)(nil),                  // 121: pb.ForeignExceptionMessage
+	(*Procedure)(nil),                                // 122: pb.Procedure
+	(*ThrottleRequest)(nil),                          // 123: pb.ThrottleRequest
+	(*CoprocessorServiceRequest)(nil),                // 124: pb.CoprocessorServiceRequest
+	(*CoprocessorServiceResponse)(nil),               // 125: pb.CoprocessorServiceResponse
 }
 var file_Master_proto_depIdxs = []int32{
-	110, // 0: pb.AddColumnRequest.table_name:type_name -> pb.TableName
-	111, // 1: pb.AddColumnRequest.column_families:type_name -> pb.ColumnFamilySchema
-	110, // 2: pb.DeleteColumnRequest.table_name:type_name -> pb.TableName
-	110, // 3: pb.ModifyColumnRequest.table_name:type_name -> pb.TableName
-	111, // 4: pb.ModifyColumnRequest.column_families:type_name -> pb.ColumnFamilySchema
-	112, // 5: pb.MoveRegionRequest.region:type_name -> pb.RegionSpecifier
-	113, // 6: pb.MoveRegionRequest.dest_server_name:type_name -> pb.ServerName
-	112, // 7: pb.DispatchMergingRegionsRequest.region_a:type_name -> pb.RegionSpecifier
-	112, // 8: pb.DispatchMergingRegionsRequest.region_b:type_name -> pb.RegionSpecifier
-	112, // 9: pb.AssignRegionRequest.region:type_name -> pb.RegionSpecifier
-	112, // 10: pb.UnassignRegionRequest.region:type_name -> pb.RegionSpecifier
-	112, // 11: pb.OfflineRegionRequest.region:type_name -> pb.RegionSpecifier
-	114, // 12: pb.CreateTableRequest.table_schema:type_name -> pb.TableSchema
-	110, // 13: pb.DeleteTableRequest.table_name:type_name -> pb.TableName
-	110, // 14: pb.TruncateTableRequest.tableName:type_name -> pb.TableName
-	110, // 15: pb.EnableTableRequest.table_name:type_name -> pb.TableName
-	110, // 16: pb.DisableTableRequest.table_name:type_name -> pb.TableName
-	110, // 17: pb.ModifyTableRequest.table_name:type_name -> pb.TableName
-	114, // 18: pb.ModifyTableRequest.table_schema:type_name -> pb.TableSchema
-	115, // 19: pb.CreateNamespaceRequest.namespaceDescriptor:type_name -> pb.NamespaceDescriptor
-	115, // 20: pb.ModifyNamespaceRequest.namespaceDescriptor:type_name -> pb.NamespaceDescriptor
-	115, // 21: pb.GetNamespaceDescriptorResponse.namespaceDescriptor:type_name -> pb.NamespaceDescriptor
-	115, // 22: pb.ListNamespaceDescriptorsResponse.namespaceDescriptor:type_name -> pb.NamespaceDescriptor
-	114, // 23: pb.ListTableDescriptorsByNamespaceResponse.tableSchema:type_name -> pb.TableSchema
-	110, // 24: pb.ListTableNamesByNamespaceResponse.tableName:type_name -> pb.TableName
-	0,   // 25: pb.SetSplitOrMergeEnabledRequest.switch_types:type_name -> pb.MasterSwitchType
-	0,   // 26: pb.IsSplitOrMergeEnabledRequest.switch_type:type_name -> pb.MasterSwitchType
-	116, // 27: pb.SnapshotRequest.snapshot:type_name -> pb.SnapshotDescription
-	116, // 28: pb.GetCompletedSnapshotsResponse.snapshots:type_name -> pb.SnapshotDescription
-	116, // 29: pb.DeleteSnapshotRequest.snapshot:type_name -> pb.SnapshotDescription
-	116, // 30: pb.RestoreSnapshotRequest.snapshot:type_name -> pb.SnapshotDescription
-	116, // 31: pb.IsSnapshotDoneRequest.snapshot:type_name -> pb.SnapshotDescription
-	116, // 32: pb.IsSnapshotDoneResponse.snapshot:type_name -> pb.SnapshotDescription
-	116, // 33: pb.IsRestoreSnapshotDoneRequest.snapshot:type_name -> pb.SnapshotDescription
-	110, // 34: pb.GetSchemaAlterStatusRequest.table_name:type_name -> pb.TableName
-	110, // 35: pb.GetTableDescriptorsRequest.table_names:type_name -> pb.TableName
-	114, // 36: pb.GetTableDescriptorsResponse.table_schema:type_name -> pb.TableSchema
-	110, // 37: pb.GetTableNamesResponse.table_names:type_name -> pb.TableName
-	117, // 38: pb.GetClusterStatusResponse.cluster_status:type_name -> pb.ClusterStatus
-	118, // 39: pb.ExecProcedureRequest.procedure:type_name -> pb.ProcedureDescription
-	118, // 40: pb.IsProcedureDoneRequest.procedure:type_name -> pb.ProcedureDescription
-	118, // 41: pb.IsProcedureDoneResponse.snapshot:type_name -> pb.ProcedureDescription
-	1,   // 42: pb.GetProcedureResultResponse.state:type_name -> pb.GetProcedureResultResponse.State
-	119, // 43: pb.GetProcedureResultResponse.exception:type_name -> pb.ForeignExceptionMessage
-	120, // 44: pb.ListProceduresResponse.procedure:type_name -> pb.Procedure
-	110, // 45: pb.SetQuotaRequest.table_name:type_name -> pb.TableName
-	121, // 46: pb.SetQuotaRequest.throttle:type_name -> pb.ThrottleRequest
-	110, // 47: pb.MajorCompactionTimestampRequest.table_name:type_name -> pb.TableName
-	112, // 48: pb.MajorCompactionTimestampForRegionRequest.region:type_name -> pb.RegionSpecifier
-	2,   // 49: pb.SecurityCapabilitiesResponse.capabilities:type_name -> pb.SecurityCapabilitiesResponse.Capability
-	83,  // 50: pb.MasterService.GetSchemaAlterStatus:input_type -> pb.GetSchemaAlterStatusRequest
-	85,  // 51: pb.MasterService.GetTableDescriptors:input_type -> pb.GetTableDescriptorsRequest
-	87,  // 52: pb.MasterService.GetTableNames:input_type -> pb.GetTableNamesRequest
-	89,  // 53: pb.MasterService.GetClusterStatus:input_type -> pb.GetClusterStatusRequest
-	91,  // 54: pb.MasterService.IsMasterRunning:input_type -> pb.IsMasterRunningRequest
-	3,   // 55: pb.MasterService.AddColumn:input_type -> pb.AddColumnRequest
-	5,   // 56: pb.MasterService.DeleteColumn:input_type -> pb.DeleteColumnRequest
-	7,   // 57: pb.MasterService.ModifyColumn:input_type -> pb.ModifyColumnRequest
-	9,   // 58: pb.MasterService.MoveRegion:input_type -> pb.MoveRegionRequest
-	11,  // 59: pb.MasterService.DispatchMergingRegions:input_type -> pb.DispatchMergingRegionsRequest
-	13,  // 60: pb.MasterService.AssignRegion:input_type -> pb.AssignRegionRequest
-	15,  // 61: pb.MasterService.UnassignRegion:input_type -> pb.UnassignRegionRequest
-	17,  // 62: pb.MasterService.OfflineRegion:input_type -> pb.OfflineRegionRequest
-	21,  // 63: pb.MasterService.DeleteTable:input_type -> pb.DeleteTableRequest
-	23,  // 64: pb.MasterService.truncateTable:input_type -> pb.TruncateTableRequest
-	25,  // 65: pb.MasterService.EnableTable:input_type -> pb.EnableTableRequest
-	27,  // 66: pb.MasterService.DisableTable:input_type -> pb.DisableTableRequest
-	29,  // 67: pb.MasterService.ModifyTable:input_type -> pb.ModifyTableRequest
-	19,  // 68: pb.MasterService.CreateTable:input_type -> pb.CreateTableRequest
-	45,  // 69: pb.MasterService.Shutdown:input_type -> pb.ShutdownRequest
-	47,  // 70: pb.MasterService.StopMaster:input_type -> pb.StopMasterRequest
-	49,  // 71: pb.MasterService.Balance:input_type -> pb.BalanceRequest
-	51,  // 72: pb.MasterService.SetBalancerRunning:input_type -> pb.SetBalancerRunningRequest
-	53,  // 73: pb.MasterService.IsBalancerEnabled:input_type -> pb.IsBalancerEnabledRequest
-	55,  // 74: pb.MasterService.SetSplitOrMergeEnabled:input_type -> pb.SetSplitOrMergeEnabledRequest
-	57,  // 75: pb.MasterService.IsSplitOrMergeEnabled:input_type -> pb.IsSplitOrMergeEnabledRequest
-	59,  // 76: pb.MasterService.Normalize:input_type -> pb.NormalizeRequest
-	61,  // 77: pb.MasterService.SetNormalizerRunning:input_type -> pb.SetNormalizerRunningRequest
-	63,  // 78: pb.MasterService.IsNormalizerEnabled:input_type -> pb.IsNormalizerEnabledRequest
-	65,  // 79: pb.MasterService.RunCatalogScan:input_type -> pb.RunCatalogScanRequest
-	67,  // 80: pb.MasterService.EnableCatalogJanitor:input_type -> pb.EnableCatalogJanitorRequest
-	69,  // 81: pb.MasterService.IsCatalogJanitorEnabled:input_type -> pb.IsCatalogJanitorEnabledRequest
-	122, // 82: pb.MasterService.ExecMasterService:input_type -> pb.CoprocessorServiceRequest
-	71,  // 83: pb.MasterService.Snapshot:input_type -> pb.SnapshotRequest
-	73,  // 84: pb.MasterService.GetCompletedSnapshots:input_type -> pb.GetCompletedSnapshotsRequest
-	75,  // 85: pb.MasterService.DeleteSnapshot:input_type -> pb.DeleteSnapshotRequest
-	79,  // 86: pb.MasterService.IsSnapshotDone:input_type -> pb.IsSnapshotDoneRequest
-	77,  // 87: pb.MasterService.RestoreSnapshot:input_type -> pb.RestoreSnapshotRequest
-	81,  // 88: pb.MasterService.IsRestoreSnapshotDone:input_type -> pb.IsRestoreSnapshotDoneRequest
-	93,  // 89: pb.MasterService.ExecProcedure:input_type -> pb.ExecProcedureRequest
-	93,  // 90: pb.MasterService.ExecProcedureWithRet:input_type -> pb.ExecProcedureRequest
-	95,  // 91: pb.MasterService.IsProcedureDone:input_type -> pb.IsProcedureDoneRequest
-	35,  // 92: pb.MasterService.ModifyNamespace:input_type -> pb.ModifyNamespaceRequest
-	31,  // 93: pb.MasterService.CreateNamespace:input_type -> pb.CreateNamespaceRequest
-	33,  // 94: pb.MasterService.DeleteNamespace:input_type -> pb.DeleteNamespaceRequest
-	37,  // 95: pb.MasterService.GetNamespaceDescriptor:input_type -> pb.GetNamespaceDescriptorRequest
-	39,  // 96: pb.MasterService.ListNamespaceDescriptors:input_type -> pb.ListNamespaceDescriptorsRequest
-	41,  // 97: pb.MasterService.ListTableDescriptorsByNamespace:input_type -> pb.ListTableDescriptorsByNamespaceRequest
-	43,  // 98: pb.MasterService.ListTableNamesByNamespace:input_type -> pb.ListTableNamesByNamespaceRequest
-	103, // 99: pb.MasterService.SetQuota:input_type -> pb.SetQuotaRequest
-	105, // 100: pb.MasterService.getLastMajorCompactionTimestamp:input_type -> pb.MajorCompactionTimestampRequest
-	106, // 101: pb.MasterService.getLastMajorCompactionTimestampForRegion:input_type -> pb.MajorCompactionTimestampForRegionRequest
-	97,  // 102: pb.MasterService.getProcedureResult:input_type -> pb.GetProcedureResultRequest
-	108, // 103: pb.MasterService.getSecurityCapabilities:input_type -> pb.SecurityCapabilitiesRequest
-	99,  // 104: pb.MasterService.AbortProcedure:input_type -> pb.AbortProcedureRequest
-	101, // 105: pb.MasterService.ListProcedures:input_type -> pb.ListProceduresRequest
-	84,  // 106: pb.MasterService.GetSchemaAlterStatus:output_type -> pb.GetSchemaAlterStatusResponse
-	86,  // 107: pb.MasterService.GetTableDescriptors:output_type -> pb.GetTableDescriptorsResponse
-	88,  // 108: pb.MasterService.GetTableNames:output_type -> pb.GetTableNamesResponse
-	90,  // 109: pb.MasterService.GetClusterStatus:output_type -> pb.GetClusterStatusResponse
-	92,  // 110: pb.MasterService.IsMasterRunning:output_type -> pb.IsMasterRunningResponse
-	4,   // 111: pb.MasterService.AddColumn:output_type -> pb.AddColumnResponse
-	6,   // 112: pb.MasterService.DeleteColumn:output_type -> pb.DeleteColumnResponse
-	8,   // 113: pb.MasterService.ModifyColumn:output_type -> pb.ModifyColumnResponse
-	10,  // 114: pb.MasterService.MoveRegion:output_type -> pb.MoveRegionResponse
-	12,  // 115: pb.MasterService.DispatchMergingRegions:output_type -> pb.DispatchMergingRegionsResponse
-	14,  // 116: pb.MasterService.AssignRegion:output_type -> pb.AssignRegionResponse
-	16,  // 117: pb.MasterService.UnassignRegion:output_type -> pb.UnassignRegionResponse
-	18,  // 118: pb.MasterService.OfflineRegion:output_type -> pb.OfflineRegionResponse
-	22,  // 119: pb.MasterService.DeleteTable:output_type -> pb.DeleteTableResponse
-	24,  // 120: pb.MasterService.truncateTable:output_type -> pb.TruncateTableResponse
-	26,  // 121: pb.MasterService.EnableTable:output_type -> pb.EnableTableResponse
-	28,  // 122: pb.MasterService.DisableTable:output_type -> pb.DisableTableResponse
-	30,  // 123: pb.MasterService.ModifyTable:output_type -> pb.ModifyTableResponse
-	20,  // 124: pb.MasterService.CreateTable:output_type -> pb.CreateTableResponse
-	46,  // 125: pb.MasterService.Shutdown:output_type -> pb.ShutdownResponse
-	48,  // 126: pb.MasterService.StopMaster:output_type -> pb.StopMasterResponse
-	50,  // 127: pb.MasterService.Balance:output_type -> pb.BalanceResponse
-	52,  // 128: pb.MasterService.SetBalancerRunning:output_type -> pb.SetBalancerRunningResponse
-	54,  // 129: pb.MasterService.IsBalancerEnabled:output_type -> pb.IsBalancerEnabledResponse
-	56,  // 130: pb.MasterService.SetSplitOrMergeEnabled:output_type -> pb.SetSplitOrMergeEnabledResponse
-	58,  // 131: pb.MasterService.IsSplitOrMergeEnabled:output_type -> pb.IsSplitOrMergeEnabledResponse
-	60,  // 132: pb.MasterService.Normalize:output_type -> pb.NormalizeResponse
-	62,  // 133: pb.MasterService.SetNormalizerRunning:output_type -> pb.SetNormalizerRunningResponse
-	64,  // 134: pb.MasterService.IsNormalizerEnabled:output_type -> pb.IsNormalizerEnabledResponse
-	66,  // 135: pb.MasterService.RunCatalogScan:output_type -> pb.RunCatalogScanResponse
-	68,  // 136: pb.MasterService.EnableCatalogJanitor:output_type -> pb.EnableCatalogJanitorResponse
-	70,  // 137: pb.MasterService.IsCatalogJanitorEnabled:output_type -> pb.IsCatalogJanitorEnabledResponse
-	123, // 138: pb.MasterService.ExecMasterService:output_type -> pb.CoprocessorServiceResponse
-	72,  // 139: pb.MasterService.Snapshot:output_type -> pb.SnapshotResponse
-	74,  // 140: pb.MasterService.GetCompletedSnapshots:output_type -> pb.GetCompletedSnapshotsResponse
-	76,  // 141: pb.MasterService.DeleteSnapshot:output_type -> pb.DeleteSnapshotResponse
-	80,  // 142: pb.MasterService.IsSnapshotDone:output_type -> pb.IsSnapshotDoneResponse
-	78,  // 143: pb.MasterService.RestoreSnapshot:output_type -> pb.RestoreSnapshotResponse
-	82,  // 144: pb.MasterService.IsRestoreSnapshotDone:output_type -> pb.IsRestoreSnapshotDoneResponse
-	94,  // 145: pb.MasterService.ExecProcedure:output_type -> pb.ExecProcedureResponse
-	94,  // 146: pb.MasterService.ExecProcedureWithRet:output_type -> pb.ExecProcedureResponse
-	96,  // 147: pb.MasterService.IsProcedureDone:output_type -> pb.IsProcedureDoneResponse
-	36,  // 148: pb.MasterService.ModifyNamespace:output_type -> pb.ModifyNamespaceResponse
-	32,  // 149: pb.MasterService.CreateNamespace:output_type -> pb.CreateNamespaceResponse
-	34,  // 150: pb.MasterService.DeleteNamespace:output_type -> pb.DeleteNamespaceResponse
-	38,  // 151: pb.MasterService.GetNamespaceDescriptor:output_type -> pb.GetNamespaceDescriptorResponse
-	40,  // 152: pb.MasterService.ListNamespaceDescriptors:output_type -> pb.ListNamespaceDescriptorsResponse
-	42,  // 153: pb.MasterService.ListTableDescriptorsByNamespace:output_type -> pb.ListTableDescriptorsByNamespaceResponse
-	44,  // 154: pb.MasterService.ListTableNamesByNamespace:output_type -> pb.ListTableNamesByNamespaceResponse
-	104, // 155: pb.MasterService.SetQuota:output_type -> pb.SetQuotaResponse
-	107, // 156: pb.MasterService.getLastMajorCompactionTimestamp:output_type -> pb.MajorCompactionTimestampResponse
-	107, // 157: pb.MasterService.getLastMajorCompactionTimestampForRegion:output_type -> pb.MajorCompactionTimestampResponse
-	98,  // 158: pb.MasterService.getProcedureResult:output_type -> pb.GetProcedureResultResponse
-	109, // 159: pb.MasterService.getSecurityCapabilities:output_type -> pb.SecurityCapabilitiesResponse
-	100, // 160: pb.MasterService.AbortProcedure:output_type -> pb.AbortProcedureResponse
-	102, // 161: pb.MasterService.ListProcedures:output_type -> pb.ListProceduresResponse
-	106, // [106:162] is the sub-list for method output_type
-	50,  // [50:106] is the sub-list for method input_type
-	50,  // [50:50] is the sub-list for extension type_name
-	50,  // [50:50] is the sub-list for extension extendee
-	0,   // [0:50] is the sub-list for field type_name
+	112, // 0: pb.AddColumnRequest.table_name:type_name -> pb.TableName
+	113, // 1: pb.AddColumnRequest.column_families:type_name -> pb.ColumnFamilySchema
+	112, // 2: pb.DeleteColumnRequest.table_name:type_name -> pb.TableName
+	112, // 3: pb.ModifyColumnRequest.table_name:type_name -> pb.TableName
+	113, // 4: pb.ModifyColumnRequest.column_families:type_name -> pb.ColumnFamilySchema
+	114, // 5: pb.MoveRegionRequest.region:type_name -> pb.RegionSpecifier
+	115, // 6: pb.MoveRegionRequest.dest_server_name:type_name -> pb.ServerName
+	114, // 7: pb.DispatchMergingRegionsRequest.region_a:type_name -> pb.RegionSpecifier
+	114, // 8: pb.DispatchMergingRegionsRequest.region_b:type_name -> pb.RegionSpecifier
+	114, // 9: pb.MergeTableRegionsRequest.region:type_name -> pb.RegionSpecifier
+	114, // 10: pb.AssignRegionRequest.region:type_name -> pb.RegionSpecifier
+	114, // 11: pb.UnassignRegionRequest.region:type_name -> pb.RegionSpecifier
+	114, // 12: pb.OfflineRegionRequest.region:type_name -> pb.RegionSpecifier
+	116, // 13: pb.CreateTableRequest.table_schema:type_name -> pb.TableSchema
+	112, // 14: pb.DeleteTableRequest.table_name:type_name -> pb.TableName
+	112, // 15: pb.TruncateTableRequest.tableName:type_name -> pb.TableName
+	112, // 16: pb.EnableTableRequest.table_name:type_name -> pb.TableName
+	112, // 17: pb.DisableTableRequest.table_name:type_name -> pb.TableName
+	112, // 18: pb.ModifyTableRequest.table_name:type_name -> pb.TableName
+	116, // 19: pb.ModifyTableRequest.table_schema:type_name -> pb.TableSchema
+	117, // 20: pb.CreateNamespaceRequest.namespaceDescriptor:type_name -> pb.NamespaceDescriptor
+	117, // 21: pb.ModifyNamespaceRequest.namespaceDescriptor:type_name -> pb.NamespaceDescriptor
+	117, // 22: pb.GetNamespaceDescriptorResponse.namespaceDescriptor:type_name -> pb.NamespaceDescriptor
+	117, // 23: pb.ListNamespaceDescriptorsResponse.namespaceDescriptor:type_name -> pb.NamespaceDescriptor
+	116, // 24: pb.ListTableDescriptorsByNamespaceResponse.tableSchema:type_name -> pb.TableSchema
+	112, // 25: pb.ListTableNamesByNamespaceResponse.tableName:type_name -> pb.TableName
+	0,   // 26: pb.SetSplitOrMergeEnabledRequest.switch_types:type_name -> pb.MasterSwitchType
+	0,   // 27: pb.IsSplitOrMergeEnabledRequest.switch_type:type_name -> pb.MasterSwitchType
+	118, // 28: pb.SnapshotRequest.snapshot:type_name -> pb.SnapshotDescription
+	118, // 29: pb.GetCompletedSnapshotsResponse.snapshots:type_name -> pb.SnapshotDescription
+	118, // 30: pb.DeleteSnapshotRequest.snapshot:type_name -> pb.SnapshotDescription
+	118, // 31: pb.RestoreSnapshotRequest.snapshot:type_name -> pb.SnapshotDescription
+	118, // 32: pb.IsSnapshotDoneRequest.snapshot:type_name -> pb.SnapshotDescription
+	118, // 33: pb.IsSnapshotDoneResponse.snapshot:type_name -> pb.SnapshotDescription
+	118, // 34: pb.IsRestoreSnapshotDoneRequest.snapshot:type_name -> pb.SnapshotDescription
+	112, // 35: pb.GetSchemaAlterStatusRequest.table_name:type_name -> pb.TableName
+	112, // 36: pb.GetTableDescriptorsRequest.table_names:type_name -> pb.TableName
+	116, // 37: pb.GetTableDescriptorsResponse.table_schema:type_name -> pb.TableSchema
+	112, // 38: pb.GetTableNamesResponse.table_names:type_name -> pb.TableName
+	119, // 39: pb.GetClusterStatusResponse.cluster_status:type_name -> pb.ClusterStatus
+	120, // 40: pb.ExecProcedureRequest.procedure:type_name -> pb.ProcedureDescription
+	120, // 41: pb.IsProcedureDoneRequest.procedure:type_name -> pb.ProcedureDescription
+	120, // 42: pb.IsProcedureDoneResponse.snapshot:type_name -> pb.ProcedureDescription
+	1,   // 43: pb.GetProcedureResultResponse.state:type_name -> pb.GetProcedureResultResponse.State
+	121, // 44: pb.GetProcedureResultResponse.exception:type_name -> pb.ForeignExceptionMessage
+	122, // 45: pb.ListProceduresResponse.procedure:type_name -> pb.Procedure
+	112, // 46: pb.SetQuotaRequest.table_name:type_name -> pb.TableName
+	123, // 47: pb.SetQuotaRequest.throttle:type_name -> pb.ThrottleRequest
+	112, // 48: pb.MajorCompactionTimestampRequest.table_name:type_name -> pb.TableName
+	114, // 49: pb.MajorCompactionTimestampForRegionRequest.region:type_name -> pb.RegionSpecifier
+	2,   // 50: pb.SecurityCapabilitiesResponse.capabilities:type_name -> pb.SecurityCapabilitiesResponse.Capability
+	85,  // 51: pb.MasterService.GetSchemaAlterStatus:input_type -> pb.GetSchemaAlterStatusRequest
+	87,  // 52: pb.MasterService.GetTableDescriptors:input_type -> pb.GetTableDescriptorsRequest
+	89,  // 53: pb.MasterService.GetTableNames:input_type -> pb.GetTableNamesRequest
+	91,  // 54: pb.MasterService.GetClusterStatus:input_type -> pb.GetClusterStatusRequest
+	93,  // 55: pb.MasterService.IsMasterRunning:input_type -> pb.IsMasterRunningRequest
+	3,   // 56: pb.MasterService.AddColumn:input_type -> pb.AddColumnRequest
+	5,   // 57: pb.MasterService.DeleteColumn:input_type -> pb.DeleteColumnRequest
+	7,   // 58: pb.MasterService.ModifyColumn:input_type -> pb.ModifyColumnRequest
+	9,   // 59: pb.MasterService.MoveRegion:input_type -> pb.MoveRegionRequest
+	11,  // 60: pb.MasterService.DispatchMergingRegions:input_type -> pb.DispatchMergingRegionsRequest
+	13,  // 61: pb.MasterService.MergeTableRegions:input_type -> pb.MergeTableRegionsRequest
+	15,  // 62: pb.MasterService.AssignRegion:input_type -> pb.AssignRegionRequest
+	17,  // 63: pb.MasterService.UnassignRegion:input_type -> pb.UnassignRegionRequest
+	19,  // 64: pb.MasterService.OfflineRegion:input_type -> pb.OfflineRegionRequest
+	23,  // 65: pb.MasterService.DeleteTable:input_type -> pb.DeleteTableRequest
+	25,  // 66: pb.MasterService.truncateTable:input_type -> pb.TruncateTableRequest
+	27,  // 67: pb.MasterService.EnableTable:input_type -> pb.EnableTableRequest
+	29,  // 68: pb.MasterService.DisableTable:input_type -> pb.DisableTableRequest
+	31,  // 69: pb.MasterService.ModifyTable:input_type -> pb.ModifyTableRequest
+	21,  // 70: pb.MasterService.CreateTable:input_type -> pb.CreateTableRequest
+	47,  // 71: pb.MasterService.Shutdown:input_type -> pb.ShutdownRequest
+	49,  // 72: pb.MasterService.StopMaster:input_type -> pb.StopMasterRequest
+	51,  // 73: pb.MasterService.Balance:input_type -> pb.BalanceRequest
+	53,  // 74: pb.MasterService.SetBalancerRunning:input_type -> pb.SetBalancerRunningRequest
+	55,  // 75: pb.MasterService.IsBalancerEnabled:input_type -> pb.IsBalancerEnabledRequest
+	57,  // 76: pb.MasterService.SetSplitOrMergeEnabled:input_type -> pb.SetSplitOrMergeEnabledRequest
+	59,  // 77: pb.MasterService.IsSplitOrMergeEnabled:input_type -> pb.IsSplitOrMergeEnabledRequest
+	61,  // 78: pb.MasterService.Normalize:input_type -> pb.NormalizeRequest
+	63,  // 79: pb.MasterService.SetNormalizerRunning:input_type -> pb.SetNormalizerRunningRequest
+	65,  // 80: pb.MasterService.IsNormalizerEnabled:input_type -> pb.IsNormalizerEnabledRequest
+	67,  // 81: pb.MasterService.RunCatalogScan:input_type -> pb.RunCatalogScanRequest
+	69,  // 82: pb.MasterService.EnableCatalogJanitor:input_type -> pb.EnableCatalogJanitorRequest
+	71,  // 83: pb.MasterService.IsCatalogJanitorEnabled:input_type -> pb.IsCatalogJanitorEnabledRequest
+	124, // 84: pb.MasterService.ExecMasterService:input_type -> pb.CoprocessorServiceRequest
+	73,  // 85: pb.MasterService.Snapshot:input_type -> pb.SnapshotRequest
+	75,  // 86: pb.MasterService.GetCompletedSnapshots:input_type -> pb.GetCompletedSnapshotsRequest
+	77,  // 87: pb.MasterService.DeleteSnapshot:input_type -> pb.DeleteSnapshotRequest
+	81,  // 88: pb.MasterService.IsSnapshotDone:input_type -> pb.IsSnapshotDoneRequest
+	79,  // 89: pb.MasterService.RestoreSnapshot:input_type -> pb.RestoreSnapshotRequest
+	83,  // 90: pb.MasterService.IsRestoreSnapshotDone:input_type -> pb.IsRestoreSnapshotDoneRequest
+	95,  // 91: pb.MasterService.ExecProcedure:input_type -> pb.ExecProcedureRequest
+	95,  // 92: pb.MasterService.ExecProcedureWithRet:input_type -> pb.ExecProcedureRequest
+	97,  // 93: pb.MasterService.IsProcedureDone:input_type -> pb.IsProcedureDoneRequest
+	37,  // 94: pb.MasterService.ModifyNamespace:input_type -> pb.ModifyNamespaceRequest
+	33,  // 95: pb.MasterService.CreateNamespace:input_type -> pb.CreateNamespaceRequest
+	35,  // 96: pb.MasterService.DeleteNamespace:input_type -> pb.DeleteNamespaceRequest
+	39,  // 97: pb.MasterService.GetNamespaceDescriptor:input_type -> pb.GetNamespaceDescriptorRequest
+	41,  // 98: pb.MasterService.ListNamespaceDescriptors:input_type -> pb.ListNamespaceDescriptorsRequest
+	43,  // 99: pb.MasterService.ListTableDescriptorsByNamespace:input_type -> pb.ListTableDescriptorsByNamespaceRequest
+	45,  // 100: pb.MasterService.ListTableNamesByNamespace:input_type -> pb.ListTableNamesByNamespaceRequest
+	105, // 101: pb.MasterService.SetQuota:input_type -> pb.SetQuotaRequest
+	107, // 102: pb.MasterService.getLastMajorCompactionTimestamp:input_type -> pb.MajorCompactionTimestampRequest
+	108, // 103: pb.MasterService.getLastMajorCompactionTimestampForRegion:input_type -> pb.MajorCompactionTimestampForRegionRequest
+	99,  // 104: pb.MasterService.getProcedureResult:input_type -> pb.GetProcedureResultRequest
+	110, // 105: pb.MasterService.getSecurityCapabilities:input_type -> pb.SecurityCapabilitiesRequest
+	101, // 106: pb.MasterService.AbortProcedure:input_type -> pb.AbortProcedureRequest
+	103, // 107: pb.MasterService.ListProcedures:input_type -> pb.ListProceduresRequest
+	86,  // 108: pb.MasterService.GetSchemaAlterStatus:output_type -> pb.GetSchemaAlterStatusResponse
+	88,  // 109: pb.MasterService.GetTableDescriptors:output_type -> pb.GetTableDescriptorsResponse
+	90,  // 110: pb.MasterService.GetTableNames:output_type -> pb.GetTableNamesResponse
+	92,  // 111: pb.MasterService.GetClusterStatus:output_type -> pb.GetClusterStatusResponse
+	94,  // 112: pb.MasterService.IsMasterRunning:output_type -> pb.IsMasterRunningResponse
+	4,   // 113: pb.MasterService.AddColumn:output_type -> pb.AddColumnResponse
+	6,   // 114: pb.MasterService.DeleteColumn:output_type -> pb.DeleteColumnResponse
+	8,   // 115: pb.MasterService.ModifyColumn:output_type -> pb.ModifyColumnResponse
+	10,  // 116: pb.MasterService.MoveRegion:output_type -> pb.MoveRegionResponse
+	12,  // 117: pb.MasterService.DispatchMergingRegions:output_type -> pb.DispatchMergingRegionsResponse
+	14,  // 118: pb.MasterService.MergeTableRegions:output_type -> pb.MergeTableRegionsResponse
+	16,  // 119: pb.MasterService.AssignRegion:output_type -> pb.AssignRegionResponse
+	18,  // 120: pb.MasterService.UnassignRegion:output_type -> pb.UnassignRegionResponse
+	20,  // 121: pb.MasterService.OfflineRegion:output_type -> pb.OfflineRegionResponse
+	24,  // 122: pb.MasterService.DeleteTable:output_type -> pb.DeleteTableResponse
+	26,  // 123: pb.MasterService.truncateTable:output_type -> pb.TruncateTableResponse
+	28,  // 124: pb.MasterService.EnableTable:output_type -> pb.EnableTableResponse
+	30,  // 125: pb.MasterService.DisableTable:output_type -> pb.DisableTableResponse
+	32,  // 126: pb.MasterService.ModifyTable:output_type -> pb.ModifyTableResponse
+	22,  // 127: pb.MasterService.CreateTable:output_type -> pb.CreateTableResponse
+	48,  // 128: pb.MasterService.Shutdown:output_type -> pb.ShutdownResponse
+	50,  // 129: pb.MasterService.StopMaster:output_type -> pb.StopMasterResponse
+	52,  // 130: pb.MasterService.Balance:output_type -> pb.BalanceResponse
+	54,  // 131: pb.MasterService.SetBalancerRunning:output_type -> pb.SetBalancerRunningResponse
+	56,  // 132: pb.MasterService.IsBalancerEnabled:output_type -> pb.IsBalancerEnabledResponse
+	58,  // 133: pb.MasterService.SetSplitOrMergeEnabled:output_type -> pb.SetSplitOrMergeEnabledResponse
+	60,  // 134: pb.MasterService.IsSplitOrMergeEnabled:output_type -> pb.IsSplitOrMergeEnabledResponse
+	62,  // 135: pb.MasterService.Normalize:output_type -> pb.NormalizeResponse
+	64,  // 136: pb.MasterService.SetNormalizerRunning:output_type -> pb.SetNormalizerRunningResponse
+	66,  // 137: pb.MasterService.IsNormalizerEnabled:output_type -> pb.IsNormalizerEnabledResponse
+	68,  // 138: pb.MasterService.RunCatalogScan:output_type -> pb.RunCatalogScanResponse
+	70,  // 139: pb.MasterService.EnableCatalogJanitor:output_type -> pb.EnableCatalogJanitorResponse
+	72,  // 140: pb.MasterService.IsCatalogJanitorEnabled:output_type -> pb.IsCatalogJanitorEnabledResponse
+	125, // 141: pb.MasterService.ExecMasterService:output_type -> pb.CoprocessorServiceResponse
+	74,  // 142: pb.MasterService.Snapshot:output_type -> pb.SnapshotResponse
+	76,  // 143: pb.MasterService.GetCompletedSnapshots:output_type -> pb.GetCompletedSnapshotsResponse
+	78,  // 144: pb.MasterService.DeleteSnapshot:output_type -> pb.DeleteSnapshotResponse
+	82,  // 145: pb.MasterService.IsSnapshotDone:output_type -> pb.IsSnapshotDoneResponse
+	80,  // 146: pb.MasterService.RestoreSnapshot:output_type -> pb.RestoreSnapshotResponse
+	84,  // 147: pb.MasterService.IsRestoreSnapshotDone:output_type -> pb.IsRestoreSnapshotDoneResponse
+	96,  // 148: pb.MasterService.ExecProcedure:output_type -> pb.ExecProcedureResponse
+	96,  // 149: pb.MasterService.ExecProcedureWithRet:output_type -> pb.ExecProcedureResponse
+	98,  // 150: pb.MasterService.IsProcedureDone:output_type -> pb.IsProcedureDoneResponse
+	38,  // 151: pb.MasterService.ModifyNamespace:output_type -> pb.ModifyNamespaceResponse
+	34,  // 152: pb.MasterService.CreateNamespace:output_type -> pb.CreateNamespaceResponse
+	36,  // 153: pb.MasterService.DeleteNamespace:output_type -> pb.DeleteNamespaceResponse
+	40,  // 154: pb.MasterService.GetNamespaceDescriptor:output_type -> pb.GetNamespaceDescriptorResponse
+	42,  // 155: pb.MasterService.ListNamespaceDescriptors:output_type -> pb.ListNamespaceDescriptorsResponse
+	44,  // 156: pb.MasterService.ListTableDescriptorsByNamespace:output_type -> pb.ListTableDescriptorsByNamespaceResponse
+	46,  // 157: pb.MasterService.ListTableNamesByNamespace:output_type -> pb.ListTableNamesByNamespaceResponse
+	106, // 158: pb.MasterService.SetQuota:output_type -> pb.SetQuotaResponse
+	109, // 159: pb.MasterService.getLastMajorCompactionTimestamp:output_type -> pb.MajorCompactionTimestampResponse
+	109, // 160: pb.MasterService.getLastMajorCompactionTimestampForRegion:output_type -> pb.MajorCompactionTimestampResponse
+	100, // 161: pb.MasterService.getProcedureResult:output_type -> pb.GetProcedureResultResponse
+	111, // 162: pb.MasterService.getSecurityCapabilities:output_type -> pb.SecurityCapabilitiesResponse
+	102, // 163: pb.MasterService.AbortProcedure:output_type -> pb.AbortProcedureResponse
+	104, // 164: pb.MasterService.ListProcedures:output_type -> pb.ListProceduresResponse
+	108, // [108:165] is the sub-list for method output_type
+	51,  // [51:108] is the sub-list for method input_type
+	51,  // [51:51] is the sub-list for extension type_name
+	51,  // [51:51] is the sub-list for extension extendee
+	0,   // [0:51] is the sub-list for field type_name
 }
 
 func init() { file_Master_proto_init() }
@@ -6815,7 +6966,7 @@ func file_Master_proto_init() {
 			}
 		}
 		file_Master_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AssignRegionRequest); i {
+			switch v := v.(*MergeTableRegionsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6827,7 +6978,7 @@ func file_Master_proto_init() {
 			}
 		}
 		file_Master_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AssignRegionResponse); i {
+			switch v := v.(*MergeTableRegionsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6839,7 +6990,7 @@ func file_Master_proto_init() {
 			}
 		}
 		file_Master_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UnassignRegionRequest); i {
+			switch v := v.(*AssignRegionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6851,7 +7002,7 @@ func file_Master_proto_init() {
 			}
 		}
 		file_Master_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UnassignRegionResponse); i {
+			switch v := v.(*AssignRegionResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6863,7 +7014,7 @@ func file_Master_proto_init() {
 			}
 		}
 		file_Master_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OfflineRegionRequest); i {
+			switch v := v.(*UnassignRegionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6875,7 +7026,7 @@ func file_Master_proto_init() {
 			}
 		}
 		file_Master_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OfflineRegionResponse); i {
+			switch v := v.(*UnassignRegionResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6887,7 +7038,7 @@ func file_Master_proto_init() {
 			}
 		}
 		file_Master_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateTableRequest); i {
+			switch v := v.(*OfflineRegionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6899,7 +7050,7 @@ func file_Master_proto_init() {
 			}
 		}
 		file_Master_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateTableResponse); i {
+			switch v := v.(*OfflineRegionResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6911,7 +7062,7 @@ func file_Master_proto_init() {
 			}
 		}
 		file_Master_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteTableRequest); i {
+			switch v := v.(*CreateTableRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6923,7 +7074,7 @@ func file_Master_proto_init() {
 			}
 		}
 		file_Master_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteTableResponse); i {
+			switch v := v.(*CreateTableResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6935,7 +7086,7 @@ func file_Master_proto_init() {
 			}
 		}
 		file_Master_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TruncateTableRequest); i {
+			switch v := v.(*DeleteTableRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6947,7 +7098,7 @@ func file_Master_proto_init() {
 			}
 		}
 		file_Master_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TruncateTableResponse); i {
+			switch v := v.(*DeleteTableResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6959,7 +7110,7 @@ func file_Master_proto_init() {
 			}
 		}
 		file_Master_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EnableTableRequest); i {
+			switch v := v.(*TruncateTableRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6971,7 +7122,7 @@ func file_Master_proto_init() {
 			}
 		}
 		file_Master_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EnableTableResponse); i {
+			switch v := v.(*TruncateTableResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6983,7 +7134,7 @@ func file_Master_proto_init() {
 			}
 		}
 		file_Master_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DisableTableRequest); i {
+			switch v := v.(*EnableTableRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6995,7 +7146,7 @@ func file_Master_proto_init() {
 			}
 		}
 		file_Master_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DisableTableResponse); i {
+			switch v := v.(*EnableTableResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7007,7 +7158,7 @@ func file_Master_proto_init() {
 			}
 		}
 		file_Master_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ModifyTableRequest); i {
+			switch v := v.(*DisableTableRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7019,7 +7170,7 @@ func file_Master_proto_init() {
 			}
 		}
 		file_Master_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ModifyTableResponse); i {
+			switch v := v.(*DisableTableResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7031,7 +7182,7 @@ func file_Master_proto_init() {
 			}
 		}
 		file_Master_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateNamespaceRequest); i {
+			switch v := v.(*ModifyTableRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7043,7 +7194,7 @@ func file_Master_proto_init() {
 			}
 		}
 		file_Master_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateNamespaceResponse); i {
+			switch v := v.(*ModifyTableResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7055,7 +7206,7 @@ func file_Master_proto_init() {
 			}
 		}
 		file_Master_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteNamespaceRequest); i {
+			switch v := v.(*CreateNamespaceRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7067,7 +7218,7 @@ func file_Master_proto_init() {
 			}
 		}
 		file_Master_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteNamespaceResponse); i {
+			switch v := v.(*CreateNamespaceResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7079,7 +7230,7 @@ func file_Master_proto_init() {
 			}
 		}
 		file_Master_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ModifyNamespaceRequest); i {
+			switch v := v.(*DeleteNamespaceRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7091,7 +7242,7 @@ func file_Master_proto_init() {
 			}
 		}
 		file_Master_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ModifyNamespaceResponse); i {
+			switch v := v.(*DeleteNamespaceResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7103,7 +7254,7 @@ func file_Master_proto_init() {
 			}
 		}
 		file_Master_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetNamespaceDescriptorRequest); i {
+			switch v := v.(*ModifyNamespaceRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7115,7 +7266,7 @@ func file_Master_proto_init() {
 			}
 		}
 		file_Master_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetNamespaceDescriptorResponse); i {
+			switch v := v.(*ModifyNamespaceResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7127,7 +7278,7 @@ func file_Master_proto_init() {
 			}
 		}
 		file_Master_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListNamespaceDescriptorsRequest); i {
+			switch v := v.(*GetNamespaceDescriptorRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7139,7 +7290,7 @@ func file_Master_proto_init() {
 			}
 		}
 		file_Master_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListNamespaceDescriptorsResponse); i {
+			switch v := v.(*GetNamespaceDescriptorResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7151,7 +7302,7 @@ func file_Master_proto_init() {
 			}
 		}
 		file_Master_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListTableDescriptorsByNamespaceRequest); i {
+			switch v := v.(*ListNamespaceDescriptorsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7163,7 +7314,7 @@ func file_Master_proto_init() {
 			}
 		}
 		file_Master_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListTableDescriptorsByNamespaceResponse); i {
+			switch v := v.(*ListNamespaceDescriptorsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7175,7 +7326,7 @@ func file_Master_proto_init() {
 			}
 		}
 		file_Master_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListTableNamesByNamespaceRequest); i {
+			switch v := v.(*ListTableDescriptorsByNamespaceRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7187,7 +7338,7 @@ func file_Master_proto_init() {
 			}
 		}
 		file_Master_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListTableNamesByNamespaceResponse); i {
+			switch v := v.(*ListTableDescriptorsByNamespaceResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7199,7 +7350,7 @@ func file_Master_proto_init() {
 			}
 		}
 		file_Master_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ShutdownRequest); i {
+			switch v := v.(*ListTableNamesByNamespaceRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7211,7 +7362,7 @@ func file_Master_proto_init() {
 			}
 		}
 		file_Master_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ShutdownResponse); i {
+			switch v := v.(*ListTableNamesByNamespaceResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7223,7 +7374,7 @@ func file_Master_proto_init() {
 			}
 		}
 		file_Master_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StopMasterRequest); i {
+			switch v := v.(*ShutdownRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7235,7 +7386,7 @@ func file_Master_proto_init() {
 			}
 		}
 		file_Master_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StopMasterResponse); i {
+			switch v := v.(*ShutdownResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7247,7 +7398,7 @@ func file_Master_proto_init() {
 			}
 		}
 		file_Master_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BalanceRequest); i {
+			switch v := v.(*StopMasterRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7259,7 +7410,7 @@ func file_Master_proto_init() {
 			}
 		}
 		file_Master_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BalanceResponse); i {
+			switch v := v.(*StopMasterResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7271,7 +7422,7 @@ func file_Master_proto_init() {
 			}
 		}
 		file_Master_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetBalancerRunningRequest); i {
+			switch v := v.(*BalanceRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7283,7 +7434,7 @@ func file_Master_proto_init() {
 			}
 		}
 		file_Master_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetBalancerRunningResponse); i {
+			switch v := v.(*BalanceResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7295,7 +7446,7 @@ func file_Master_proto_init() {
 			}
 		}
 		file_Master_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IsBalancerEnabledRequest); i {
+			switch v := v.(*SetBalancerRunningRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7307,7 +7458,7 @@ func file_Master_proto_init() {
 			}
 		}
 		file_Master_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IsBalancerEnabledResponse); i {
+			switch v := v.(*SetBalancerRunningResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7319,7 +7470,7 @@ func file_Master_proto_init() {
 			}
 		}
 		file_Master_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetSplitOrMergeEnabledRequest); i {
+			switch v := v.(*IsBalancerEnabledRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7331,7 +7482,7 @@ func file_Master_proto_init() {
 			}
 		}
 		file_Master_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetSplitOrMergeEnabledResponse); i {
+			switch v := v.(*IsBalancerEnabledResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7343,7 +7494,7 @@ func file_Master_proto_init() {
 			}
 		}
 		file_Master_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IsSplitOrMergeEnabledRequest); i {
+			switch v := v.(*SetSplitOrMergeEnabledRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7355,7 +7506,7 @@ func file_Master_proto_init() {
 			}
 		}
 		file_Master_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IsSplitOrMergeEnabledResponse); i {
+			switch v := v.(*SetSplitOrMergeEnabledResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7367,7 +7518,7 @@ func file_Master_proto_init() {
 			}
 		}
 		file_Master_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NormalizeRequest); i {
+			switch v := v.(*IsSplitOrMergeEnabledRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7379,7 +7530,7 @@ func file_Master_proto_init() {
 			}
 		}
 		file_Master_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NormalizeResponse); i {
+			switch v := v.(*IsSplitOrMergeEnabledResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7391,7 +7542,7 @@ func file_Master_proto_init() {
 			}
 		}
 		file_Master_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetNormalizerRunningRequest); i {
+			switch v := v.(*NormalizeRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7403,7 +7554,7 @@ func file_Master_proto_init() {
 			}
 		}
 		file_Master_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetNormalizerRunningResponse); i {
+			switch v := v.(*NormalizeResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7415,7 +7566,7 @@ func file_Master_proto_init() {
 			}
 		}
 		file_Master_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IsNormalizerEnabledRequest); i {
+			switch v := v.(*SetNormalizerRunningRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7427,7 +7578,7 @@ func file_Master_proto_init() {
 			}
 		}
 		file_Master_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IsNormalizerEnabledResponse); i {
+			switch v := v.(*SetNormalizerRunningResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7439,7 +7590,7 @@ func file_Master_proto_init() {
 			}
 		}
 		file_Master_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RunCatalogScanRequest); i {
+			switch v := v.(*IsNormalizerEnabledRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7451,7 +7602,7 @@ func file_Master_proto_init() {
 			}
 		}
 		file_Master_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RunCatalogScanResponse); i {
+			switch v := v.(*IsNormalizerEnabledResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7463,7 +7614,7 @@ func file_Master_proto_init() {
 			}
 		}
 		file_Master_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EnableCatalogJanitorRequest); i {
+			switch v := v.(*RunCatalogScanRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7475,7 +7626,7 @@ func file_Master_proto_init() {
 			}
 		}
 		file_Master_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EnableCatalogJanitorResponse); i {
+			switch v := v.(*RunCatalogScanResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7487,7 +7638,7 @@ func file_Master_proto_init() {
 			}
 		}
 		file_Master_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IsCatalogJanitorEnabledRequest); i {
+			switch v := v.(*EnableCatalogJanitorRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7499,7 +7650,7 @@ func file_Master_proto_init() {
 			}
 		}
 		file_Master_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IsCatalogJanitorEnabledResponse); i {
+			switch v := v.(*EnableCatalogJanitorResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7511,7 +7662,7 @@ func file_Master_proto_init() {
 			}
 		}
 		file_Master_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SnapshotRequest); i {
+			switch v := v.(*IsCatalogJanitorEnabledRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7523,7 +7674,7 @@ func file_Master_proto_init() {
 			}
 		}
 		file_Master_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SnapshotResponse); i {
+			switch v := v.(*IsCatalogJanitorEnabledResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7535,7 +7686,7 @@ func file_Master_proto_init() {
 			}
 		}
 		file_Master_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetCompletedSnapshotsRequest); i {
+			switch v := v.(*SnapshotRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7547,7 +7698,7 @@ func file_Master_proto_init() {
 			}
 		}
 		file_Master_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetCompletedSnapshotsResponse); i {
+			switch v := v.(*SnapshotResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7559,7 +7710,7 @@ func file_Master_proto_init() {
 			}
 		}
 		file_Master_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteSnapshotRequest); i {
+			switch v := v.(*GetCompletedSnapshotsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7571,7 +7722,7 @@ func file_Master_proto_init() {
 			}
 		}
 		file_Master_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteSnapshotResponse); i {
+			switch v := v.(*GetCompletedSnapshotsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7583,7 +7734,7 @@ func file_Master_proto_init() {
 			}
 		}
 		file_Master_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RestoreSnapshotRequest); i {
+			switch v := v.(*DeleteSnapshotRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7595,7 +7746,7 @@ func file_Master_proto_init() {
 			}
 		}
 		file_Master_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RestoreSnapshotResponse); i {
+			switch v := v.(*DeleteSnapshotResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7607,7 +7758,7 @@ func file_Master_proto_init() {
 			}
 		}
 		file_Master_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IsSnapshotDoneRequest); i {
+			switch v := v.(*RestoreSnapshotRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7619,7 +7770,7 @@ func file_Master_proto_init() {
 			}
 		}
 		file_Master_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IsSnapshotDoneResponse); i {
+			switch v := v.(*RestoreSnapshotResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7631,7 +7782,7 @@ func file_Master_proto_init() {
 			}
 		}
 		file_Master_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IsRestoreSnapshotDoneRequest); i {
+			switch v := v.(*IsSnapshotDoneRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7643,7 +7794,7 @@ func file_Master_proto_init() {
 			}
 		}
 		file_Master_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IsRestoreSnapshotDoneResponse); i {
+			switch v := v.(*IsSnapshotDoneResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7655,7 +7806,7 @@ func file_Master_proto_init() {
 			}
 		}
 		file_Master_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetSchemaAlterStatusRequest); i {
+			switch v := v.(*IsRestoreSnapshotDoneRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7667,7 +7818,7 @@ func file_Master_proto_init() {
 			}
 		}
 		file_Master_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetSchemaAlterStatusResponse); i {
+			switch v := v.(*IsRestoreSnapshotDoneResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7679,7 +7830,7 @@ func file_Master_proto_init() {
 			}
 		}
 		file_Master_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetTableDescriptorsRequest); i {
+			switch v := v.(*GetSchemaAlterStatusRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7691,7 +7842,7 @@ func file_Master_proto_init() {
 			}
 		}
 		file_Master_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetTableDescriptorsResponse); i {
+			switch v := v.(*GetSchemaAlterStatusResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7703,7 +7854,7 @@ func file_Master_proto_init() {
 			}
 		}
 		file_Master_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetTableNamesRequest); i {
+			switch v := v.(*GetTableDescriptorsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7715,7 +7866,7 @@ func file_Master_proto_init() {
 			}
 		}
 		file_Master_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetTableNamesResponse); i {
+			switch v := v.(*GetTableDescriptorsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7727,7 +7878,7 @@ func file_Master_proto_init() {
 			}
 		}
 		file_Master_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetClusterStatusRequest); i {
+			switch v := v.(*GetTableNamesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7739,7 +7890,7 @@ func file_Master_proto_init() {
 			}
 		}
 		file_Master_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetClusterStatusResponse); i {
+			switch v := v.(*GetTableNamesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7751,7 +7902,7 @@ func file_Master_proto_init() {
 			}
 		}
 		file_Master_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IsMasterRunningRequest); i {
+			switch v := v.(*GetClusterStatusRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7763,7 +7914,7 @@ func file_Master_proto_init() {
 			}
 		}
 		file_Master_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IsMasterRunningResponse); i {
+			switch v := v.(*GetClusterStatusResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7775,7 +7926,7 @@ func file_Master_proto_init() {
 			}
 		}
 		file_Master_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExecProcedureRequest); i {
+			switch v := v.(*IsMasterRunningRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7787,7 +7938,7 @@ func file_Master_proto_init() {
 			}
 		}
 		file_Master_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExecProcedureResponse); i {
+			switch v := v.(*IsMasterRunningResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7799,7 +7950,7 @@ func file_Master_proto_init() {
 			}
 		}
 		file_Master_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IsProcedureDoneRequest); i {
+			switch v := v.(*ExecProcedureRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7811,7 +7962,7 @@ func file_Master_proto_init() {
 			}
 		}
 		file_Master_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IsProcedureDoneResponse); i {
+			switch v := v.(*ExecProcedureResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7823,7 +7974,7 @@ func file_Master_proto_init() {
 			}
 		}
 		file_Master_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetProcedureResultRequest); i {
+			switch v := v.(*IsProcedureDoneRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7835,7 +7986,7 @@ func file_Master_proto_init() {
 			}
 		}
 		file_Master_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetProcedureResultResponse); i {
+			switch v := v.(*IsProcedureDoneResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7847,7 +7998,7 @@ func file_Master_proto_init() {
 			}
 		}
 		file_Master_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AbortProcedureRequest); i {
+			switch v := v.(*GetProcedureResultRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7859,7 +8010,7 @@ func file_Master_proto_init() {
 			}
 		}
 		file_Master_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AbortProcedureResponse); i {
+			switch v := v.(*GetProcedureResultResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7871,7 +8022,7 @@ func file_Master_proto_init() {
 			}
 		}
 		file_Master_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListProceduresRequest); i {
+			switch v := v.(*AbortProcedureRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7883,7 +8034,7 @@ func file_Master_proto_init() {
 			}
 		}
 		file_Master_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListProceduresResponse); i {
+			switch v := v.(*AbortProcedureResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7895,7 +8046,7 @@ func file_Master_proto_init() {
 			}
 		}
 		file_Master_proto_msgTypes[100].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetQuotaRequest); i {
+			switch v := v.(*ListProceduresRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7907,7 +8058,7 @@ func file_Master_proto_init() {
 			}
 		}
 		file_Master_proto_msgTypes[101].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetQuotaResponse); i {
+			switch v := v.(*ListProceduresResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7919,7 +8070,7 @@ func file_Master_proto_init() {
 			}
 		}
 		file_Master_proto_msgTypes[102].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MajorCompactionTimestampRequest); i {
+			switch v := v.(*SetQuotaRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7931,7 +8082,7 @@ func file_Master_proto_init() {
 			}
 		}
 		file_Master_proto_msgTypes[103].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MajorCompactionTimestampForRegionRequest); i {
+			switch v := v.(*SetQuotaResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7943,7 +8094,7 @@ func file_Master_proto_init() {
 			}
 		}
 		file_Master_proto_msgTypes[104].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MajorCompactionTimestampResponse); i {
+			switch v := v.(*MajorCompactionTimestampRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7955,7 +8106,7 @@ func file_Master_proto_init() {
 			}
 		}
 		file_Master_proto_msgTypes[105].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SecurityCapabilitiesRequest); i {
+			switch v := v.(*MajorCompactionTimestampForRegionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7967,6 +8118,30 @@ func file_Master_proto_init() {
 			}
 		}
 		file_Master_proto_msgTypes[106].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MajorCompactionTimestampResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_Master_proto_msgTypes[107].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SecurityCapabilitiesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_Master_proto_msgTypes[108].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SecurityCapabilitiesResponse); i {
 			case 0:
 				return &v.state
@@ -7985,7 +8160,7 @@ func file_Master_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_Master_proto_rawDesc,
 			NumEnums:      3,
-			NumMessages:   107,
+			NumMessages:   109,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
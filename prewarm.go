@@ -0,0 +1,196 @@
+// Copyright (C) 2026  The GoHBase Authors.  All rights reserved.
+// This file is part of GoHBase.
+// Use of this source code is governed by the Apache License 2.0
+// that can be found in the COPYING file.
+
+package gohbase
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+	"sync"
+
+	"github.com/baiweiguo/gohbase/hrpc"
+	"github.com/baiweiguo/gohbase/region"
+	log "github.com/sirupsen/logrus"
+)
+
+const defaultPrewarmConcurrency = 16
+
+// PrewarmConcurrency will return an option that sets how many RegionServers
+// PrewarmRegions connects to at once. The default is 16.
+func PrewarmConcurrency(n int) Option {
+	return func(c *client) {
+		if n > 0 {
+			c.prewarmConcurrency = n
+		}
+	}
+}
+
+// PrewarmRegions looks up the regions of table in a single scan of hbase:meta,
+// or with the resolver of the client, puts them in cache and connects to their
+// RegionServers, at most as many at once as set with PrewarmConcurrency option.
+func (c *client) PrewarmRegions(ctx context.Context,
+	table []byte) (hrpc.PrewarmSummary, error) {
+	locations, err := c.tableRegionLocations(ctx, table)
+	if err != nil {
+		return hrpc.PrewarmSummary{}, err
+	}
+
+	// the regions that replace cached ones are unavailable until their
+	// RegionServers are connected to, grouped by their address
+	regionsByAddr := make(map[string][]hrpc.RegionInfo)
+	for _, l := range locations {
+		l.Region.MarkUnavailable()
+		overlaps, replaced := c.regions.put(l.Region)
+		if !replaced {
+			// the same or younger region is already in cache
+			l.Region.MarkAvailable()
+			continue
+		}
+		for _, r := range overlaps {
+			c.clients.del(r)
+		}
+		regionsByAddr[l.Addr] = append(regionsByAddr[l.Addr], l.Region)
+	}
+
+	var (
+		m       sync.Mutex
+		wg      sync.WaitGroup
+		summary = hrpc.PrewarmSummary{Regions: len(locations)}
+		sem     = make(chan struct{}, c.prewarmConcurrency)
+	)
+	for addr, regions := range regionsByAddr {
+		sem <- struct{}{}
+		wg.Add(1)
+		go func(addr string, regions []hrpc.RegionInfo) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			err := c.prewarmRegionServer(ctx, addr, regions)
+			m.Lock()
+			defer m.Unlock()
+			if err != nil {
+				if summary.Failed == nil {
+					summary.Failed = make(map[string]error)
+				}
+				summary.Failed[addr] = err
+				return
+			}
+			summary.RegionServers++
+		}(addr, regions)
+	}
+	wg.Wait()
+
+	if len(summary.Failed) > 0 {
+		return summary, fmt.Errorf("failed to connect to %d of the %d RegionServers of %q",
+			len(summary.Failed), len(regionsByAddr), table)
+	}
+	return summary, nil
+}
+
+// prewarmRegionServer connects to the RegionServer at addr, if the client
+// isn't connected to it yet, and makes regions available with it as their
+// region client. They aren't probed like when they're established by rpcs, the
+// ones the RegionServer doesn't serve anymore are looked up again once used.
+// Regions are made available without a region client if the RegionServer can't
+// be connected to, so that rpcs establish them.
+func (c *client) prewarmRegionServer(ctx context.Context, addr string,
+	regions []hrpc.RegionInfo) error {
+	var created bool
+	newClient := func() hrpc.RegionClient {
+		created = true
+		return c.newRegionClientFn(addr, c.clientType, c.rpcQueueSize, c.flushInterval,
+			c.effectiveUser, c.regionReadTimeout, c.compressionCodec,
+			c.regionClientOptions...)
+	}
+	rc := c.clients.client(addr, newClient)
+	dialCtx, cancel := context.WithTimeout(ctx, c.regionLookupTimeout)
+	err := c.dial(dialCtx, rc)
+	cancel()
+	if err != nil {
+		if created {
+			c.clients.remove(rc)
+			rc.Close()
+		}
+		for _, reg := range regions {
+			reg.MarkAvailable()
+		}
+		return fmt.Errorf("failed to connect to RegionServer %s: %w", addr, err)
+	}
+	for _, reg := range regions {
+		c.clients.put(addr, reg, newClient)
+		reg.SetClient(rc)
+		reg.MarkAvailable()
+	}
+	return nil
+}
+
+// tableRegionLocations looks up the regions of table and the addresses of
+// their RegionServers in a single scan of hbase:meta, or with the resolver
+// of the client if it has one, without caching them. The regions of hbase:meta
+// rows that are offline or have no location are left out.
+func (c *client) tableRegionLocations(ctx context.Context,
+	table []byte) ([]hrpc.RegionLocation, error) {
+	if c.resolver != nil {
+		var locations []hrpc.RegionLocation
+		var key []byte
+		for {
+			reg, addr, err := c.lookupRegion(ctx, table, key)
+			if err != nil {
+				return nil, err
+			}
+			locations = append(locations, hrpc.RegionLocation{Region: reg, Addr: addr})
+			stop := reg.StopKey()
+			if len(stop) == 0 {
+				return locations, nil
+			}
+			if bytes.Compare(stop, key) <= 0 {
+				return nil, fmt.Errorf("%w: looked up table=%q key=%q got region=%s",
+					ErrStaleMeta, table, key, reg)
+			}
+			key = stop
+		}
+	}
+
+	// the rows of the regions of table start with "table,", and ',' + 1 is '-'
+	start := append(append([]byte(nil), table...), ',')
+	stop := append(append([]byte(nil), table...), '-')
+	rpc, err := hrpc.NewScanRange(ctx, metaTableName, start, stop,
+		hrpc.Families(infoFamily))
+	if err != nil {
+		return nil, err
+	}
+	scanner := c.Scan(rpc)
+	defer scanner.Close()
+	var locations []hrpc.RegionLocation
+	for {
+		resp, err := scanner.Next()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+		reg, addr, err := region.ParseRegionInfo(resp)
+		if err != nil {
+			log.WithFields(log.Fields{
+				"table": strconv.Quote(string(table)),
+				"err":   err,
+			}).Debug("skipping region of hbase:meta")
+			continue
+		}
+		if !bytes.Equal(table, fullyQualifiedTable(reg)) {
+			continue
+		}
+		locations = append(locations,
+			hrpc.RegionLocation{Region: reg, Addr: c.rewriteAddr(addr)})
+	}
+	if len(locations) == 0 {
+		return nil, TableNotFound
+	}
+	return locations, nil
+}
@@ -0,0 +1,161 @@
+// Copyright (C) 2026  The GoHBase Authors.  All rights reserved.
+// This file is part of GoHBase.
+// Use of this source code is governed by the Apache License 2.0
+// that can be found in the COPYING file.
+
+package gohbase
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/baiweiguo/gohbase/compression"
+	"github.com/baiweiguo/gohbase/hrpc"
+	"github.com/baiweiguo/gohbase/pb"
+	"github.com/baiweiguo/gohbase/region"
+	"github.com/baiweiguo/gohbase/test"
+	mockRegion "github.com/baiweiguo/gohbase/test/mock/region"
+	"github.com/golang/mock/gomock"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestPrewarmRegions(t *testing.T) {
+	ctrl := test.NewController(t)
+	defer ctrl.Finish()
+	c := newMockClient(nil)
+	c.prewarmConcurrency = 2
+
+	// 10 regions of table test at 5 regionservers, and a region of another table
+	metaRow := func(table string, i int, startKey, stopKey []byte) *pb.Result {
+		info, err := proto.Marshal(&pb.RegionInfo{
+			RegionId:  proto.Uint64(1434573235908),
+			TableName: &pb.TableName{Namespace: []byte("default"), Qualifier: []byte(table)},
+			StartKey:  startKey,
+			EndKey:    stopKey,
+			Offline:   proto.Bool(false),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		row := []byte(table + "," + string(startKey) +
+			",1434573235908.56f833d5569a27c7a43fbf547b4924a4.")
+		return &pb.Result{Cell: []*pb.Cell{{
+			Row:       row,
+			Family:    []byte("info"),
+			Qualifier: []byte("regioninfo"),
+			Value:     append([]byte("PBUF"), info...),
+		}, {
+			Row:       row,
+			Family:    []byte("info"),
+			Qualifier: []byte("server"),
+			Value:     []byte(fmt.Sprintf("regionserver:%d", i%5)),
+		}}}
+	}
+	var metaRows []*pb.Result
+	for i := 0; i < 10; i++ {
+		var startKey, stopKey []byte
+		if i > 0 {
+			startKey = []byte(fmt.Sprintf("%02d", i*5))
+		}
+		if i < 9 {
+			stopKey = []byte(fmt.Sprintf("%02d", (i+1)*5))
+		}
+		metaRows = append(metaRows, metaRow("test", i, startKey, stopKey))
+	}
+	metaRows = append(metaRows, metaRow("test2", 0, nil, nil))
+
+	var metaRPCs int32
+	metaClient := mockRegion.NewMockRegionClient(ctrl)
+	metaClient.EXPECT().Addr().Return("regionserver:meta").AnyTimes()
+	metaClient.EXPECT().String().Return("regionserver:meta").AnyTimes()
+	metaClient.EXPECT().QueueRPC(gomock.Any()).AnyTimes().Do(func(call hrpc.Call) {
+		atomic.AddInt32(&metaRPCs, 1)
+		scan := call.(*hrpc.Scan)
+		var results []*pb.Result
+		for _, row := range metaRows {
+			if bytes.Compare(row.Cell[0].Row, scan.StartRow()) >= 0 &&
+				bytes.Compare(row.Cell[0].Row, scan.StopRow()) < 0 {
+				results = append(results, row)
+			}
+		}
+		call.ResultChan() <- hrpc.RPCResult{Msg: &pb.ScanResponse{Results: results}}
+	})
+	c.clients.put("regionserver:meta", c.metaRegionInfo,
+		func() hrpc.RegionClient { return metaClient })
+	c.metaRegionInfo.SetClient(metaClient)
+
+	var (
+		m                   sync.Mutex
+		dialing, maxDialing int
+	)
+	c.newRegionClientFn = func(addr string, _ region.ClientType, _ int, _ time.Duration,
+		_ string, _ time.Duration, _ compression.Codec,
+		_ ...region.ClientOption) hrpc.RegionClient {
+		rc := mockRegion.NewMockRegionClient(ctrl)
+		rc.EXPECT().Addr().Return(addr).AnyTimes()
+		rc.EXPECT().String().Return(addr).AnyTimes()
+		rc.EXPECT().Close().AnyTimes()
+		rc.EXPECT().Dial(gomock.Any()).AnyTimes().DoAndReturn(func(context.Context) error {
+			m.Lock()
+			if dialing++; dialing > maxDialing {
+				maxDialing = dialing
+			}
+			m.Unlock()
+			time.Sleep(10 * time.Millisecond)
+			m.Lock()
+			dialing--
+			m.Unlock()
+			if addr == "regionserver:4" {
+				return errors.New("connection refused")
+			}
+			return nil
+		})
+		return rc
+	}
+
+	summary, err := c.PrewarmRegions(context.Background(), []byte("test"))
+	if err == nil {
+		t.Error("expected an error for the regionserver that couldn't be connected to")
+	}
+	if summary.Regions != 10 || summary.RegionServers != 4 || len(summary.Failed) != 1 ||
+		summary.Failed["regionserver:4"] == nil {
+		t.Errorf("unexpected summary %+v", summary)
+	}
+	if n := atomic.LoadInt32(&metaRPCs); n != 1 {
+		t.Errorf("expected regions to be looked up with 1 meta RPC, got %d", n)
+	}
+	if maxDialing > 2 {
+		t.Errorf("expected at most 2 regionservers connected to at once, got %d", maxDialing)
+	}
+	if n := c.regions.regions.Len(); n != 10 {
+		t.Errorf("expected 10 regions in cache, got %d", n)
+	}
+	for i := 0; i < 50; i++ {
+		key := []byte(fmt.Sprintf("%02d", i))
+		reg := c.getRegionFromCache([]byte("test"), key)
+		if reg == nil {
+			t.Fatalf("expected the region of key %q to be cached", key)
+		}
+		if reg.AvailabilityChan() != nil {
+			t.Errorf("expected region %s to be available", reg)
+		}
+		addr := fmt.Sprintf("regionserver:%d", i/5%5)
+		if addr == "regionserver:4" {
+			if reg.Client() != nil {
+				t.Errorf("expected region %s to have no client, got %s", reg, reg.Client())
+			}
+		} else if reg.Client() == nil || reg.Client().Addr() != addr {
+			t.Errorf("expected region %s to have client %s, got %v", reg, addr, reg.Client())
+		}
+	}
+
+	if _, err := c.PrewarmRegions(context.Background(), []byte("unknown")); err != TableNotFound {
+		t.Errorf("expected TableNotFound, got %v", err)
+	}
+}
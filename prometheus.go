@@ -22,7 +22,8 @@ var (
 			// (most requests have a 30s timeout by default at the Envoy level)
 			Buckets: prometheus.ExponentialBuckets(0.04, 2, 11),
 		},
-		[]string{"operation", "result"},
+		// label is the one set with hrpc.MetricLabel, if any
+		[]string{"operation", "result", "label"},
 	)
 
 	sendBatchSplitCount = promauto.NewHistogram(
@@ -34,4 +35,12 @@ var (
 			Buckets: prometheus.ExponentialBuckets(1, 2, 10),
 		},
 	)
+
+	clockSkewExceeded = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: "gohbase",
+			Name:      "clock_skew_exceeded_total",
+			Help:      "Number of responses with clock skew above the configured threshold",
+		},
+	)
 )
@@ -14,6 +14,8 @@ import (
 	"fmt"
 	"io"
 	"net"
+	"regexp"
+	"strconv"
 	"strings"
 	"sync"
 	"sync/atomic"
@@ -24,7 +26,6 @@ import (
 
 	"github.com/baiweiguo/gohbase/hrpc"
 	"github.com/baiweiguo/gohbase/pb"
-	"google.golang.org/protobuf/encoding/protowire"
 	"google.golang.org/protobuf/proto"
 )
 
@@ -37,6 +38,14 @@ type canDeserializeCellBlocks interface {
 	DeserializeCellBlocks(proto.Message, []byte) (uint32, error)
 }
 
+type canRetainBuffer interface {
+	// ZeroCopy returns true if cells of the response can be deserialized
+	// in place from the pooled buffer the response was read into.
+	ZeroCopy() bool
+	// SetBufferRelease hands over the function returning that buffer to the pool.
+	SetBufferRelease(func())
+}
+
 type canSerializeCellBlocks interface {
 	// SerializeCellBlocks serializes RPC into protobuf for metadata
 	// as well as cellblocks for payload.
@@ -54,6 +63,17 @@ var (
 	// died because of failed send or receive
 	ErrClientClosed = ServerError{errors.New("client is closed")}
 
+	// ErrNotHBaseServer is returned to rpcs when the first response received
+	// on a connection doesn't look like one of HBase, e.g. because the client
+	// is configured with the port of another service
+	ErrNotHBaseServer = ServerError{errors.New("server doesn't look like HBase")}
+
+	// ErrCellTooLarge is matched by CellTooLargeError with errors.Is
+	ErrCellTooLarge = errors.New("cell is too large")
+
+	// ErrRequestTooLarge is matched by RequestTooLargeError with errors.Is
+	ErrRequestTooLarge = errors.New("request is too large")
+
 	// If a Java exception listed here is returned by HBase, the client should
 	// reestablish region and attempt to resend the RPC message, potentially via
 	// a different region client.
@@ -71,11 +91,29 @@ var (
 		"org.apache.hadoop.hbase.CallQueueTooBigException":          "",
 		"org.apache.hadoop.hbase.exceptions.RegionOpeningException": "",
 		"org.apache.hadoop.hbase.ipc.ServerNotRunningYetException":  "",
-		"org.apache.hadoop.hbase.quotas.RpcThrottlingException":     "",
 		"org.apache.hadoop.hbase.RetryImmediatelyException":         "",
 		"org.apache.hadoop.hbase.RegionTooBusyException":            "",
 	}
 
+	// If the Java exception returned by HBase is javaThrottlingException, the client
+	// should wait for the time suggested in its message, which follows
+	// throttlingWaitPrefix, and resend the RPC message to the same region server.
+	javaThrottlingException = "org.apache.hadoop.hbase.quotas.RpcThrottlingException"
+	throttlingWaitPrefix    = " - wait "
+	throttlingWaitRegexp    = regexp.MustCompile(`(\d+)(hrs|mins|sec|ms)`)
+	throttlingWaitUnits     = map[string]time.Duration{
+		"hrs":  time.Hour,
+		"mins": time.Minute,
+		"sec":  time.Second,
+		"ms":   time.Millisecond,
+	}
+
+	// If the Java exception returned by HBase is javaDoNotRetryException and its
+	// message matches cellTooLargeRegexp, a cell of the mutation is larger than
+	// hbase.server.keyvalue.maxsize of the regionserver.
+	javaDoNotRetryException = "org.apache.hadoop.hbase.DoNotRetryIOException"
+	cellTooLargeRegexp      = regexp.MustCompile(`with size (\d+) exceeds limit of (\d+) bytes`)
+
 	// javaServerExceptions is a map where all Java exceptions that signify
 	// the RPC should be sent again are listed (as keys). If a Java exception
 	// listed here is returned by HBase, the RegionClient will be closed and a new
@@ -92,12 +130,23 @@ const (
 	DefaultLookupTimeout = 30 * time.Second
 	//DefaultReadTimeout is the default region read timeout
 	DefaultReadTimeout = 30 * time.Second
+	// DefaultMaxRequestSize is the default maximum size of a request,
+	// the default hbase.ipc.max.request.size of RegionServers
+	DefaultMaxRequestSize = 256 * 1024 * 1024
+	// maxFirstResponseSize is the size above which the first response of a
+	// connection is deemed not to come from HBase. The length prefix of the
+	// response of a text protocol such as HTTP is way above it.
+	maxFirstResponseSize = 1 << 29
 	// RegionClient is a ClientType that means this will be a normal client
 	RegionClient = ClientType("ClientService")
 
 	// MasterClient is a ClientType that means this client will talk to the
 	// master server
 	MasterClient = ClientType("MasterService")
+
+	// RegionAdminClient is a ClientType that means this client will talk to
+	// the AdminService of a RegionServer, e.g. to compact its regions
+	RegionAdminClient = ClientType("AdminService")
 )
 
 var bufferPool sync.Pool
@@ -146,6 +195,73 @@ func (e RetryableError) Error() string {
 	return formatErr(e, e.error)
 }
 
+// ThrottledError is an error that indicates the RPC was rejected because
+// it exceeded a quota, and should be retried on the same region client
+// after waiting for at least Wait, as suggested by the server.
+type ThrottledError struct {
+	error
+	// Wait is how long the server suggested to wait before retrying,
+	// or zero if it didn't suggest anything.
+	Wait time.Duration
+}
+
+func (e ThrottledError) Error() string {
+	return formatErr(e, e.error)
+}
+
+// CellTooLargeError is an error that indicates a mutation was rejected because
+// one of its cells is larger than the maximum size of a cell. It matches
+// ErrCellTooLarge with errors.Is.
+type CellTooLargeError struct {
+	// Size is the size of the cell in bytes.
+	Size int64
+	// Limit is the maximum size of a cell in bytes.
+	Limit int64
+	// Err is the exception returned by HBase, or nil if the mutation
+	// has been rejected before being sent.
+	Err error
+}
+
+func (e CellTooLargeError) Error() string {
+	if e.Err != nil {
+		return formatErr(e, e.Err)
+	}
+	return formatErr(e, fmt.Errorf("cell of size %d exceeds limit of %d bytes",
+		e.Size, e.Limit))
+}
+
+// Unwrap returns the exception returned by HBase.
+func (e CellTooLargeError) Unwrap() error {
+	return e.Err
+}
+
+// Is returns true if target is ErrCellTooLarge.
+func (e CellTooLargeError) Is(target error) bool {
+	return target == ErrCellTooLarge
+}
+
+// RequestTooLargeError is an error that indicates an rpc wasn't sent because
+// it's larger than the maximum size of a request on its own, see
+// WithMaxRequestSize. Batched rpcs are split into several requests instead,
+// but an rpc such as hrpc.CheckAndMutate whose mutations must be performed
+// atomically can't be split. It matches ErrRequestTooLarge with errors.Is.
+type RequestTooLargeError struct {
+	// Size is the estimated size of the rpc in bytes.
+	Size int64
+	// Limit is the maximum size of a request in bytes.
+	Limit int64
+}
+
+func (e RequestTooLargeError) Error() string {
+	return formatErr(e, fmt.Errorf("request of size %d exceeds limit of %d bytes",
+		e.Size, e.Limit))
+}
+
+// Is returns true if target is ErrRequestTooLarge.
+func (e RequestTooLargeError) Is(target error) bool {
+	return target == ErrRequestTooLarge
+}
+
 // NotServingRegionError is an error that indicates the client should
 // reestablish the region and retry the RPC potentially via a different client
 type NotServingRegionError struct {
@@ -158,6 +274,10 @@ func (e NotServingRegionError) Error() string {
 
 // client manages a connection to a RegionServer.
 type client struct {
+	// stats are updated atomically. They are kept first in the struct
+	// for 64-bit alignment required by atomic operations on 32-bit platforms.
+	stats hrpc.ConnStats
+
 	conn net.Conn
 
 	// Address of the RegionServer.
@@ -172,26 +292,212 @@ type client struct {
 	rpcs chan []hrpc.Call
 	done chan struct{}
 
+	// goroutines tracks the goroutines processing and receiving rpcs,
+	// so that Close can wait for them to stop. goroutinesM makes sure
+	// that none is started once done is closed.
+	goroutinesM sync.Mutex
+	goroutines  sync.WaitGroup
+
 	// sent contains the mapping of sent call IDs to RPC calls, so that when
 	// a response is received it can be tied to the correct RPC
 	sentM sync.Mutex // protects sent
 	sent  map[uint32]hrpc.Call
 
+	// responded is set once a response looking like one of HBase has been
+	// received. It's only accessed by the goroutine receiving rpcs.
+	responded bool
+
+	// writeM serializes writes to the connection. It's a channel instead of
+	// a sync.Mutex so that waiting for it can be given up once the context
+	// of an rpc is done. It's lazily created by lockWrite.
+	writeOnce sync.Once
+	writeM    chan struct{}
+
 	// inFlight is number of rpcs sent to regionserver awaiting response
-	inFlightM sync.Mutex // protects inFlight and SetReadDeadline
+	inFlightM sync.Mutex // protects inFlight, readDeadline and SetReadDeadline
 	inFlight  uint32
+	// readDeadline is the read deadline of the connection while rpcs are in flight
+	readDeadline time.Time
 
 	id uint32
 
 	rpcQueueSize  int
 	flushInterval time.Duration
-	effectiveUser string
+	// maxRequestSize is the maximum estimated size of a request, zero if
+	// there's no limit, see WithMaxRequestSize
+	maxRequestSize int64
+	effectiveUser  string
+	// realUser is the user impersonating effectiveUser, if any
+	realUser string
 
 	// readTimeout is the maximum amount of time to wait for regionserver reply
 	readTimeout time.Duration
+	// contextDeadlines enables deadlines of the connection derived
+	// from the contexts of rpcs, see WithContextDeadlines
+	contextDeadlines bool
 
 	// compressor for cellblocks. if nil, then no compression
 	compressor *compressor
+	// compressionThreshold is the size of cellblocks below which requests are
+	// sent as plain protobuf instead of compressed, see WithCompressionThreshold
+	compressionThreshold uint32
+
+	// coalesceIncrements enables summing up batched increments of the same cell
+	coalesceIncrements bool
+
+	// dnsCache resolves the hostname of the RegionServer. If nil, it's
+	// resolved every time the client connects.
+	dnsCache *DNSCache
+
+	// dialer connects to the RegionServer. If nil, net.Dialer is used.
+	dialer func(ctx context.Context, network, addr string) (net.Conn, error)
+	// readBuffer and writeBuffer are the sizes of the socket buffers of
+	// the connection and noDelay its TCP_NODELAY, left as is if zero or nil
+	readBuffer, writeBuffer int
+	noDelay                 *bool
+
+	// tap is called with the frames of rpcs, if set
+	tap Tap
+	// rpcCodec encodes requests and decodes responses, ProtobufCodec if nil
+	rpcCodec RPCCodec
+}
+
+// ClientOption is an option that can be passed to NewClient.
+type ClientOption func(*client)
+
+// CoalesceIncrements returns an option that makes the client sum up increments
+// of the same cell batched within a flush interval and send them to the
+// regionserver as a single increment. Each call still gets its own result
+// as if the increments were sent one by one in the order they were queued.
+func CoalesceIncrements() ClientOption {
+	return func(c *client) {
+		c.coalesceIncrements = true
+	}
+}
+
+// WithDialer returns an option that makes the client connect to the
+// RegionServer with the given function instead of net.Dialer.
+func WithDialer(
+	dialer func(ctx context.Context, network, addr string) (net.Conn, error)) ClientOption {
+	return func(c *client) {
+		c.dialer = dialer
+	}
+}
+
+// WithSocketBuffers returns an option that sets the sizes of the operating
+// system's receive and send buffers of the connection to the RegionServer,
+// e.g. larger ones for high-throughput scans over high latency networks.
+// A size of zero or less leaves the default of the operating system.
+func WithSocketBuffers(read, write int) ClientOption {
+	return func(c *client) {
+		c.readBuffer, c.writeBuffer = read, write
+	}
+}
+
+// WithNoDelay returns an option that sets TCP_NODELAY on the connection to
+// the RegionServer. Go enables it by default, so that requests are sent
+// without delay, disabling it trades latency for fewer packets.
+func WithNoDelay(noDelay bool) ClientOption {
+	return func(c *client) {
+		c.noDelay = &noDelay
+	}
+}
+
+// setSocketOptions sets the socket options of the client on conn. Connections
+// that don't support them, such as ones of custom dialers, are left as is.
+func (c *client) setSocketOptions(conn net.Conn) error {
+	if s, ok := conn.(interface{ SetReadBuffer(int) error }); ok && c.readBuffer > 0 {
+		if err := s.SetReadBuffer(c.readBuffer); err != nil {
+			return fmt.Errorf("failed to set read buffer: %s", err)
+		}
+	}
+	if s, ok := conn.(interface{ SetWriteBuffer(int) error }); ok && c.writeBuffer > 0 {
+		if err := s.SetWriteBuffer(c.writeBuffer); err != nil {
+			return fmt.Errorf("failed to set write buffer: %s", err)
+		}
+	}
+	if s, ok := conn.(interface{ SetNoDelay(bool) error }); ok && c.noDelay != nil {
+		if err := s.SetNoDelay(*c.noDelay); err != nil {
+			return fmt.Errorf("failed to set TCP_NODELAY: %s", err)
+		}
+	}
+	return nil
+}
+
+// WithContextDeadlines returns an option that sets the write and read deadlines
+// of the connection from the deadlines of the contexts of rpcs, so that a socket
+// stalled writing an rpc or reading its response fails the client once the rpc
+// is over rather than after the read timeout. The read deadline isn't shortened
+// before the ones of other rpcs awaiting their responses. A slow response to an
+// rpc whose context is over fails the client all the same.
+func WithContextDeadlines() ClientOption {
+	return func(c *client) {
+		c.contextDeadlines = true
+	}
+}
+
+// WithRealUser returns an option that makes the client impersonate its effective
+// user: the connection is made as user, e.g. the one of a secure gateway, and
+// RPCs are authorized by the RegionServer as the effective user on whose behalf
+// they run. HBase must allow user to impersonate others, see the
+// hadoop.proxyuser.<user>.* settings. There's no real user by default.
+func WithRealUser(user string) ClientOption {
+	return func(c *client) {
+		c.realUser = user
+	}
+}
+
+// WithMaxRequestSize returns an option that caps the size of requests sent
+// to the RegionServer, which rejects requests larger than its
+// hbase.ipc.max.request.size. The size of a request is estimated from the
+// cells of its mutations. Batches of rpcs that would exceed it are split into
+// several requests, so that a batch of mutations of a region that was sent
+// as a single request is then performed by several of them, which is fine as
+// mutations of a batch are not performed atomically anyway. Rpcs larger than
+// size on their own, including atomic ones such as hrpc.CheckAndMutate, fail
+// with RequestTooLargeError without being sent. The default is
+// DefaultMaxRequestSize, zero or less disables the limit.
+func WithMaxRequestSize(size int64) ClientOption {
+	return func(c *client) {
+		c.maxRequestSize = size
+	}
+}
+
+// WithCompressionThreshold returns an option that sends requests whose
+// cellblocks are smaller than size bytes as plain protobuf when cellblocks are
+// compressed, so that only large mutations pay for compressing them. HBase
+// decompresses the cellblocks of every request once a connection negotiated
+// a compressor, so small requests can't be sent with uncompressed cellblocks.
+func WithCompressionThreshold(size uint32) ClientOption {
+	return func(c *client) {
+		c.compressionThreshold = size
+	}
+}
+
+// checkRequestSize returns a RequestTooLargeError if rpc is larger
+// than the maximum size of a request.
+func (c *client) checkRequestSize(rpc hrpc.Call) (int64, error) {
+	if c.maxRequestSize <= 0 {
+		return 0, nil
+	}
+	size := requestSize(rpc)
+	if size > c.maxRequestSize {
+		return size, RequestTooLargeError{Size: size, Limit: c.maxRequestSize}
+	}
+	return size, nil
+}
+
+// requestSize returns an estimate of the size of rpc in a request, which is
+// the size of its key and of the KeyValues of the cells it mutates if any.
+// It's a lower bound that doesn't account for the rest of the request,
+// e.g. the families and filters of Gets.
+func requestSize(rpc hrpc.Call) int64 {
+	size := int64(len(rpc.Key()))
+	if m, ok := rpc.(interface{ KeyValueSizes() (int64, int64) }); ok {
+		total, _ := m.KeyValueSizes()
+		size += total
+	}
+	return size
 }
 
 // QueueRPC will add an rpc call to the queue for processing by the writer goroutine
@@ -210,7 +516,9 @@ func (c *client) QueueRPC(rpc hrpc.Call) {
 			// request. The function that placed the RPC in our queue should
 			// stop waiting for a result and return an error.
 		default:
-			if err := c.trySend(rpc); err != nil {
+			if _, err := c.checkRequestSize(rpc); err != nil {
+				returnResult(rpc, nil, err)
+			} else if err := c.trySend(rpc); err != nil {
 				returnResult(rpc, nil, err)
 			}
 		}
@@ -234,9 +542,42 @@ func (c *client) QueueBatch(ctx context.Context, rpcs []hrpc.Call) {
 
 // Close asks this region.Client to close its connection to the RegionServer.
 // All queued and outstanding RPCs, if any, will be failed as if a connection
-// error had happened.
+// error had happened. Close returns once the goroutines of the client,
+// including the one flushing batches every flushInterval, have stopped.
 func (c *client) Close() {
 	c.fail(ErrClientClosed)
+	// done is closed, so no more goroutines can be started once
+	// the ones being started are added to the wait group
+	c.goroutinesM.Lock()
+	c.goroutinesM.Unlock() // nolint:staticcheck
+	c.goroutines.Wait()
+}
+
+// goroutine runs f in a goroutine that Close waits for,
+// unless the client is already closed.
+func (c *client) goroutine(f func()) {
+	c.goroutinesM.Lock()
+	defer c.goroutinesM.Unlock()
+	select {
+	case <-c.done:
+		return
+	default:
+	}
+	c.goroutines.Add(1)
+	go func() {
+		defer c.goroutines.Done()
+		f()
+	}()
+}
+
+// ConnStats returns a snapshot of statistics of the connection to the RegionServer.
+func (c *client) ConnStats() hrpc.ConnStats {
+	return hrpc.ConnStats{
+		BytesSent:     atomic.LoadUint64(&c.stats.BytesSent),
+		BytesReceived: atomic.LoadUint64(&c.stats.BytesReceived),
+		RPCsCompleted: atomic.LoadUint64(&c.stats.RPCsCompleted),
+		RPCsFailed:    atomic.LoadUint64(&c.stats.RPCsFailed),
+	}
 }
 
 // Addr returns address of the region server the client is connected to
@@ -249,11 +590,21 @@ func (c *client) String() string {
 	return fmt.Sprintf("RegionClient{Addr: %s}", c.addr)
 }
 
-func (c *client) inFlightUp() error {
+func (c *client) inFlightUp(ctx context.Context) error {
 	c.inFlightM.Lock()
 	c.inFlight++
 	// we expect that at least the last request can be completed within readTimeout
-	if err := c.conn.SetReadDeadline(time.Now().Add(c.readTimeout)); err != nil {
+	deadline := time.Now().Add(c.readTimeout)
+	if d, ok := ctx.Deadline(); ok && c.contextDeadlines && d.Before(deadline) {
+		// or before the deadline of its context, unless the other requests
+		// awaiting a response are expected to complete later
+		deadline = d
+		if c.inFlight > 1 && c.readDeadline.After(deadline) {
+			deadline = c.readDeadline
+		}
+	}
+	c.readDeadline = deadline
+	if err := c.conn.SetReadDeadline(deadline); err != nil {
 		c.inFlightM.Unlock()
 		return err
 	}
@@ -267,6 +618,7 @@ func (c *client) inFlightDown() error {
 	// reset read timeout if we are not waiting for any responses
 	// in order to prevent from closing this client if there are no request
 	if c.inFlight == 0 {
+		c.readDeadline = time.Time{}
 		if err := c.conn.SetReadDeadline(time.Time{}); err != nil {
 			c.inFlightM.Unlock()
 			return err
@@ -299,11 +651,11 @@ func (c *client) fail(err error) {
 			c.conn.Close()
 		}
 
-		c.failSentRPCs()
+		c.failSentRPCs(err)
 	})
 }
 
-func (c *client) failSentRPCs() {
+func (c *client) failSentRPCs(err error) {
 	// channel is closed, clean up awaiting rpcs
 	c.sentM.Lock()
 	sent := c.sent
@@ -315,14 +667,23 @@ func (c *client) failSentRPCs() {
 		"count":  len(sent),
 	}).Debug("failing awaiting RPCs")
 
-	// send error to awaiting rpcs
+	atomic.AddUint64(&c.stats.RPCsFailed, uint64(len(sent)))
+	// send error to awaiting rpcs, so that they are retried with a new client
+	// unless there's no point as the server isn't HBase
+	if err != ErrNotHBaseServer {
+		err = ErrClientClosed
+	}
 	for _, rpc := range sent {
-		returnResult(rpc, nil, ErrClientClosed)
+		returnResult(rpc, nil, err)
 	}
 }
 
 func (c *client) registerRPC(rpc hrpc.Call) uint32 {
 	currID := atomic.AddUint32(&c.id, 1)
+	if currID == 0 {
+		// 0 is the id of rpcs that failed before being registered
+		currID = atomic.AddUint32(&c.id, 1)
+	}
 	c.sentM.Lock()
 	c.sent[currID] = rpc
 	c.sentM.Unlock()
@@ -337,10 +698,15 @@ func (c *client) unregisterRPC(id uint32) hrpc.Call {
 	return rpc
 }
 
+func (c *client) newMulti() *multi {
+	m := newMulti(c.rpcQueueSize)
+	m.coalesceIncrements = c.coalesceIncrements
+	return m
+}
+
 func (c *client) processRPCs() {
-	// TODO: flush when the size is too large
 	// TODO: if multi has only one call, send that call instead
-	m := newMulti(c.rpcQueueSize)
+	m := c.newMulti()
 	defer func() {
 		m.returnResults(nil, ErrClientClosed)
 	}()
@@ -363,7 +729,29 @@ func (c *client) processRPCs() {
 		}
 
 		// Start preparing for the next batch
-		m = newMulti(c.rpcQueueSize)
+		m = c.newMulti()
+	}
+
+	// add adds rpcs to the batch and returns whether it's full. The batch is
+	// flushed before it would exceed maxRequestSize, so that an oversized
+	// batch is split into several requests.
+	add := func(rpcs []hrpc.Call) bool {
+		if c.maxRequestSize <= 0 {
+			return m.add(rpcs)
+		}
+		for i, rpc := range rpcs {
+			size, err := c.checkRequestSize(rpc)
+			if err != nil {
+				returnResult(rpc, nil, err)
+				continue
+			}
+			if m.len() > 0 && m.bytes+size > c.maxRequestSize {
+				flush("size")
+			}
+			m.bytes += size
+			m.add(rpcs[i : i+1])
+		}
+		return m.len() >= c.rpcQueueSize
 	}
 
 	for {
@@ -376,7 +764,7 @@ func (c *client) processRPCs() {
 				return
 			case rpcs := <-c.rpcs:
 				// have things queued up, batch them
-				if !m.add(rpcs) {
+				if !add(rpcs) {
 					// can still put more rpcs into batch
 					continue
 				}
@@ -392,7 +780,7 @@ func (c *client) processRPCs() {
 			case <-c.done:
 				return
 			case rpcs := <-c.rpcs:
-				m.add(rpcs)
+				add(rpcs)
 			}
 			continue
 		} else if l >= c.rpcQueueSize || c.flushInterval == 0 {
@@ -409,12 +797,13 @@ func (c *client) processRPCs() {
 		for {
 			select {
 			case <-c.done:
+				timer.Stop()
 				return
 			case <-timer.C:
 				reason = "timeout"
 				// time to flush
 			case rpcs := <-c.rpcs:
-				if !m.add(rpcs) {
+				if !add(rpcs) {
 					// can still put more rpcs into batch
 					continue
 				}
@@ -434,6 +823,9 @@ func returnResult(c hrpc.Call, msg proto.Message, err error) {
 	if m, ok := c.(*multi); ok {
 		m.returnResults(msg, err)
 	} else {
+		if mr, ok := msg.(*pb.MultiResponse); ok && err == nil {
+			err = atomicMultiError(mr)
+		}
 		c.ResultChan() <- hrpc.RPCResult{Msg: msg, Error: err}
 	}
 }
@@ -443,6 +835,11 @@ func (c *client) trySend(rpc hrpc.Call) (err error) {
 		if _, ok := err.(ServerError); ok {
 			c.fail(err)
 		}
+		if id == 0 {
+			// the rpc failed before being registered, e.g. while waiting
+			// for a stalled write, so nobody else returns its result
+			return err
+		}
 		if r := c.unregisterRPC(id); r != nil {
 			// we are the ones to unregister the rpc,
 			// return err to notify client of it
@@ -472,6 +869,15 @@ func (c *client) receiveRPCs() {
 	}
 }
 
+// badResponse returns err for a malformed response, or ErrNotHBaseServer
+// if it's the first response of the connection.
+func (c *client) badResponse(err ServerError) error {
+	if !c.responded {
+		return ErrNotHBaseServer
+	}
+	return err
+}
+
 func (c *client) receive(r io.Reader) (err error) {
 	var (
 		sz       [4]byte
@@ -485,35 +891,50 @@ func (c *client) receive(r io.Reader) (err error) {
 	}
 
 	size := binary.BigEndian.Uint32(sz[:])
-	b := make([]byte, size)
+	if !c.responded && size > maxFirstResponseSize {
+		return ErrNotHBaseServer
+	}
+	b := newBuffer(int(size))
+	// retained is set when cells of the response reference b, in which case
+	// it's up to the rpc to return b to the pool once it's done with them.
+	var retained bool
+	defer func() {
+		if !retained {
+			freeBuffer(b)
+		}
+	}()
 
 	_, err = io.ReadFull(r, b)
 	if err != nil {
 		return ServerError{err}
 	}
+	atomic.AddUint64(&c.stats.BytesReceived, uint64(len(sz)+len(b)))
 
 	// unmarshal header
-	headerBytes, headerLen := protowire.ConsumeBytes(b)
-	if headerLen < 0 {
-		return ServerError{fmt.Errorf("failed to decode the response header: %v",
-			protowire.ParseError(headerLen))}
-	}
-	if err = proto.Unmarshal(headerBytes, &header); err != nil {
-		return ServerError{fmt.Errorf("failed to decode the response header: %v", err)}
+	codec := c.codec()
+	headerLen, err := codec.DecodeResponseHeader(b, &header)
+	if err != nil {
+		return c.badResponse(
+			ServerError{fmt.Errorf("failed to decode the response header: %v", err)})
 	}
 
 	if header.CallId == nil {
-		return ErrMissingCallID
+		return c.badResponse(ErrMissingCallID)
 	}
 
 	callID := *header.CallId
 	rpc := c.unregisterRPC(callID)
 	if rpc == nil {
-		return ServerError{fmt.Errorf("got a response with an unexpected call ID: %d", callID)}
+		return c.badResponse(
+			ServerError{fmt.Errorf("got a response with an unexpected call ID: %d", callID)})
 	}
+	c.responded = true
 	if err := c.inFlightDown(); err != nil {
 		return ServerError{err}
 	}
+	if c.tap != nil {
+		c.tap(rpc, TapResponse, b)
+	}
 
 	select {
 	case <-rpc.Context().Done():
@@ -525,7 +946,14 @@ func (c *client) receive(r io.Reader) (err error) {
 	// Here we know for sure that we got a response for rpc we asked.
 	// It's our responsibility to deliver the response or error to the
 	// caller as we unregistered the rpc.
-	defer func() { returnResult(rpc, response, err) }()
+	defer func() {
+		if err != nil {
+			atomic.AddUint64(&c.stats.RPCsFailed, 1)
+		} else {
+			atomic.AddUint64(&c.stats.RPCsCompleted, 1)
+		}
+		returnResult(rpc, response, err)
+	}()
 
 	if header.Exception != nil {
 		err = exceptionToError(*header.Exception.ExceptionClassName, *header.Exception.StackTrace)
@@ -534,14 +962,7 @@ func (c *client) receive(r io.Reader) (err error) {
 
 	response = rpc.NewResponse()
 
-	responseBytes, responseLen := protowire.ConsumeBytes(b[headerLen:])
-	if responseLen < 0 {
-		err = RetryableError{fmt.Errorf("failed to decode the response: %s",
-			protowire.ParseError(responseLen))}
-		return
-	}
-
-	if err = proto.Unmarshal(responseBytes, response); err != nil {
+	if _, err = codec.DecodeResponse(b[headerLen:], response); err != nil {
 		err = RetryableError{fmt.Errorf("failed to decode the response: %s", err)}
 		return
 	}
@@ -551,13 +972,22 @@ func (c *client) receive(r io.Reader) (err error) {
 		cellsLen = header.CellBlockMeta.GetLength()
 	}
 	if d, ok := rpc.(canDeserializeCellBlocks); cellsLen > 0 && ok {
+		buf := b
 		b := b[size-cellsLen:]
+		zc, zeroCopy := rpc.(canRetainBuffer)
+		zeroCopy = zeroCopy && zc.ZeroCopy()
 		if c.compressor != nil {
 			b, err = c.compressor.decompressCellblocks(b)
 			if err != nil {
 				err = RetryableError{fmt.Errorf("failed to decompress the response: %s", err)}
 				return
 			}
+			// decompressed cellblocks don't reference the pooled buffer
+			zeroCopy = false
+		} else if !zeroCopy {
+			// deserialized cells reference the cellblocks,
+			// copy them out so that the pooled buffer can be reused
+			b = append([]byte(nil), b...)
 		}
 		var nread uint32
 		nread, err = d.DeserializeCellBlocks(response, b)
@@ -571,22 +1001,75 @@ func (c *client) receive(r io.Reader) (err error) {
 				fmt.Errorf("short read: buffer length %d, read %d", len(b), nread)}
 			return
 		}
+
+		if zeroCopy {
+			retained = true
+			zc.SetBufferRelease(func() { freeBuffer(buf) })
+		}
 	}
 	return
 }
 
 func exceptionToError(class, stack string) error {
 	err := fmt.Errorf("HBase Java exception %s:\n%s", class, stack)
-	if s, ok := javaRetryableExceptions[class]; ok && strings.Contains(stack, s) {
+	if class == javaThrottlingException {
+		return ThrottledError{error: err, Wait: throttlingWait(stack)}
+	} else if s, ok := javaRetryableExceptions[class]; ok && strings.Contains(stack, s) {
 		return RetryableError{err}
 	} else if s, ok := javaRegionExceptions[class]; ok && strings.Contains(stack, s) {
 		return NotServingRegionError{err}
 	} else if s, ok := javaServerExceptions[class]; ok && strings.Contains(stack, s) {
 		return ServerError{err}
+	} else if class == javaDoNotRetryException {
+		if m := cellTooLargeRegexp.FindStringSubmatch(stack); m != nil {
+			size, _ := strconv.ParseInt(m[1], 10, 64)
+			limit, _ := strconv.ParseInt(m[2], 10, 64)
+			return CellTooLargeError{Size: size, Limit: limit, Err: err}
+		}
 	}
 	return err
 }
 
+// throttlingWait parses the wait suggested in the message of
+// RpcThrottlingException, e.g. "Number of requests exceeded - wait 1sec, 50ms".
+func throttlingWait(stack string) time.Duration {
+	i := strings.Index(stack, throttlingWaitPrefix)
+	if i < 0 {
+		return 0
+	}
+	msg := stack[i+len(throttlingWaitPrefix):]
+	if i = strings.IndexByte(msg, '\n'); i >= 0 {
+		msg = msg[:i]
+	}
+	var wait time.Duration
+	for _, m := range throttlingWaitRegexp.FindAllStringSubmatch(msg, -1) {
+		n, err := strconv.ParseInt(m[1], 10, 64)
+		if err != nil {
+			return 0
+		}
+		wait += time.Duration(n) * throttlingWaitUnits[m[2]]
+	}
+	return wait
+}
+
+// lockWrite acquires exclusive access to writing to the connection,
+// or returns the error of the context if it's done first.
+func (c *client) lockWrite(ctx context.Context) error {
+	c.writeOnce.Do(func() {
+		c.writeM = make(chan struct{}, 1)
+	})
+	select {
+	case c.writeM <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (c *client) unlockWrite() {
+	<-c.writeM
+}
+
 // write sends the given buffer to the RegionServer.
 func (c *client) write(buf []byte) error {
 	_, err := c.conn.Write(buf)
@@ -602,6 +1085,9 @@ func (c *client) sendHello() error {
 		ServiceName:         proto.String(string(c.ctype)),
 		CellBlockCodecClass: proto.String("org.apache.hadoop.hbase.codec.KeyValueCodec"),
 	}
+	if c.realUser != "" {
+		connHeader.UserInfo.RealUser = proto.String(c.realUser)
+	}
 	if c.compressor != nil {
 		// if we have compression enabled, specify the compressor class
 		connHeader.CellBlockCompressorClass = proto.String(c.compressor.CellBlockCompressorClass())
@@ -621,7 +1107,7 @@ func (c *client) sendHello() error {
 }
 
 // send sends an RPC out to the wire.
-// Returns the response (for now, as the call is synchronous).
+// Returns the id the rpc was registered with, or 0 if it failed before.
 func (c *client) send(rpc hrpc.Call) (uint32, error) {
 	var err error
 	var request proto.Message
@@ -636,23 +1122,35 @@ func (c *client) send(rpc hrpc.Call) (uint32, error) {
 		// request can be serialized to cellblocks
 		request, cellblocks, cellblocksLen = s.SerializeCellBlocks(nil)
 
-		if c.compressor != nil {
-			// we have compressor, encode the cellblocks
-			compressed := c.compressor.compressCellblocks(cellblocks, cellblocksLen)
-			defer freeBuffer(compressed)
-			cellblocks = net.Buffers{compressed}
-			cellblocksLen = uint32(len(compressed))
-		}
+		if c.compressor != nil && cellblocksLen < c.compressionThreshold {
+			// not worth compressing, fall back to plain protobuf
+			request, cellblocks, cellblocksLen = rpc.ToProto(), nil, 0
+		} else {
+			if c.compressor != nil {
+				// we have compressor, encode the cellblocks
+				compressed := c.compressor.compressCellblocks(cellblocks, cellblocksLen)
+				defer freeBuffer(compressed)
+				cellblocks = net.Buffers{compressed}
+				cellblocksLen = uint32(len(compressed))
+			}
 
-		// specify cellblocks length
-		header.CellBlockMeta = &pb.CellBlockMeta{
-			Length: &cellblocksLen,
+			// specify cellblocks length
+			header.CellBlockMeta = &pb.CellBlockMeta{
+				Length: &cellblocksLen,
+			}
 		}
 	} else {
 		// plain protobuf request
 		request = rpc.ToProto()
 	}
 
+	// the connection might be stalled by a slow write of another rpc,
+	// don't wait for it longer than the rpc's context allows
+	if err := c.lockWrite(rpc.Context()); err != nil {
+		return 0, err
+	}
+	defer c.unlockWrite()
+
 	// we have to register rpc after we marshal because
 	// registered rpc can fail before it was even sent
 	// in all the cases where c.fail() is called.
@@ -661,11 +1159,19 @@ func (c *client) send(rpc hrpc.Call) (uint32, error) {
 	id := c.registerRPC(rpc)
 	header.CallId = &id
 
+	// don't stay stuck writing to a stalled connection once the rpc is over
+	if deadline, ok := rpc.Context().Deadline(); ok && c.contextDeadlines {
+		if err := c.conn.SetWriteDeadline(deadline); err != nil {
+			return id, ServerError{err}
+		}
+		defer c.conn.SetWriteDeadline(time.Time{})
+	}
+
 	// Wire protocol:
 	//
 	// 4 byte total length
 	//
-	// Protobuf data:
+	// Protobuf data, encoded by the RPCCodec of the client, by default:
 	// varint length of header
 	// <header>
 	// varint length of request
@@ -685,34 +1191,15 @@ func (c *client) send(rpc hrpc.Call) (uint32, error) {
 		return id, errors.New("failed to marshal request: proto: Marshal called with nil")
 	}
 
-	headerSize := proto.Size(header)
-	requestSize := proto.Size(request)
-
-	protobufLen := protowire.SizeVarint(uint64(headerSize)) + headerSize +
-		protowire.SizeVarint(uint64(requestSize)) + requestSize
-	totalLen := uint32(protobufLen) + cellblocksLen
-
-	b := make([]byte, 4, 4+protobufLen)
-
-	binary.BigEndian.PutUint32(b, totalLen)
-
-	b = protowire.AppendVarint(b, uint64(headerSize))
-	b, err = proto.MarshalOptions{UseCachedSize: true}.MarshalAppend(b, header)
+	b, err := c.codec().AppendRequest(make([]byte, 4), header, request)
 	if err != nil {
-		return id, fmt.Errorf("failed to marshal request header: %s", err)
+		return id, err
 	}
+	totalLen := uint32(len(b)-4) + cellblocksLen
+	binary.BigEndian.PutUint32(b, totalLen)
 
-	b = protowire.AppendVarint(b, uint64(requestSize))
-	b, err = proto.MarshalOptions{UseCachedSize: true}.MarshalAppend(b, request)
-	if err != nil {
-		return id, fmt.Errorf("failed to marshal request: %s", err)
-	}
-	if len(b) != 4+protobufLen {
-		// This shouldn't ever happen, if it does there is a bug in
-		// the size calculation.
-		panic(fmt.Errorf("size of data sent doesn't match expected. expected: %d actual: %d"+
-			"headerSize: %d requestSize: %d\nheader: %s\nrequest: %s",
-			4+protobufLen, len(b), headerSize, requestSize, header, request))
+	if c.tap != nil {
+		c.tapRequest(rpc, b, cellblocks)
 	}
 
 	if cellblocks != nil {
@@ -724,8 +1211,9 @@ func (c *client) send(rpc hrpc.Call) (uint32, error) {
 	if err != nil {
 		return id, ServerError{err}
 	}
+	atomic.AddUint64(&c.stats.BytesSent, uint64(4+totalLen))
 
-	if err := c.inFlightUp(); err != nil {
+	if err := c.inFlightUp(rpc.Context()); err != nil {
 		return id, ServerError{err}
 	}
 	return id, nil
@@ -12,9 +12,11 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"math"
 	"net"
 	"reflect"
+	"runtime"
 	"strconv"
 	"strings"
 	"sync"
@@ -22,6 +24,8 @@ import (
 	"testing"
 	"time"
 
+	"github.com/baiweiguo/gohbase/compression"
+	"github.com/baiweiguo/gohbase/filter"
 	"github.com/baiweiguo/gohbase/hrpc"
 	"github.com/baiweiguo/gohbase/pb"
 	"github.com/baiweiguo/gohbase/test"
@@ -121,6 +125,36 @@ func TestSendHello(t *testing.T) {
 	}
 }
 
+func TestSendHelloRealUser(t *testing.T) {
+	ctrl := test.NewController(t)
+	defer ctrl.Finish()
+	mockConn := mock.NewMockConn(ctrl)
+	c := &client{
+		conn:          mockConn,
+		effectiveUser: "alice",
+		ctype:         RegionClient,
+	}
+	WithRealUser("gateway")(c)
+
+	mockConn.EXPECT().Write(gomock.Any()).Return(0, nil).Times(1).Do(func(buf []byte) {
+		const header = "HBas\x00P"
+		if !bytes.HasPrefix(buf, []byte(header)) {
+			t.Fatalf("expected the connection preamble, got %q", buf)
+		}
+		var connHeader pb.ConnectionHeader
+		if err := proto.Unmarshal(buf[len(header)+4:], &connHeader); err != nil {
+			t.Fatal(err)
+		}
+		userInfo := connHeader.GetUserInfo()
+		if userInfo.GetEffectiveUser() != "alice" || userInfo.GetRealUser() != "gateway" {
+			t.Errorf("expected alice impersonated by gateway, got %v", userInfo)
+		}
+	})
+	if err := c.sendHello(); err != nil {
+		t.Errorf("Wasn't expecting error, but got one: %#v", err)
+	}
+}
+
 func TestFail(t *testing.T) {
 	ctrl := test.NewController(t)
 	defer ctrl.Finish()
@@ -517,7 +551,7 @@ func TestServerErrorExceptionResponse(t *testing.T) {
 	}
 
 	c.registerRPC(rpc)
-	if err := c.inFlightUp(); err != nil {
+	if err := c.inFlightUp(context.Background()); err != nil {
 		t.Fatal(err)
 	}
 
@@ -583,6 +617,43 @@ func TestExceptionToError(t *testing.T) {
 				"HBase Java exception org.apache.hadoop.hbase.CallQueueTooBigException:\n" +
 					"blahblah")},
 		},
+		{
+			class: "org.apache.hadoop.hbase.quotas.RpcThrottlingException",
+			stack: "org.apache.hadoop.hbase.quotas.RpcThrottlingException: " +
+				"Number of requests exceeded - wait 1mins, 2sec, 50ms\n\tat blahblah",
+			out: ThrottledError{
+				error: errors.New("HBase Java exception " +
+					"org.apache.hadoop.hbase.quotas.RpcThrottlingException:\n" +
+					"org.apache.hadoop.hbase.quotas.RpcThrottlingException: " +
+					"Number of requests exceeded - wait 1mins, 2sec, 50ms\n\tat blahblah"),
+				Wait: time.Minute + 2*time.Second + 50*time.Millisecond,
+			},
+		},
+		{
+			class: "org.apache.hadoop.hbase.quotas.RpcThrottlingException",
+			stack: "blahblah",
+			out: ThrottledError{error: errors.New("HBase Java exception " +
+				"org.apache.hadoop.hbase.quotas.RpcThrottlingException:\nblahblah")},
+		},
+		{
+			class: "org.apache.hadoop.hbase.DoNotRetryIOException",
+			stack: "org.apache.hadoop.hbase.DoNotRetryIOException: Cell[\\x00/cf:q/1/Put] " +
+				"with size 10485830 exceeds limit of 10485760 bytes\n\tat blahblah",
+			out: CellTooLargeError{
+				Size:  10485830,
+				Limit: 10485760,
+				Err: errors.New("HBase Java exception " +
+					"org.apache.hadoop.hbase.DoNotRetryIOException:\n" +
+					"org.apache.hadoop.hbase.DoNotRetryIOException: Cell[\\x00/cf:q/1/Put] " +
+					"with size 10485830 exceeds limit of 10485760 bytes\n\tat blahblah"),
+			},
+		},
+		{
+			class: "org.apache.hadoop.hbase.DoNotRetryIOException",
+			stack: "blahblah",
+			out: errors.New("HBase Java exception " +
+				"org.apache.hadoop.hbase.DoNotRetryIOException:\nblahblah"),
+		},
 	}
 	for _, tcase := range tcases {
 		t.Run(tcase.class, func(t *testing.T) {
@@ -684,6 +755,168 @@ func TestReceiveDeserializeCellblocksError(t *testing.T) {
 	}
 }
 
+// scanResponse returns a serialized response to a scan with the given call ID
+// and one row of the given number of cells passed in (compressed) cellblocks.
+func scanResponse(t testing.TB, callID uint32, cells int, cmp *compressor) []byte {
+	var cellblocks []byte
+	for i := 0; i < cells; i++ {
+		row, family, qualifier := "row", "cf", fmt.Sprintf("q%d", i)
+		value := bytes.Repeat([]byte{byte(i)}, 100)
+		keyLen := 2 + len(row) + 1 + len(family) + len(qualifier) + 8 + 1
+		cell := make([]byte, 14, 4+4+4+keyLen+len(value))
+		binary.BigEndian.PutUint32(cell, uint32(4+4+keyLen+len(value)))
+		binary.BigEndian.PutUint32(cell[4:], uint32(keyLen))
+		binary.BigEndian.PutUint32(cell[8:], uint32(len(value)))
+		binary.BigEndian.PutUint16(cell[12:], uint16(len(row)))
+		cell = append(cell, row...)
+		cell = append(cell, byte(len(family)))
+		cell = append(cell, family...)
+		cell = append(cell, qualifier...)
+		cell = append(cell, 0, 0, 0, 0, 0, 0, 0, 42, byte(pb.CellType_PUT))
+		cellblocks = append(cellblocks, append(cell, value...)...)
+	}
+	if cmp != nil {
+		cellblocks = cmp.compressCellblocks(net.Buffers{cellblocks}, uint32(len(cellblocks)))
+	}
+
+	header := &pb.ResponseHeader{
+		CallId:        proto.Uint32(callID),
+		CellBlockMeta: &pb.CellBlockMeta{Length: proto.Uint32(uint32(len(cellblocks)))},
+	}
+	scan := &pb.ScanResponse{
+		CellsPerResult:       []uint32{uint32(cells)},
+		PartialFlagPerResult: []bool{false},
+		MoreResultsInRegion:  proto.Bool(false),
+	}
+	response := make([]byte, 4)
+	for _, m := range []proto.Message{header, scan} {
+		b, err := proto.Marshal(m)
+		if err != nil {
+			t.Fatal(err)
+		}
+		response = protowire.AppendVarint(response, uint64(len(b)))
+		response = append(response, b...)
+	}
+	response = append(response, cellblocks...)
+	binary.BigEndian.PutUint32(response, uint32(len(response)-4))
+	return response
+}
+
+// releaseRecorder records the function returning the pooled buffer
+// backing the response handed over by the region client.
+type releaseRecorder struct {
+	*hrpc.Scan
+	release func()
+}
+
+func (r *releaseRecorder) SetBufferRelease(release func()) {
+	r.release = release
+}
+
+func TestReceiveZeroCopy(t *testing.T) {
+	tests := []struct {
+		zeroCopy    bool
+		compressor  *compressor
+		expRetained bool
+	}{
+		{},
+		{zeroCopy: true, expRetained: true},
+		// decompressed cellblocks never reference the pooled buffer
+		{zeroCopy: true, compressor: &compressor{Codec: mockCodec{}}},
+	}
+
+	for i, tcase := range tests {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			ctrl := test.NewController(t)
+			defer ctrl.Finish()
+			mockConn := mock.NewMockConn(ctrl)
+			mockConn.EXPECT().SetReadDeadline(time.Time{}).Times(1)
+			c := &client{
+				conn:       mockConn,
+				done:       make(chan struct{}),
+				sent:       make(map[uint32]hrpc.Call),
+				compressor: tcase.compressor,
+			}
+
+			var opts []func(hrpc.Call) error
+			if tcase.zeroCopy {
+				opts = append(opts, hrpc.ZeroCopy())
+			}
+			scan, err := hrpc.NewScanStr(context.Background(), "test", opts...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			rpc := &releaseRecorder{Scan: scan}
+			c.sent[1] = rpc
+			c.inFlight = 1
+
+			err = c.receive(bytes.NewReader(scanResponse(t, 1, 3, tcase.compressor)))
+			if err != nil {
+				t.Fatal(err)
+			}
+			res := <-rpc.ResultChan()
+			if res.Error != nil {
+				t.Fatal(res.Error)
+			}
+			if retained := rpc.release != nil; retained != tcase.expRetained {
+				t.Errorf("expected retained buffer %v, got %v", tcase.expRetained, retained)
+			}
+
+			results := res.Msg.(*pb.ScanResponse).Results
+			if len(results) != 1 || len(results[0].Cell) != 3 {
+				t.Fatalf("unexpected results: %v", results)
+			}
+			for i, cell := range results[0].Cell {
+				if q := fmt.Sprintf("q%d", i); string(cell.Qualifier) != q {
+					t.Errorf("expected qualifier %q, got %q", q, cell.Qualifier)
+				}
+				if v := bytes.Repeat([]byte{byte(i)}, 100); !bytes.Equal(cell.Value, v) {
+					t.Errorf("expected value %q, got %q", v, cell.Value)
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkReceiveScan(b *testing.B) {
+	for _, zeroCopy := range []bool{false, true} {
+		b.Run(fmt.Sprintf("zeroCopy=%v", zeroCopy), func(b *testing.B) {
+			conn, _ := net.Pipe()
+			defer conn.Close()
+			c := &client{
+				conn: conn,
+				done: make(chan struct{}),
+				sent: make(map[uint32]hrpc.Call),
+			}
+			var opts []func(hrpc.Call) error
+			if zeroCopy {
+				opts = append(opts, hrpc.ZeroCopy())
+			}
+			scan, err := hrpc.NewScanStr(context.Background(), "test", opts...)
+			if err != nil {
+				b.Fatal(err)
+			}
+			response := scanResponse(b, 1, 100, nil)
+			r := bytes.NewReader(response)
+
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				c.sent[1] = scan
+				c.inFlight = 1
+				r.Reset(response)
+				if err := c.receive(r); err != nil {
+					b.Fatal(err)
+				}
+				if res := <-scan.ResultChan(); res.Error != nil {
+					b.Fatal(res.Error)
+				}
+				scan.ReleaseBuffer()
+			}
+		})
+	}
+}
+
 func TestUnexpectedSendError(t *testing.T) {
 	ctrl := test.NewController(t)
 	defer ctrl.Finish()
@@ -815,6 +1048,101 @@ func TestProcessRPCs(t *testing.T) {
 	}
 }
 
+func TestMaxRequestSize(t *testing.T) {
+	ctrl := test.NewController(t)
+	defer ctrl.Finish()
+
+	mockConn := mock.NewMockConn(ctrl)
+	mockConn.EXPECT().Close()
+	mockConn.EXPECT().Write(gomock.Any()).AnyTimes().DoAndReturn(
+		func(buf []byte) (int, error) { return len(buf), nil })
+	mockConn.EXPECT().SetReadDeadline(gomock.Any()).AnyTimes()
+	c := &client{
+		conn:         mockConn,
+		rpcs:         make(chan []hrpc.Call),
+		done:         make(chan struct{}),
+		sent:         make(map[uint32]hrpc.Call),
+		rpcQueueSize: 100,
+	}
+	// each put has a single cell of 126 bytes, so that 3 of them fit in a request
+	WithMaxRequestSize(400)(c)
+	newPut := func(value []byte) *hrpc.Mutate {
+		put, err := hrpc.NewPutStr(context.Background(), "yolo", "row",
+			map[string]map[string][]byte{"cf": {"q": value}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		put.SetRegion(reg0)
+		return put
+	}
+
+	var wgProcessRPCs sync.WaitGroup
+	wgProcessRPCs.Add(1)
+	go func() {
+		c.processRPCs()
+		wgProcessRPCs.Done()
+	}()
+	defer func() {
+		c.Close()
+		wgProcessRPCs.Wait()
+	}()
+
+	// an oversized batch is split into several requests,
+	// a put larger than a request on its own fails
+	batch := make([]hrpc.Call, 10)
+	for i := range batch {
+		batch[i] = newPut(make([]byte, 100))
+	}
+	tooLarge := newPut(make([]byte, 500))
+	c.QueueBatch(context.Background(), append(batch, tooLarge))
+	res := <-tooLarge.ResultChan()
+	var rerr RequestTooLargeError
+	if !errors.As(res.Error, &rerr) || !errors.Is(res.Error, ErrRequestTooLarge) {
+		t.Fatalf("expected a RequestTooLargeError, got %v", res.Error)
+	}
+	if rerr.Size != 529 || rerr.Limit != 400 {
+		t.Errorf("unexpected size %d and limit %d", rerr.Size, rerr.Limit)
+	}
+	inFlight := func() uint32 {
+		c.inFlightM.Lock()
+		defer c.inFlightM.Unlock()
+		return c.inFlight
+	}
+	deadline := time.Now().Add(5 * time.Second)
+	for inFlight() < 4 && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond)
+	}
+	if n := inFlight(); n != 4 {
+		t.Fatalf("expected the batch to be split into 4 requests, got %d", n)
+	}
+	sizes := map[int]int{}
+	c.sentM.Lock()
+	for _, rpc := range c.sent {
+		sizes[rpc.(*multi).len()]++
+	}
+	c.sentM.Unlock()
+	if expected := map[int]int{3: 3, 1: 1}; !reflect.DeepEqual(expected, sizes) {
+		t.Errorf("expected requests of sizes %v, got %v", expected, sizes)
+	}
+
+	// atomic mutations can't be split
+	cam, err := hrpc.NewCheckAndMutate(
+		[]*hrpc.Mutate{newPut(make([]byte, 200)), newPut(make([]byte, 200))},
+		"cf", "q", filter.Equal,
+		filter.NewBinaryComparator(filter.NewByteArrayComparable(nil)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	cam.SetRegion(reg0)
+	c.QueueRPC(cam)
+	if res := <-cam.ResultChan(); !errors.Is(res.Error, ErrRequestTooLarge) {
+		t.Errorf("expected %v, got %v", ErrRequestTooLarge, res.Error)
+	}
+	if n := inFlight(); n != 4 {
+		t.Errorf("expected the atomic mutations not to be sent, got %d requests", n)
+	}
+}
+
 func TestRPCContext(t *testing.T) {
 	ctrl := test.NewController(t)
 	defer ctrl.Finish()
@@ -859,6 +1187,146 @@ func TestRPCContext(t *testing.T) {
 	c.Close()
 }
 
+func TestQueueRPCStalledWrite(t *testing.T) {
+	ctrl := test.NewController(t)
+	defer ctrl.Finish()
+	mockConn := mock.NewMockConn(ctrl)
+	c := &client{
+		conn:          mockConn,
+		rpcs:          make(chan []hrpc.Call),
+		done:          make(chan struct{}),
+		sent:          make(map[uint32]hrpc.Call),
+		rpcQueueSize:  1,
+		flushInterval: 1000 * time.Second,
+	}
+
+	// first rpc gets stuck writing to the connection
+	stall := make(chan struct{})
+	writing := make(chan struct{})
+	p, payload := mockRPCProto("yolo")
+	mockConn.EXPECT().Write(newRPCMatcher(payload)).Times(1).DoAndReturn(
+		func(b []byte) (int, error) {
+			close(writing)
+			<-stall
+			return len(b), nil
+		})
+	mockConn.EXPECT().SetReadDeadline(gomock.Any()).Times(1)
+	mockCall := mock.NewMockCall(ctrl)
+	mockCall.EXPECT().Name().Return("Get").Times(1)
+	mockCall.EXPECT().ToProto().Return(p).Times(1)
+	mockCall.EXPECT().Context().Return(context.Background()).AnyTimes()
+	mockCall.EXPECT().Description().AnyTimes()
+	mockCall.EXPECT().ResultChan().Return(make(chan hrpc.RPCResult, 1)).AnyTimes()
+	stalled := make(chan struct{})
+	go func() {
+		c.QueueRPC(mockCall)
+		close(stalled)
+	}()
+	<-writing
+
+	// second rpc should give up once its deadline is exceeded
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	callWithDeadline := mock.NewMockCall(ctrl)
+	callWithDeadline.EXPECT().Name().Return("Get").AnyTimes()
+	callWithDeadline.EXPECT().ToProto().Return(p).AnyTimes()
+	callWithDeadline.EXPECT().Context().Return(ctx).AnyTimes()
+	callWithDeadline.EXPECT().Description().AnyTimes()
+	result := make(chan hrpc.RPCResult, 1)
+	callWithDeadline.EXPECT().ResultChan().Return(result).Times(1)
+	queued := make(chan struct{})
+	go func() {
+		c.QueueRPC(callWithDeadline)
+		close(queued)
+	}()
+	select {
+	case <-queued:
+	case <-time.After(5 * time.Second):
+		t.Fatal("QueueRPC didn't return after deadline was exceeded")
+	}
+	select {
+	case res := <-result:
+		if res.Error != context.DeadlineExceeded {
+			t.Errorf("expected %v, got %v", context.DeadlineExceeded, res.Error)
+		}
+	default:
+		t.Error("expected the error of the rpc to be returned")
+	}
+	if len(c.sent) != 1 {
+		t.Errorf("expected only the stalled rpc to be registered, got %d", len(c.sent))
+	}
+
+	// clean up
+	close(stall)
+	<-stalled
+	mockConn.EXPECT().Close()
+	c.Close()
+}
+
+func TestConnStats(t *testing.T) {
+	ctrl := test.NewController(t)
+	defer ctrl.Finish()
+	mockConn := mock.NewMockConn(ctrl)
+	c := &client{
+		conn:         mockConn,
+		rpcs:         make(chan []hrpc.Call),
+		done:         make(chan struct{}),
+		sent:         make(map[uint32]hrpc.Call),
+		rpcQueueSize: 1,
+	}
+	if s := c.ConnStats(); s != (hrpc.ConnStats{}) {
+		t.Fatalf("expected empty stats, got %+v", s)
+	}
+
+	var written int
+	mockConn.EXPECT().Write(gomock.Any()).Times(2).DoAndReturn(func(b []byte) (int, error) {
+		written += len(b)
+		return len(b), nil
+	})
+	mockConn.EXPECT().SetReadDeadline(gomock.Any()).AnyTimes()
+
+	// round-trip
+	scan, err := hrpc.NewScanStr(context.Background(), "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	scan.SetRegion(reg0)
+	c.QueueRPC(scan)
+	response := scanResponse(t, 1, 3, nil)
+	if err := c.receive(bytes.NewReader(response)); err != nil {
+		t.Fatal(err)
+	}
+	if res := <-scan.ResultChan(); res.Error != nil {
+		t.Fatal(res.Error)
+	}
+	exp := hrpc.ConnStats{
+		BytesSent:     uint64(written),
+		BytesReceived: uint64(len(response)),
+		RPCsCompleted: 1,
+	}
+	if s := c.ConnStats(); s != exp {
+		t.Errorf("expected stats %+v, got %+v", exp, s)
+	}
+
+	// rpc failed because of the connection being closed
+	scan, err = hrpc.NewScanStr(context.Background(), "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	scan.SetRegion(reg0)
+	c.QueueRPC(scan)
+	mockConn.EXPECT().Close()
+	c.Close()
+	if res := <-scan.ResultChan(); res.Error != ErrClientClosed {
+		t.Fatalf("expected %v, got %v", ErrClientClosed, res.Error)
+	}
+	exp.BytesSent = uint64(written)
+	exp.RPCsFailed = 1
+	if s := c.ConnStats(); s != exp {
+		t.Errorf("expected stats %+v, got %+v", exp, s)
+	}
+}
+
 type readBufSizeMatcher struct {
 	l int
 }
@@ -896,9 +1364,10 @@ func TestSanity(t *testing.T) {
 		wg.Done()
 	}()
 
-	// using Append as it returns cellblocks
+	// using Append as it returns cellblocks, without a nonce as it's random
 	app, err := hrpc.NewAppStr(context.Background(), "test1", "yolo",
-		map[string]map[string][]byte{"cf": map[string][]byte{"swag": []byte("meow")}})
+		map[string]map[string][]byte{"cf": map[string][]byte{"swag": []byte("meow")}},
+		hrpc.Nonce(0))
 	if err != nil {
 		t.Fatalf("Failed to create Get request: %s", err)
 	}
@@ -988,9 +1457,10 @@ func TestSanityCompressor(t *testing.T) {
 		wg.Done()
 	}()
 
-	// using Append as it returns cellblocks
+	// using Append as it returns cellblocks, without a nonce as it's random
 	app, err := hrpc.NewAppStr(context.Background(), "test1", "yolo",
-		map[string]map[string][]byte{"cf": map[string][]byte{"swag": []byte("meow")}})
+		map[string]map[string][]byte{"cf": map[string][]byte{"swag": []byte("meow")}},
+		hrpc.Nonce(0))
 	if err != nil {
 		t.Fatalf("Failed to create Get request: %s", err)
 	}
@@ -1265,3 +1735,530 @@ func TestMarshalJSONNilValues(t *testing.T) {
 		t.Fatalf("Did not expect Error to be thrown: %v", err)
 	}
 }
+
+func TestDNSCache(t *testing.T) {
+	var lookups int
+	cache := newDNSCache(time.Minute, func(ctx context.Context, host string) ([]string, error) {
+		lookups++
+		return []string{"127.0.0.1"}, nil
+	})
+	now := time.Now()
+	cache.now = func() time.Time { return now }
+
+	for i := 0; i < 3; i++ {
+		addrs, err := cache.LookupHost(context.Background(), "regionserver")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(addrs) != 1 || addrs[0] != "127.0.0.1" {
+			t.Fatalf("unexpected addresses: %v", addrs)
+		}
+	}
+	if lookups != 1 {
+		t.Fatalf("expected 1 lookup within TTL, got %d", lookups)
+	}
+
+	// IP addresses are not resolved
+	if _, err := cache.LookupHost(context.Background(), "10.0.0.1"); err != nil {
+		t.Fatal(err)
+	}
+	if lookups != 1 {
+		t.Fatalf("expected IP address not to be resolved, got %d lookups", lookups)
+	}
+
+	now = now.Add(time.Minute)
+	if _, err := cache.LookupHost(context.Background(), "regionserver"); err != nil {
+		t.Fatal(err)
+	}
+	if lookups != 2 {
+		t.Fatalf("expected lookup after TTL, got %d lookups", lookups)
+	}
+
+	cache.Invalidate("regionserver")
+	if _, err := cache.LookupHost(context.Background(), "regionserver"); err != nil {
+		t.Fatal(err)
+	}
+	if lookups != 3 {
+		t.Fatalf("expected lookup after invalidation, got %d lookups", lookups)
+	}
+}
+
+func TestDialDNSCache(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, port, err := net.SplitHostPort(ln.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			conn.Close()
+		}
+	}()
+
+	var lookups int
+	cache := newDNSCache(time.Minute, func(ctx context.Context, host string) ([]string, error) {
+		lookups++
+		return []string{"127.0.0.1"}, nil
+	})
+	addr := net.JoinHostPort("regionserver", port)
+	for i := 0; i < 3; i++ {
+		c := &client{addr: addr}
+		WithDNSCache(cache)(c)
+		conn, err := c.dial(context.Background())
+		if err != nil {
+			t.Fatal(err)
+		}
+		conn.Close()
+	}
+	if lookups != 1 {
+		t.Fatalf("expected 1 lookup for repeated connections, got %d", lookups)
+	}
+
+	// failing to connect invalidates the cached addresses
+	ln.Close()
+	c := &client{addr: addr, dnsCache: cache}
+	if _, err := c.dial(context.Background()); err == nil {
+		t.Fatal("expected dial to fail")
+	}
+	if lookups != 1 {
+		t.Fatalf("expected 1 lookup, got %d", lookups)
+	}
+	if _, err := c.dial(context.Background()); err == nil {
+		t.Fatal("expected dial to fail")
+	}
+	if lookups != 2 {
+		t.Fatalf("expected lookup after failed connection, got %d lookups", lookups)
+	}
+}
+
+// socketConn is a connection recording the socket options set on it.
+type socketConn struct {
+	net.Conn
+	readBuffer, writeBuffer int
+	noDelay                 []bool
+}
+
+func (c *socketConn) SetReadBuffer(bytes int) error {
+	c.readBuffer = bytes
+	return nil
+}
+
+func (c *socketConn) SetWriteBuffer(bytes int) error {
+	c.writeBuffer = bytes
+	return nil
+}
+
+func (c *socketConn) SetNoDelay(noDelay bool) error {
+	c.noDelay = append(c.noDelay, noDelay)
+	return nil
+}
+
+func TestSocketOptions(t *testing.T) {
+	dial := func(options ...ClientOption) *socketConn {
+		c := &client{addr: "regionserver:16020"}
+		WithDialer(func(ctx context.Context, network, addr string) (net.Conn, error) {
+			client, server := net.Pipe()
+			server.Close()
+			return &socketConn{Conn: client}, nil
+		})(c)
+		for _, option := range options {
+			option(c)
+		}
+		conn, err := c.dial(context.Background())
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer conn.Close()
+		return conn.(*socketConn)
+	}
+
+	conn := dial(WithSocketBuffers(1<<20, 2<<20), WithNoDelay(false))
+	if conn.readBuffer != 1<<20 || conn.writeBuffer != 2<<20 {
+		t.Errorf("expected buffers of %d and %d bytes, got %d and %d",
+			1<<20, 2<<20, conn.readBuffer, conn.writeBuffer)
+	}
+	if !reflect.DeepEqual([]bool{false}, conn.noDelay) {
+		t.Errorf("expected TCP_NODELAY to be disabled, got %v", conn.noDelay)
+	}
+
+	// the connection is left as is by default
+	conn = dial()
+	if conn.readBuffer != 0 || conn.writeBuffer != 0 || conn.noDelay != nil {
+		t.Errorf("expected no socket options to be set, got %+v", conn)
+	}
+
+	// connections not supporting socket options are left as is
+	c := &client{addr: "regionserver:16020"}
+	WithDialer(func(ctx context.Context, network, addr string) (net.Conn, error) {
+		client, server := net.Pipe()
+		server.Close()
+		return client, nil
+	})(c)
+	WithSocketBuffers(1<<20, 1<<20)(c)
+	if conn, err := c.dial(context.Background()); err != nil {
+		t.Errorf("expected the connection to succeed, got %v", err)
+	} else {
+		conn.Close()
+	}
+}
+
+func TestNotHBaseServer(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ln.Close()
+	// an HTTP server answers the first request with an HTTP response
+	go func() {
+		conn, err := ln.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		var sz [4]byte
+		// skip the hello and the request, which are both prefixed by their size
+		for _, skip := range []int{6, 0} {
+			if _, err := io.CopyN(io.Discard, conn, int64(skip)); err != nil {
+				return
+			}
+			if _, err := io.ReadFull(conn, sz[:]); err != nil {
+				return
+			}
+			if _, err := io.CopyN(io.Discard, conn,
+				int64(binary.BigEndian.Uint32(sz[:]))); err != nil {
+				return
+			}
+		}
+		conn.Write([]byte("HTTP/1.1 400 Bad Request\r\nConnection: close\r\n\r\n"))
+		io.Copy(io.Discard, conn)
+	}()
+
+	c := NewClient(ln.Addr().String(), RegionClient, 1, 0, "root",
+		DefaultReadTimeout, nil)
+	defer c.Close()
+	if err := c.Dial(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	get, err := hrpc.NewGetStr(context.Background(), "test", "row")
+	if err != nil {
+		t.Fatal(err)
+	}
+	get.SetRegion(NewInfo(0, nil, []byte("test"), []byte("test,,1"), nil, nil))
+	c.QueueRPC(get)
+	select {
+	case res := <-get.ResultChan():
+		if res.Error != ErrNotHBaseServer {
+			t.Errorf("expected %v, got %v", ErrNotHBaseServer, res.Error)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for the result")
+	}
+}
+
+func TestContextDeadlines(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ln.Close()
+	// the server stalls in the middle of every response
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				var sz [4]byte
+				// skip the hello, which is prefixed by its size
+				if _, err := io.CopyN(io.Discard, conn, 6); err != nil {
+					return
+				}
+				if _, err := io.ReadFull(conn, sz[:]); err != nil {
+					return
+				}
+				if _, err := io.CopyN(io.Discard, conn,
+					int64(binary.BigEndian.Uint32(sz[:]))); err != nil {
+					return
+				}
+				conn.Write([]byte{0, 0, 0, 100, 1, 2, 3})
+				io.Copy(io.Discard, conn)
+			}()
+		}
+	}()
+
+	send := func(options ...ClientOption) (*hrpc.Get, func()) {
+		c := NewClient(ln.Addr().String(), RegionClient, 1, 0, "root",
+			DefaultReadTimeout, nil, options...)
+		if err := c.Dial(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+		get, err := hrpc.NewGetStr(ctx, "test", "row")
+		if err != nil {
+			t.Fatal(err)
+		}
+		get.SetRegion(NewInfo(0, nil, []byte("test"), []byte("test,,1"), nil, nil))
+		c.QueueRPC(get)
+		return get, func() {
+			cancel()
+			c.Close()
+		}
+	}
+
+	start := time.Now()
+	get, done := send(WithContextDeadlines())
+	defer done()
+	select {
+	case res := <-get.ResultChan():
+		if res.Error != ErrClientClosed {
+			t.Errorf("expected %v, got %v", ErrClientClosed, res.Error)
+		}
+		if elapsed := time.Since(start); elapsed < 100*time.Millisecond {
+			t.Errorf("expected the rpc to fail after its deadline, got %v", elapsed)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected the stalled rpc to fail near its deadline")
+	}
+
+	// by default the connection stays stuck until the read timeout
+	get, done = send()
+	defer done()
+	select {
+	case res := <-get.ResultChan():
+		t.Errorf("unexpected result %v", res)
+	case <-time.After(300 * time.Millisecond):
+	}
+}
+
+func TestCompressionThreshold(t *testing.T) {
+	conn, server := net.Pipe()
+	defer server.Close()
+	cmp := &compressor{Codec: compression.New("snappy")}
+	c := &client{
+		conn:                 conn,
+		done:                 make(chan struct{}),
+		sent:                 make(map[uint32]hrpc.Call),
+		compressor:           cmp,
+		compressionThreshold: 1024,
+	}
+	defer c.Close()
+
+	// receive returns the request read by the server and its decompressed cellblocks
+	receive := func() (*pb.RequestHeader, *pb.MutateRequest, []byte) {
+		var sz [4]byte
+		if _, err := io.ReadFull(server, sz[:]); err != nil {
+			t.Fatal(err)
+		}
+		b := make([]byte, binary.BigEndian.Uint32(sz[:]))
+		if _, err := io.ReadFull(server, b); err != nil {
+			t.Fatal(err)
+		}
+		header := &pb.RequestHeader{}
+		request := &pb.MutateRequest{}
+		for _, m := range []proto.Message{header, request} {
+			v, n := protowire.ConsumeBytes(b)
+			if n < 0 {
+				t.Fatal(protowire.ParseError(n))
+			}
+			if err := proto.Unmarshal(v, m); err != nil {
+				t.Fatal(err)
+			}
+			b = b[n:]
+		}
+		if header.CellBlockMeta == nil {
+			return header, request, nil
+		}
+		if int(header.CellBlockMeta.GetLength()) != len(b) {
+			t.Fatalf("expected %d bytes of cellblocks, got %d",
+				header.CellBlockMeta.GetLength(), len(b))
+		}
+		cellblocks, err := cmp.decompressCellblocks(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return header, request, cellblocks
+	}
+
+	sent := make(chan error, 1)
+	send := func(value []byte) *hrpc.Mutate {
+		put, err := hrpc.NewPutStr(context.Background(), "test", "row",
+			map[string]map[string][]byte{"cf": {"q": value}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		put.SetRegion(NewInfo(0, nil, []byte("test"), []byte("test,,1"), nil, nil))
+		go func() {
+			_, err := c.send(put)
+			sent <- err
+		}()
+		return put
+	}
+
+	// large mutations are sent as compressed cellblocks
+	large := send(bytes.Repeat([]byte("v"), 64*1024))
+	_, request, cellblocks := receive()
+	if err := <-sent; err != nil {
+		t.Fatal(err)
+	}
+	_, expected, _ := large.SerializeCellBlocks(nil)
+	var uncompressed []byte
+	for _, b := range expected {
+		uncompressed = append(uncompressed, b...)
+	}
+	if !bytes.Equal(uncompressed, cellblocks) {
+		t.Errorf("expected decompressed cellblocks to match the cells of the put")
+	}
+	if n := request.GetMutation().GetAssociatedCellCount(); n != 1 {
+		t.Errorf("expected 1 cell in cellblocks, got %d", n)
+	}
+
+	// small ones are sent as plain protobuf
+	send([]byte("v"))
+	header, request, _ := receive()
+	if err := <-sent; err != nil {
+		t.Fatal(err)
+	}
+	if header.CellBlockMeta != nil {
+		t.Errorf("expected no cellblocks, got %v", header.CellBlockMeta)
+	}
+	if values := request.GetMutation().GetColumnValue(); len(values) != 1 ||
+		string(values[0].GetQualifierValue()[0].GetValue()) != "v" {
+		t.Errorf("expected the value to be in protobuf, got %v", values)
+	}
+}
+
+func TestCloseStopsGoroutines(t *testing.T) {
+	// regionservers read everything sent to them until the connection is closed
+	var servers sync.WaitGroup
+	dialer := func(ctx context.Context, network, addr string) (net.Conn, error) {
+		client, server := net.Pipe()
+		servers.Add(1)
+		go func() {
+			defer servers.Done()
+			io.Copy(io.Discard, server)
+			server.Close()
+		}()
+		return client, nil
+	}
+
+	before := runtime.NumGoroutine()
+	for i := 0; i < 100; i++ {
+		c := NewClient("regionserver:1", RegionClient, 100, 10*time.Millisecond, "root",
+			DefaultReadTimeout, nil, WithDialer(dialer))
+		if err := c.Dial(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+		c.Close()
+	}
+	servers.Wait()
+
+	if after := runtime.NumGoroutine(); after > before {
+		t.Errorf("expected at most %d goroutines after closing region clients, got %d",
+			before, after)
+	}
+
+	// closing a client that was never dialed doesn't block
+	c := NewClient("regionserver:1", RegionClient, 100, 10*time.Millisecond, "root",
+		DefaultReadTimeout, nil, WithDialer(dialer))
+	c.Close()
+}
+
+func TestTap(t *testing.T) {
+	ctrl := test.NewController(t)
+	defer ctrl.Finish()
+	mockConn := mock.NewMockConn(ctrl)
+	type frame struct {
+		rpc hrpc.Call
+		dir TapDirection
+		b   []byte
+	}
+	var frames []frame
+	c := &client{
+		conn:         mockConn,
+		rpcs:         make(chan []hrpc.Call),
+		done:         make(chan struct{}),
+		sent:         make(map[uint32]hrpc.Call),
+		rpcQueueSize: 1,
+	}
+	WithTap(func(rpc hrpc.Call, dir TapDirection, b []byte) {
+		frames = append(frames, frame{rpc, dir, append([]byte(nil), b...)})
+	})(c)
+
+	mockConn.EXPECT().Write(gomock.Any()).DoAndReturn(func(b []byte) (int, error) {
+		return len(b), nil
+	})
+	mockConn.EXPECT().SetReadDeadline(gomock.Any()).AnyTimes()
+
+	scan, err := hrpc.NewScanStr(context.Background(), "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	scan.SetRegion(reg0)
+	c.QueueRPC(scan)
+	if err := c.receive(bytes.NewReader(scanResponse(t, 1, 3, nil))); err != nil {
+		t.Fatal(err)
+	}
+	if res := <-scan.ResultChan(); res.Error != nil {
+		t.Fatal(res.Error)
+	}
+
+	if len(frames) != 2 {
+		t.Fatalf("expected 2 frames, got %d", len(frames))
+	}
+	// decode returns the cellblocks following header and msg
+	decode := func(b []byte, header, msg proto.Message) []byte {
+		t.Helper()
+		for _, m := range []proto.Message{header, msg} {
+			v, n := protowire.ConsumeBytes(b)
+			if n < 0 {
+				t.Fatal(protowire.ParseError(n))
+			}
+			if err := proto.Unmarshal(v, m); err != nil {
+				t.Fatal(err)
+			}
+			b = b[n:]
+		}
+		return b
+	}
+
+	req := frames[0]
+	if req.rpc != scan || req.dir != TapRequest {
+		t.Errorf("expected a request of the scan, got %v of %v", req.dir, req.rpc)
+	}
+	var reqHeader pb.RequestHeader
+	var scanReq pb.ScanRequest
+	if cb := decode(req.b, &reqHeader, &scanReq); len(cb) != 0 {
+		t.Errorf("unexpected cellblocks in request: %q", cb)
+	}
+	if reqHeader.GetMethodName() != "Scan" || reqHeader.GetCallId() != 1 {
+		t.Errorf("unexpected request header %v", &reqHeader)
+	}
+	if !proto.Equal(&scanReq, scan.ToProto()) {
+		t.Errorf("expected request %v, got %v", scan.ToProto(), &scanReq)
+	}
+
+	resp := frames[1]
+	if resp.rpc != scan || resp.dir != TapResponse {
+		t.Errorf("expected a response of the scan, got %v of %v", resp.dir, resp.rpc)
+	}
+	var respHeader pb.ResponseHeader
+	var scanResp pb.ScanResponse
+	cb := decode(resp.b, &respHeader, &scanResp)
+	if respHeader.GetCallId() != 1 ||
+		int(respHeader.GetCellBlockMeta().GetLength()) != len(cb) {
+		t.Errorf("unexpected response header %v with %d bytes of cellblocks",
+			&respHeader, len(cb))
+	}
+	if !reflect.DeepEqual(scanResp.GetCellsPerResult(), []uint32{3}) {
+		t.Errorf("expected 3 cells per result, got %v", &scanResp)
+	}
+}
@@ -0,0 +1,148 @@
+// Copyright (C) 2026  The GoHBase Authors.  All rights reserved.
+// This file is part of GoHBase.
+// Use of this source code is governed by the Apache License 2.0
+// that can be found in the COPYING file.
+
+package region
+
+import (
+	"encoding/binary"
+
+	"github.com/baiweiguo/gohbase/hrpc"
+	"github.com/baiweiguo/gohbase/pb"
+	"google.golang.org/protobuf/proto"
+)
+
+// incrementGroup is a group of batched increments of the same cell
+// that are sent to the regionserver as a single increment.
+type incrementGroup struct {
+	// mutation is the sent increment
+	mutation *pb.MutationProto
+	// value is the amount of the sent increment
+	value *pb.MutationProto_ColumnValue_QualifierValue
+	// indices of the calls in the group in the order they were batched
+	indices []uint32
+	amounts []int64
+	sum     int64
+}
+
+// rowKey identifies a row in a region.
+type rowKey struct {
+	region hrpc.RegionInfo
+	row    string
+}
+
+// incrementGroups are groups of increments of cells of rows
+// that can still be coalesced with the following increments.
+type incrementGroups map[rowKey]map[string]*incrementGroup
+
+// coalescable returns the key identifying the cell and the parameters of
+// a single cell increment together with the amount it increments by.
+// ok is false if the mutation isn't such an increment.
+func coalescable(mut *pb.MutationProto) (key string, amount int64, ok bool) {
+	if mut.GetMutateType() != pb.MutationProto_INCREMENT ||
+		len(mut.ColumnValue) != 1 || len(mut.ColumnValue[0].QualifierValue) != 1 {
+		return "", 0, false
+	}
+	qv := mut.ColumnValue[0].QualifierValue[0]
+	if len(qv.Value) != 8 {
+		return "", 0, false
+	}
+	amount = int64(binary.BigEndian.Uint64(qv.Value))
+
+	// everything but the amount and the nonce has to match
+	value, nonce := qv.Value, mut.Nonce
+	qv.Value, mut.Nonce = nil, nil
+	b, err := proto.MarshalOptions{Deterministic: true}.Marshal(mut)
+	qv.Value, mut.Nonce = value, nonce
+	if err != nil {
+		return "", 0, false
+	}
+	return string(b), amount, true
+}
+
+// coalesce adds the call to the group of increments of the same cell and
+// returns true if there already was a call sent on behalf of the group.
+// Any other call for the row ends the groups of the row, so that increments
+// are never reordered with respect to other calls for the same row.
+// msg is the call converted to protobuf, if it had to be converted.
+func (m *multi) coalesce(groups incrementGroups, c hrpc.Call, index uint32) (
+	msg proto.Message, coalesced bool) {
+	rk := rowKey{region: c.Region(), row: string(c.Key())}
+	if !isIncrement(c) {
+		delete(groups, rk)
+		return nil, false
+	}
+	msg = c.ToProto()
+	mut := msg.(*pb.MutateRequest).Mutation
+	key, amount, ok := coalescable(mut)
+	if !ok {
+		delete(groups, rk)
+		return msg, false
+	}
+
+	cells, ok := groups[rk]
+	if !ok {
+		cells = map[string]*incrementGroup{}
+		groups[rk] = cells
+	}
+	g, ok := cells[key]
+	if !ok {
+		// the first increment of the cell, it's sent on behalf of the group
+		g = &incrementGroup{mutation: mut, value: mut.ColumnValue[0].QualifierValue[0]}
+		cells[key] = g
+		if m.coalesced == nil {
+			m.coalesced = make(map[uint32]*incrementGroup)
+		}
+		m.coalesced[index] = g
+	}
+	g.indices = append(g.indices, index)
+	g.amounts = append(g.amounts, amount)
+	g.sum += amount
+	if len(g.indices) > 1 {
+		// the sum of a retried batch can be different, so the regionserver
+		// shouldn't take it for the one it has already applied
+		g.mutation.Nonce = nil
+	}
+
+	// don't modify the value in place as it belongs to the call
+	value := make([]byte, 8)
+	binary.BigEndian.PutUint64(value, uint64(g.sum))
+	g.value.Value = value
+	return msg, len(g.indices) > 1
+}
+
+// returnCoalesced returns the result of the increment sent on behalf of the group
+// to all the calls in it. Each call gets the value the cell would have had right
+// after the call if the increments were sent one by one in the order they were batched.
+func (m *multi) returnCoalesced(g *incrementGroup, r *pb.Result) {
+	cells := r.GetCell()
+	ok := len(cells) == 1 && len(cells[0].Value) == 8
+	var value int64
+	if ok {
+		value = int64(binary.BigEndian.Uint64(cells[0].Value)) - g.sum
+	}
+	for i, index := range g.indices {
+		value += g.amounts[i]
+		res := r
+		if i < len(g.indices)-1 {
+			// the last call in the group gets the result as it is
+			res = proto.Clone(r).(*pb.Result)
+			if ok {
+				res.Cell[0].Value = make([]byte, 8)
+				binary.BigEndian.PutUint64(res.Cell[0].Value, uint64(value))
+			}
+		}
+		c := m.get(index)
+		response := c.NewResponse()
+		response.(*pb.MutateResponse).Result = res
+		c.ResultChan() <- hrpc.RPCResult{Msg: response}
+	}
+}
+
+// failCoalesced returns the error to all the calls in the group.
+func (m *multi) failCoalesced(g *incrementGroup, err error) {
+	for _, index := range g.indices {
+		m.get(index).ResultChan() <- hrpc.RPCResult{Error: err}
+	}
+}
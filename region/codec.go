@@ -0,0 +1,106 @@
+// Copyright (C) 2026  The GoHBase Authors.  All rights reserved.
+// This file is part of GoHBase.
+// Use of this source code is governed by the Apache License 2.0
+// that can be found in the COPYING file.
+
+package region
+
+import (
+	"fmt"
+
+	"github.com/baiweiguo/gohbase/pb"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+)
+
+// RPCCodec encodes requests and decodes responses of rpcs exchanged with
+// a RegionServer. It frames the header and the request or response of an rpc,
+// while the client takes care of the length of the frames and the cellblocks
+// following them. It allows to support other revisions of the RPC protocol.
+type RPCCodec interface {
+	// AppendRequest appends the encoded header and request of an rpc to b.
+	AppendRequest(b []byte, header *pb.RequestHeader, request proto.Message) ([]byte, error)
+	// DecodeResponseHeader decodes the header of a response at the start of b
+	// and returns the number of bytes it took.
+	DecodeResponseHeader(b []byte, header *pb.ResponseHeader) (int, error)
+	// DecodeResponse decodes the response following the header of a response
+	// at the start of b and returns the number of bytes it took.
+	DecodeResponse(b []byte, response proto.Message) (int, error)
+}
+
+// ProtobufCodec is the default RPCCodec. It encodes headers, requests and
+// responses as protobuf messages, each preceded by its length as a varint.
+type ProtobufCodec struct{}
+
+// AppendRequest appends the varint-delimited header and request to b.
+func (ProtobufCodec) AppendRequest(b []byte, header *pb.RequestHeader,
+	request proto.Message) ([]byte, error) {
+	headerSize := proto.Size(header)
+	requestSize := proto.Size(request)
+	protobufLen := protowire.SizeVarint(uint64(headerSize)) + headerSize +
+		protowire.SizeVarint(uint64(requestSize)) + requestSize
+	if cap(b)-len(b) < protobufLen {
+		nb := make([]byte, len(b), len(b)+protobufLen)
+		copy(nb, b)
+		b = nb
+	}
+	expected := len(b) + protobufLen
+
+	var err error
+	b = protowire.AppendVarint(b, uint64(headerSize))
+	b, err = proto.MarshalOptions{UseCachedSize: true}.MarshalAppend(b, header)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request header: %s", err)
+	}
+
+	b = protowire.AppendVarint(b, uint64(requestSize))
+	b, err = proto.MarshalOptions{UseCachedSize: true}.MarshalAppend(b, request)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %s", err)
+	}
+	if len(b) != expected {
+		// This shouldn't ever happen, if it does there is a bug in
+		// the size calculation.
+		panic(fmt.Errorf("size of data sent doesn't match expected. expected: %d actual: %d"+
+			"headerSize: %d requestSize: %d\nheader: %s\nrequest: %s",
+			expected, len(b), headerSize, requestSize, header, request))
+	}
+	return b, nil
+}
+
+// DecodeResponseHeader decodes the varint-delimited header at the start of b.
+func (ProtobufCodec) DecodeResponseHeader(b []byte, header *pb.ResponseHeader) (int, error) {
+	return consumeMessage(b, header)
+}
+
+// DecodeResponse decodes the varint-delimited response at the start of b.
+func (ProtobufCodec) DecodeResponse(b []byte, response proto.Message) (int, error) {
+	return consumeMessage(b, response)
+}
+
+func consumeMessage(b []byte, m proto.Message) (int, error) {
+	v, n := protowire.ConsumeBytes(b)
+	if n < 0 {
+		return 0, protowire.ParseError(n)
+	}
+	if err := proto.Unmarshal(v, m); err != nil {
+		return 0, err
+	}
+	return n, nil
+}
+
+// WithRPCCodec returns an option that makes the client encode requests and
+// decode responses with codec. ProtobufCodec is used by default.
+func WithRPCCodec(codec RPCCodec) ClientOption {
+	return func(c *client) {
+		c.rpcCodec = codec
+	}
+}
+
+// codec returns the RPCCodec of the client.
+func (c *client) codec() RPCCodec {
+	if c.rpcCodec == nil {
+		return ProtobufCodec{}
+	}
+	return c.rpcCodec
+}
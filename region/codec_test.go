@@ -0,0 +1,144 @@
+// Copyright (C) 2026  The GoHBase Authors.  All rights reserved.
+// This file is part of GoHBase.
+// Use of this source code is governed by the Apache License 2.0
+// that can be found in the COPYING file.
+
+package region
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"testing"
+
+	"github.com/baiweiguo/gohbase/hrpc"
+	"github.com/baiweiguo/gohbase/pb"
+	"github.com/baiweiguo/gohbase/test"
+	"github.com/baiweiguo/gohbase/test/mock"
+	"github.com/golang/mock/gomock"
+	"google.golang.org/protobuf/proto"
+)
+
+// fixedLengthCodec precedes messages with their length as 4 bytes instead of a varint.
+type fixedLengthCodec struct {
+	requests, responses int
+}
+
+func appendFixedLength(b []byte, m proto.Message) ([]byte, error) {
+	data, err := proto.Marshal(m)
+	if err != nil {
+		return nil, err
+	}
+	var size [4]byte
+	binary.BigEndian.PutUint32(size[:], uint32(len(data)))
+	b = append(b, size[:]...)
+	return append(b, data...), nil
+}
+
+func consumeFixedLength(b []byte, m proto.Message) (int, error) {
+	if len(b) < 4 {
+		return 0, fmt.Errorf("short message of %d bytes", len(b))
+	}
+	n := int(binary.BigEndian.Uint32(b))
+	if len(b) < 4+n {
+		return 0, fmt.Errorf("short message of %d bytes, expected %d", len(b), 4+n)
+	}
+	return 4 + n, proto.Unmarshal(b[4:4+n], m)
+}
+
+func (c *fixedLengthCodec) AppendRequest(b []byte, header *pb.RequestHeader,
+	request proto.Message) ([]byte, error) {
+	c.requests++
+	b, err := appendFixedLength(b, header)
+	if err != nil {
+		return nil, err
+	}
+	return appendFixedLength(b, request)
+}
+
+func (c *fixedLengthCodec) DecodeResponseHeader(b []byte, header *pb.ResponseHeader) (int, error) {
+	return consumeFixedLength(b, header)
+}
+
+func (c *fixedLengthCodec) DecodeResponse(b []byte, response proto.Message) (int, error) {
+	c.responses++
+	return consumeFixedLength(b, response)
+}
+
+func TestRPCCodec(t *testing.T) {
+	ctrl := test.NewController(t)
+	defer ctrl.Finish()
+	mockConn := mock.NewMockConn(ctrl)
+	c := &client{
+		conn:         mockConn,
+		rpcs:         make(chan []hrpc.Call),
+		done:         make(chan struct{}),
+		sent:         make(map[uint32]hrpc.Call),
+		rpcQueueSize: 1,
+	}
+	codec := &fixedLengthCodec{}
+	WithRPCCodec(codec)(c)
+
+	var written []byte
+	mockConn.EXPECT().Write(gomock.Any()).DoAndReturn(func(b []byte) (int, error) {
+		written = append(written, b...)
+		return len(b), nil
+	})
+	mockConn.EXPECT().SetReadDeadline(gomock.Any()).AnyTimes()
+
+	get, err := hrpc.NewGetStr(context.Background(), "test", "row")
+	if err != nil {
+		t.Fatal(err)
+	}
+	get.SetRegion(reg0)
+	c.QueueRPC(get)
+
+	// the request is framed by the codec
+	if n := int(binary.BigEndian.Uint32(written)); n != len(written)-4 {
+		t.Fatalf("expected a frame of %d bytes, got %d", len(written)-4, n)
+	}
+	var reqHeader pb.RequestHeader
+	n, err := consumeFixedLength(written[4:], &reqHeader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var getReq pb.GetRequest
+	if _, err := consumeFixedLength(written[4+n:], &getReq); err != nil {
+		t.Fatal(err)
+	}
+	if reqHeader.GetMethodName() != "Get" || reqHeader.GetCallId() != 1 {
+		t.Errorf("unexpected request header %v", &reqHeader)
+	}
+	if !proto.Equal(&getReq, get.ToProto()) {
+		t.Errorf("expected request %v, got %v", get.ToProto(), &getReq)
+	}
+
+	// and so is the response
+	frame, err := appendFixedLength(nil, &pb.ResponseHeader{CallId: proto.Uint32(1)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	frame, err = appendFixedLength(frame, &pb.GetResponse{Result: &pb.Result{
+		Cell: []*pb.Cell{{Row: []byte("row"), Value: []byte("value")}},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	response := make([]byte, 4, 4+len(frame))
+	binary.BigEndian.PutUint32(response, uint32(len(frame)))
+	if err := c.receive(bytes.NewReader(append(response, frame...))); err != nil {
+		t.Fatal(err)
+	}
+	res := <-get.ResultChan()
+	if res.Error != nil {
+		t.Fatal(res.Error)
+	}
+	if v := res.Msg.(*pb.GetResponse).GetResult().GetCell()[0].GetValue(); string(v) != "value" {
+		t.Errorf("expected value %q, got %q", "value", v)
+	}
+	if codec.requests != 1 || codec.responses != 1 {
+		t.Errorf("expected the codec to encode 1 request and decode 1 response, "+
+			"got %d and %d", codec.requests, codec.responses)
+	}
+}
@@ -0,0 +1,130 @@
+// Copyright (C) 2026  The GoHBase Authors.  All rights reserved.
+// This file is part of GoHBase.
+// Use of this source code is governed by the Apache License 2.0
+// that can be found in the COPYING file.
+
+package region
+
+import (
+	"context"
+	"net"
+	"sync"
+	"time"
+)
+
+// DNSCache caches resolved addresses of RegionServer hostnames for a short time,
+// so that connecting to the same host again doesn't need to resolve it.
+// It's safe for concurrent use and is meant to be shared by region clients.
+type DNSCache struct {
+	ttl    time.Duration
+	lookup func(ctx context.Context, host string) ([]string, error)
+	now    func() time.Time
+
+	m       sync.Mutex
+	entries map[string]dnsEntry
+}
+
+type dnsEntry struct {
+	addrs   []string
+	expires time.Time
+}
+
+// NewDNSCache returns a DNSCache that keeps resolved addresses for ttl.
+func NewDNSCache(ttl time.Duration) *DNSCache {
+	return newDNSCache(ttl, net.DefaultResolver.LookupHost)
+}
+
+func newDNSCache(ttl time.Duration,
+	lookup func(ctx context.Context, host string) ([]string, error)) *DNSCache {
+	return &DNSCache{
+		ttl:     ttl,
+		lookup:  lookup,
+		now:     time.Now,
+		entries: make(map[string]dnsEntry),
+	}
+}
+
+// LookupHost returns the addresses of host, resolving it
+// only if it isn't cached or has been cached for longer than TTL.
+func (d *DNSCache) LookupHost(ctx context.Context, host string) ([]string, error) {
+	if net.ParseIP(host) != nil {
+		// nothing to resolve
+		return []string{host}, nil
+	}
+	d.m.Lock()
+	e, ok := d.entries[host]
+	d.m.Unlock()
+	if ok && d.now().Before(e.expires) {
+		return e.addrs, nil
+	}
+
+	addrs, err := d.lookup(ctx, host)
+	if err != nil {
+		return nil, err
+	}
+	d.m.Lock()
+	d.entries[host] = dnsEntry{addrs: addrs, expires: d.now().Add(d.ttl)}
+	d.m.Unlock()
+	return addrs, nil
+}
+
+// Invalidate removes the cached addresses of host,
+// so that it's resolved again next time.
+func (d *DNSCache) Invalidate(host string) {
+	d.m.Lock()
+	delete(d.entries, host)
+	d.m.Unlock()
+}
+
+// WithDNSCache returns an option that makes the client resolve the hostname
+// of the RegionServer with the given cache when connecting to it.
+func WithDNSCache(cache *DNSCache) ClientOption {
+	return func(c *client) {
+		c.dnsCache = cache
+	}
+}
+
+// dial connects to the RegionServer and sets the socket options
+// of the client on the connection.
+func (c *client) dial(ctx context.Context) (net.Conn, error) {
+	conn, err := c.dialAddr(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.setSocketOptions(conn); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	return conn, nil
+}
+
+// dialAddr connects to the RegionServer, resolving its hostname
+// with the DNS cache of the client if it has one.
+func (c *client) dialAddr(ctx context.Context) (net.Conn, error) {
+	dial := c.dialer
+	if dial == nil {
+		var d net.Dialer
+		dial = d.DialContext
+	}
+	if c.dnsCache == nil {
+		return dial(ctx, "tcp", c.addr)
+	}
+	host, port, err := net.SplitHostPort(c.addr)
+	if err != nil {
+		return nil, err
+	}
+	addrs, err := c.dnsCache.LookupHost(ctx, host)
+	if err != nil {
+		return nil, err
+	}
+	for _, addr := range addrs {
+		var conn net.Conn
+		conn, err = dial(ctx, "tcp", net.JoinHostPort(addr, port))
+		if err == nil {
+			return conn, nil
+		}
+	}
+	// the cached addresses might be stale
+	c.dnsCache.Invalidate(host)
+	return nil, err
+}
@@ -9,9 +9,14 @@ package region
 import (
 	"bytes"
 	"context"
+	"crypto/md5"
 	"encoding/binary"
+	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"strconv"
+	"strings"
 	"sync"
 
 	"github.com/baiweiguo/gohbase/hrpc"
@@ -21,6 +26,10 @@ import (
 
 var defaultNamespace = []byte("default")
 
+// ErrMalformedMetaRow is wrapped by the errors returned by ParseRegionInfo
+// when a row of the meta table can't be parsed.
+var ErrMalformedMetaRow = errors.New("malformed row of hbase:meta")
+
 // OfflineRegionError is returned if region is offline
 type OfflineRegionError struct {
 	n string
@@ -41,6 +50,8 @@ type info struct {
 	specifier *pb.RegionSpecifier
 	ctx       context.Context
 	cancel    context.CancelFunc
+	// replicas are the addresses of the replicas indexed by replica ID
+	replicas []string
 
 	// The attributes before this mutex are supposed to be immutable.
 	// The attributes defined below can be changed and accesses must
@@ -80,19 +91,22 @@ func NewInfo(id uint64, namespace, table, name, startKey, stopKey []byte) hrpc.R
 func infoFromCell(cell *hrpc.Cell) (hrpc.RegionInfo, error) {
 	value := cell.Value
 	if len(value) == 0 {
-		return nil, fmt.Errorf("empty value in %q", cell)
+		return nil, fmt.Errorf("empty value in %q: %w", cell, ErrMalformedMetaRow)
 	} else if value[0] != 'P' {
-		return nil, fmt.Errorf("unsupported region info version %d in %q", value[0], cell)
+		return nil, fmt.Errorf("unsupported region info version %d in %q: %w",
+			value[0], cell, ErrMalformedMetaRow)
 	}
 	const pbufMagic = 1346524486 // 4 bytes: "PBUF"
-	magic := binary.BigEndian.Uint32(value[:4])
-	if magic != pbufMagic {
-		return nil, fmt.Errorf("invalid magic number in %q", cell)
+	if len(value) < 4 || binary.BigEndian.Uint32(value[:4]) != pbufMagic {
+		return nil, fmt.Errorf("invalid magic number in %q: %w", cell, ErrMalformedMetaRow)
 	}
 	var regInfo pb.RegionInfo
 	err := proto.Unmarshal(value[4:], &regInfo)
 	if err != nil {
-		return nil, fmt.Errorf("failed to decode %q: %s", cell, err)
+		return nil, fmt.Errorf("failed to decode %q: %s: %w", cell, err, ErrMalformedMetaRow)
+	}
+	if regInfo.TableName == nil {
+		return nil, fmt.Errorf("no table name in %q: %w", cell, ErrMalformedMetaRow)
 	}
 	if regInfo.GetOffline() {
 		return nil, OfflineRegionError{n: string(cell.Row)}
@@ -113,13 +127,32 @@ func infoFromCell(cell *hrpc.Cell) (hrpc.RegionInfo, error) {
 	), nil
 }
 
-// ParseRegionInfo parses the contents of a row from the meta table.
+// ParseRegionInfo parses the contents of a row from the meta table,
+// as returned by a Get or a Scan of the info family of hbase:meta.
 // It's guaranteed to return a region info and a host:port OR return an error.
+// The error wraps ErrMalformedMetaRow if the row can't be parsed, or is
+// an OfflineRegionError if the region is offline.
 func ParseRegionInfo(metaRow *hrpc.Result) (hrpc.RegionInfo, string, error) {
 	var reg hrpc.RegionInfo
 	var addr string
+	var replicas []string
 
+	if metaRow == nil {
+		return nil, "", fmt.Errorf("no row: %w", ErrMalformedMetaRow)
+	}
 	for _, cell := range metaRow.Cells {
+		if q := string(cell.Qualifier); strings.HasPrefix(q, "server_") {
+			// server of a replica, e.g. server_0001 for replica 1
+			id, err := strconv.ParseUint(q[len("server_"):], 16, 16)
+			if err != nil || id == 0 || len(cell.Value) == 0 {
+				continue
+			}
+			for len(replicas) <= int(id) {
+				replicas = append(replicas, "")
+			}
+			replicas[id] = string(cell.Value)
+			continue
+		}
 		switch string(cell.Qualifier) {
 		case "regioninfo":
 			var err error
@@ -144,14 +177,39 @@ func ParseRegionInfo(metaRow *hrpc.Result) (hrpc.RegionInfo, string, error) {
 
 	if reg == nil {
 		// There was no region in the row in meta, this is really not expected.
-		return nil, "", fmt.Errorf("meta seems to be broken, there was no region in %v", metaRow)
+		return nil, "", fmt.Errorf("meta seems to be broken, there was no region in %v: %w",
+			metaRow, ErrMalformedMetaRow)
 	}
 	if len(addr) == 0 {
 		return nil, "", fmt.Errorf("meta doesn't have a server location in %v", metaRow)
 	}
+	if replicas == nil {
+		replicas = []string{addr}
+	} else {
+		replicas[0] = addr
+	}
+	reg.(*info).replicas = replicas
 	return reg, addr, nil
 }
 
+// NewReplicaInfo creates the region info of the replica with the given ID of
+// reg, which has the same keys but its own name. Replica 0 is reg itself.
+func NewReplicaInfo(reg hrpc.RegionInfo, replicaID int) hrpc.RegionInfo {
+	if replicaID == 0 {
+		return reg
+	}
+	table := reg.Table()
+	if ns := reg.Namespace(); ns != nil {
+		table = append(append(append([]byte(nil), ns...), ':'), table...)
+	}
+	// region names are "table,startKey,id_replica.md5(table,startKey,id_replica)."
+	name := fmt.Sprintf("%s,%s,%d_%04X", table, reg.StartKey(), reg.ID(), replicaID)
+	sum := md5.Sum([]byte(name))
+	name += "." + hex.EncodeToString(sum[:]) + "."
+	return NewInfo(reg.ID(), reg.Namespace(), reg.Table(), []byte(name),
+		reg.StartKey(), reg.StopKey())
+}
+
 // IsUnavailable returns true if this region has been marked as unavailable.
 func (i *info) IsUnavailable() bool {
 	i.m.RLock()
@@ -163,6 +221,7 @@ func (i *info) IsUnavailable() bool {
 // AvailabilityChan returns a channel that can be used to wait on for
 // notification that a connection to this region has been reestablished.
 // If this region is not marked as unavailable, nil will be returned.
+// See hrpc.RegionInfo for how to select on it.
 func (i *info) AvailabilityChan() <-chan struct{} {
 	i.m.RLock()
 	ch := i.available
@@ -221,6 +280,13 @@ func (i *info) Name() []byte {
 	return i.name
 }
 
+// Replicas returns the addresses of the RegionServers of the replicas of the
+// region indexed by replica ID as found in hbase:meta, replica 0 being the
+// primary region. Replicas that aren't assigned have empty addresses.
+func (i *info) Replicas() []string {
+	return i.replicas
+}
+
 // RegionSpecifier returns the RegionSpecifier proto for this region
 func (i *info) RegionSpecifier() *pb.RegionSpecifier {
 	return i.specifier
@@ -9,6 +9,8 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
+	"reflect"
 	"strings"
 	"testing"
 	"time"
@@ -85,6 +87,145 @@ func TestInfoFromMeta(t *testing.T) {
 	}
 }
 
+func TestParseRegionInfo(t *testing.T) {
+	regionName := []byte("table,,1431921690563.53e41f94d5c3087af0d13259b8c4186d.")
+	regionInfo := func(value string) *hrpc.Cell {
+		return &hrpc.Cell{
+			Row:       regionName,
+			Family:    []byte("info"),
+			Qualifier: []byte("regioninfo"),
+			Value:     []byte(value),
+		}
+	}
+	server := func(value string) *hrpc.Cell {
+		return &hrpc.Cell{
+			Row:       regionName,
+			Family:    []byte("info"),
+			Qualifier: []byte("server"),
+			Value:     []byte(value),
+		}
+	}
+	const validInfo = "PBUF\010\303\217\274\251\326)\022\020\n\007default" +
+		"\022\005table\032\000\"\000(\0000\0008\000"
+
+	reg, addr, err := ParseRegionInfo(&hrpc.Result{
+		Cells: []*hrpc.Cell{regionInfo(validInfo), server("regionserver:16020")}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(reg.Name(), regionName) {
+		t.Errorf("Unexpected region name: %q", reg.Name())
+	}
+	if !bytes.Equal(reg.Table(), []byte("table")) {
+		t.Errorf("Unexpected table: %q", reg.Table())
+	}
+	if addr != "regionserver:16020" {
+		t.Errorf("Unexpected address: %q", addr)
+	}
+
+	malformed := map[string]*hrpc.Result{
+		"no row":            nil,
+		"no region info":    {Cells: []*hrpc.Cell{server("regionserver:16020")}},
+		"short region info": {Cells: []*hrpc.Cell{regionInfo("PB"), server("regionserver:16020")}},
+		"no table name": {Cells: []*hrpc.Cell{
+			regionInfo("PBUF\010\001"), server("regionserver:16020")}},
+	}
+	for name, row := range malformed {
+		t.Run(name, func(t *testing.T) {
+			_, _, err := ParseRegionInfo(row)
+			if !errors.Is(err, ErrMalformedMetaRow) {
+				t.Errorf("Expected %v, got %v", ErrMalformedMetaRow, err)
+			}
+		})
+	}
+
+	// a region without location is being moved, the row isn't malformed
+	_, _, err = ParseRegionInfo(&hrpc.Result{Cells: []*hrpc.Cell{regionInfo(validInfo)}})
+	if err == nil || errors.Is(err, ErrMalformedMetaRow) {
+		t.Errorf("Unexpected error for region without location: %v", err)
+	}
+}
+
+func TestParseRegionInfoReplicas(t *testing.T) {
+	regionName := []byte("table,,1431921690563.53e41f94d5c3087af0d13259b8c4186d.")
+	cell := func(qualifier, value string) *hrpc.Cell {
+		return &hrpc.Cell{
+			Row:       regionName,
+			Family:    []byte("info"),
+			Qualifier: []byte(qualifier),
+			Value:     []byte(value),
+		}
+	}
+	const validInfo = "PBUF\010\303\217\274\251\326)\022\020\n\007default" +
+		"\022\005table\032\000\"\000(\0000\0008\000"
+
+	reg, _, err := ParseRegionInfo(&hrpc.Result{Cells: []*hrpc.Cell{
+		cell("regioninfo", validInfo),
+		cell("server", "regionserver:1"),
+		cell("server_0002", "regionserver:3"),
+		cell("seqnumDuringOpen_0002", "\x00\x00\x00\x00\x00\x00\x00\x02"),
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// replica 1 isn't assigned
+	exp := []string{"regionserver:1", "", "regionserver:3"}
+	if !reflect.DeepEqual(exp, reg.Replicas()) {
+		t.Errorf("expected replicas %q, got %q", exp, reg.Replicas())
+	}
+
+	if r := NewReplicaInfo(reg, 0); r != reg {
+		t.Errorf("expected replica 0 to be the region itself, got %s", r)
+	}
+	r := NewReplicaInfo(reg, 1)
+	expName := "table,,1431921690563_0001.eeecefd7e7c511a2c11d2ebe67c8eb4e."
+	if string(r.Name()) != expName {
+		t.Errorf("expected replica name %q, got %q", expName, r.Name())
+	}
+	if !bytes.Equal(r.StartKey(), reg.StartKey()) || !bytes.Equal(r.StopKey(), reg.StopKey()) {
+		t.Errorf("expected replica to have the keys of %s, got %s", reg, r)
+	}
+}
+
+func TestAvailabilityChanSelect(t *testing.T) {
+	reg := NewInfo(0, nil, []byte("test"), []byte("test,,1234567890042.yoloyoloyoloyoloyoloyolo."),
+		nil, nil)
+	if ch := reg.AvailabilityChan(); ch != nil {
+		t.Fatal("expected no availability channel for an available region")
+	}
+
+	// wait selects on availability of the region and cancellation by the caller
+	wait := func(cancel <-chan struct{}) error {
+		ch := reg.AvailabilityChan()
+		if ch == nil {
+			return nil
+		}
+		select {
+		case <-ch:
+			return nil
+		case <-cancel:
+			return context.Canceled
+		}
+	}
+
+	reg.MarkUnavailable()
+	cancel := make(chan struct{})
+	close(cancel)
+	if err := wait(cancel); err != context.Canceled {
+		t.Errorf("expected %v waiting for an unavailable region, got %v", context.Canceled, err)
+	}
+
+	res := make(chan error, 1)
+	go func() { res <- wait(make(chan struct{})) }()
+	reg.MarkAvailable()
+	if err := <-res; err != nil {
+		t.Errorf("expected no error waiting for a region that became available, got %v", err)
+	}
+	if err := wait(cancel); err != nil {
+		t.Errorf("expected no error waiting for an available region, got %v", err)
+	}
+}
+
 func TestCompare(t *testing.T) {
 	// Test cases from AsyncHBase
 	testcases := []struct {
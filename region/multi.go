@@ -32,14 +32,26 @@ func freeMulti(m *multi) {
 	// set m.regions to nil because the slice is not reused.
 	m.regions = nil
 	m.size = 0
+	m.bytes = 0
+	m.coalesceIncrements = false
+	m.coalesced = nil
 	multiPool.Put(m)
 }
 
 type multi struct {
-	size  int
+	size int
+	// bytes is the estimated size of the calls, see requestSize
+	bytes int64
 	calls []hrpc.Call
 	// regions preserves the order of regions to match against RegionActionResults
 	regions []hrpc.RegionInfo
+
+	// coalesceIncrements enables sending batched increments of the same cell
+	// as a single increment
+	coalesceIncrements bool
+	// coalesced maps indices of calls sent on behalf of groups
+	// of coalesced increments to the groups
+	coalesced map[uint32]*incrementGroup
 }
 
 func newMulti(queueSize int) *multi {
@@ -76,6 +88,12 @@ func (m *multi) toProto(isCellblocks bool, cbs [][]byte) (proto.Message, [][]byt
 
 	pbActions := make([]pb.Action, len(m.calls))
 	indices := make([]uint32, len(m.calls))
+	var groups incrementGroups
+	var nonceGroup *uint64
+	if m.coalesceIncrements {
+		groups = incrementGroups{}
+		m.coalesced = nil
+	}
 	for i, c := range m.calls {
 		if c.Context().Err() != nil {
 			// context has expired, don't bother sending it
@@ -83,19 +101,30 @@ func (m *multi) toProto(isCellblocks bool, cbs [][]byte) (proto.Message, [][]byt
 			continue
 		}
 
+		var msg proto.Message
+		if m.coalesceIncrements {
+			// increments are sent as protobuf to be able to sum them up
+			var coalesced bool
+			if msg, coalesced = m.coalesce(groups, c, uint32(i)+1); coalesced {
+				// has been summed up into an increment of an earlier call
+				continue
+			}
+		}
+
 		as, ok := actionsPerReg[c.Region()]
 		if !ok {
 			as = &actions{}
 			actionsPerReg[c.Region()] = as
 		}
 
-		var msg proto.Message
-		if s, ok := c.(canSerializeCellBlocks); isCellblocks && ok && s.CellBlocksEnabled() {
-			var sz uint32
-			msg, as.cellblocks, sz = s.SerializeCellBlocks(as.cellblocks)
-			size += sz
-		} else {
-			msg = c.ToProto()
+		if msg == nil {
+			if s, ok := c.(canSerializeCellBlocks); isCellblocks && ok && s.CellBlocksEnabled() {
+				var sz uint32
+				msg, as.cellblocks, sz = s.SerializeCellBlocks(as.cellblocks)
+				size += sz
+			} else {
+				msg = c.ToProto()
+			}
 		}
 
 		a := &pbActions[i]
@@ -107,6 +136,9 @@ func (m *multi) toProto(isCellblocks bool, cbs [][]byte) (proto.Message, [][]byt
 			a.Get = r.Get
 		case *pb.MutateRequest:
 			a.Mutation = r.Mutation
+			if r.NonceGroup != nil {
+				nonceGroup = r.NonceGroup
+			}
 		default:
 			panic(fmt.Sprintf("unsupported call type for Multi: %T", c))
 		}
@@ -140,7 +172,7 @@ func (m *multi) toProto(isCellblocks bool, cbs [][]byte) (proto.Message, [][]byt
 		m.regions[i] = r
 		i++
 	}
-	return &pb.MultiRequest{RegionAction: ra}, cbs, size
+	return &pb.MultiRequest{RegionAction: ra, NonceGroup: nonceGroup}, cbs, size
 }
 
 func (m *multi) SerializeCellBlocks(cbs [][]byte) (proto.Message, [][]byte, uint32) {
@@ -256,6 +288,15 @@ func (m *multi) returnResults(msg proto.Message, err error) {
 
 			// TODO: don't bother if the call's context has already expired
 
+			if g, ok := m.coalesced[i]; ok {
+				if e != nil {
+					m.failCoalesced(g, exceptionToError(*e.Name, string(e.Value)))
+				} else {
+					m.returnCoalesced(g, r)
+				}
+				continue
+			}
+
 			if e != nil {
 				c.ResultChan() <- hrpc.RPCResult{
 					Error: exceptionToError(*e.Name, string(e.Value)),
@@ -269,6 +310,10 @@ func (m *multi) returnResults(msg proto.Message, err error) {
 				rsp.Result = r
 			case *pb.MutateResponse:
 				rsp.Result = r
+				// like a Mutate sent on its own, report applied Puts and Deletes as processed
+				if d := c.Description(); d == "PUT" || d == "DELETE" {
+					rsp.Processed = proto.Bool(true)
+				}
 			default:
 				panic(fmt.Sprintf("unsupported response type for Multi: %T", response))
 			}
@@ -278,6 +323,12 @@ func (m *multi) returnResults(msg proto.Message, err error) {
 	}
 }
 
+// isIncrement returns true if the call is an Increment.
+func isIncrement(c hrpc.Call) bool {
+	m, ok := c.(*hrpc.Mutate)
+	return ok && m.Description() == pb.MutationProto_INCREMENT.String()
+}
+
 // add adds the call and returns wether the batch is full.
 func (m *multi) add(calls []hrpc.Call) bool {
 	m.calls = append(m.calls, calls...)
@@ -337,3 +388,20 @@ func (m *multi) Key() []byte {
 func (m *multi) callCount() int {
 	return len(m.calls)
 }
+
+// atomicMultiError returns the first exception of the response of a multi
+// request sent as a single call, such as hrpc.CheckAndMutate, as the call
+// fails as a whole.
+func atomicMultiError(mr *pb.MultiResponse) error {
+	for _, rar := range mr.GetRegionActionResult() {
+		if e := rar.GetException(); e != nil {
+			return exceptionToError(e.GetName(), string(e.GetValue()))
+		}
+		for _, roe := range rar.GetResultOrException() {
+			if e := roe.GetException(); e != nil {
+				return exceptionToError(e.GetName(), string(e.GetValue()))
+			}
+		}
+	}
+	return nil
+}
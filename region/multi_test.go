@@ -8,12 +8,15 @@ package region
 import (
 	"bytes"
 	"context"
+	"encoding/binary"
 	"errors"
 	"fmt"
+	"reflect"
 	"sort"
 	"strconv"
 	"testing"
 
+	"github.com/baiweiguo/gohbase/filter"
 	"github.com/baiweiguo/gohbase/hrpc"
 	"github.com/baiweiguo/gohbase/pb"
 	"github.com/baiweiguo/gohbase/test"
@@ -125,15 +128,18 @@ func TestMultiToProto(t *testing.T) {
 				cs[0].SetRegion(reg0)
 				cs[1], _ = hrpc.NewPutStr(context.Background(), "reg0", "call1", values)
 				cs[1].SetRegion(reg0)
-				cs[2], _ = hrpc.NewAppStr(context.Background(), "reg1", "call2", values)
+				cs[2], _ = hrpc.NewAppStr(context.Background(), "reg1", "call2", values,
+					hrpc.Nonce(1))
 				cs[2].SetRegion(reg1)
 				cs[3], _ = hrpc.NewDelStr(context.Background(), "reg1", "call3", delValues)
 				cs[3].SetRegion(reg1)
-				cs[4], _ = hrpc.NewIncStr(context.Background(), "reg2", "call4", delValues)
+				cs[4], _ = hrpc.NewIncStr(context.Background(), "reg2", "call4", delValues,
+					hrpc.Nonce(2))
 				cs[4].SetRegion(reg2)
 				return cs
 			}(),
 			out: &pb.MultiRequest{
+				NonceGroup: proto.Uint64(hrpc.NonceGroup()),
 				RegionAction: []*pb.RegionAction{
 					&pb.RegionAction{
 						Region: &pb.RegionSpecifier{
@@ -160,6 +166,7 @@ func TestMultiToProto(t *testing.T) {
 							&pb.Action{Index: proto.Uint32(3), Mutation: &pb.MutationProto{
 								Row:         []byte("call2"),
 								MutateType:  pb.MutationProto_APPEND.Enum(),
+								Nonce:       proto.Uint64(1),
 								Durability:  pb.MutationProto_USE_DEFAULT.Enum(),
 								ColumnValue: valuesProto,
 							}},
@@ -180,6 +187,7 @@ func TestMultiToProto(t *testing.T) {
 							&pb.Action{Index: proto.Uint32(5), Mutation: &pb.MutationProto{
 								Row:         []byte("call4"),
 								MutateType:  pb.MutationProto_INCREMENT.Enum(),
+								Nonce:       proto.Uint64(2),
 								Durability:  pb.MutationProto_USE_DEFAULT.Enum(),
 								ColumnValue: appendProto,
 							}},
@@ -188,6 +196,7 @@ func TestMultiToProto(t *testing.T) {
 				},
 			},
 			cellblocksProto: &pb.MultiRequest{
+				NonceGroup: proto.Uint64(hrpc.NonceGroup()),
 				RegionAction: []*pb.RegionAction{
 					&pb.RegionAction{
 						Region: &pb.RegionSpecifier{
@@ -214,6 +223,7 @@ func TestMultiToProto(t *testing.T) {
 							&pb.Action{Index: proto.Uint32(3), Mutation: &pb.MutationProto{
 								Row:                 []byte("call2"),
 								MutateType:          pb.MutationProto_APPEND.Enum(),
+								Nonce:               proto.Uint64(1),
 								Durability:          pb.MutationProto_USE_DEFAULT.Enum(),
 								AssociatedCellCount: proto.Int32(1),
 							}},
@@ -234,6 +244,7 @@ func TestMultiToProto(t *testing.T) {
 							&pb.Action{Index: proto.Uint32(5), Mutation: &pb.MutationProto{
 								Row:                 []byte("call4"),
 								MutateType:          pb.MutationProto_INCREMENT.Enum(),
+								Nonce:               proto.Uint64(2),
 								Durability:          pb.MutationProto_USE_DEFAULT.Enum(),
 								AssociatedCellCount: proto.Int32(1),
 							}},
@@ -263,7 +274,8 @@ func TestMultiToProto(t *testing.T) {
 				cancel()
 				cs[0], _ = hrpc.NewGetStr(ctx, "reg0", "call0")
 				cs[0].SetRegion(reg0)
-				cs[1], _ = hrpc.NewAppStr(context.Background(), "reg0", "call1", nil)
+				cs[1], _ = hrpc.NewAppStr(context.Background(), "reg0", "call1", nil,
+					hrpc.Nonce(0))
 				cs[1].SetRegion(reg0)
 				return cs
 			}(),
@@ -1098,3 +1110,247 @@ func BenchmarkMultiToProtoLarge(b *testing.B) {
 		}
 	})
 }
+
+func TestMultiCoalesceIncrements(t *testing.T) {
+	newInc := func(key, qualifier string, amount int64) hrpc.Call {
+		inc, err := hrpc.NewIncStrSingle(context.Background(), "reg0", key, "cf", qualifier,
+			amount)
+		if err != nil {
+			t.Fatal(err)
+		}
+		inc.SetRegion(reg0)
+		return inc
+	}
+	int64Value := func(v int64) []byte {
+		b := make([]byte, 8)
+		binary.BigEndian.PutUint64(b, uint64(v))
+		return b
+	}
+
+	// 10 increments of the same cell, followed by an increment of another cell
+	var calls []hrpc.Call
+	for i := 0; i < 10; i++ {
+		calls = append(calls, newInc("counter", "a", 1))
+	}
+	calls = append(calls, newInc("counter", "b", 5))
+
+	m := newMulti(1000)
+	m.coalesceIncrements = true
+	m.add(calls)
+	mr := m.ToProto().(*pb.MultiRequest)
+	if len(mr.RegionAction) != 1 {
+		t.Fatalf("expected 1 region action, got %d", len(mr.RegionAction))
+	}
+	actions := mr.RegionAction[0].Action
+	if len(actions) != 2 {
+		t.Fatalf("expected 2 actions, got %d", len(actions))
+	}
+	for i, exp := range []struct {
+		index  uint32
+		amount int64
+	}{{index: 1, amount: 10}, {index: 11, amount: 5}} {
+		a := actions[i]
+		value := a.Mutation.ColumnValue[0].QualifierValue[0].Value
+		if a.GetIndex() != exp.index || !bytes.Equal(value, int64Value(exp.amount)) {
+			t.Errorf("expected action %d to increment by %d, got action %d incrementing by %v",
+				exp.index, exp.amount, a.GetIndex(), value)
+		}
+	}
+	// the sum isn't deduplicated with nonces, as it can differ when retried
+	if actions[0].Mutation.Nonce != nil {
+		t.Errorf("expected no nonce for coalesced increments, got %d",
+			actions[0].Mutation.GetNonce())
+	}
+	if n := calls[10].(*hrpc.Mutate).Nonce(); actions[1].Mutation.GetNonce() != n {
+		t.Errorf("expected nonce %d, got %d", n, actions[1].Mutation.GetNonce())
+	}
+	// values of the calls shouldn't be modified
+	if v := calls[0].(*hrpc.Mutate).Values()["cf"]["a"]; !bytes.Equal(v, int64Value(1)) {
+		t.Errorf("expected value of the call to stay 1, got %v", v)
+	}
+
+	// the cell had value 32 before the increments
+	m.returnResults(&pb.MultiResponse{
+		RegionActionResult: []*pb.RegionActionResult{&pb.RegionActionResult{
+			ResultOrException: []*pb.ResultOrException{
+				&pb.ResultOrException{
+					Index: proto.Uint32(1),
+					Result: &pb.Result{Cell: []*pb.Cell{&pb.Cell{
+						Row:       []byte("counter"),
+						Family:    []byte("cf"),
+						Qualifier: []byte("a"),
+						Value:     int64Value(42),
+					}}},
+				},
+				&pb.ResultOrException{
+					Index: proto.Uint32(11),
+					Result: &pb.Result{Cell: []*pb.Cell{&pb.Cell{
+						Row:       []byte("counter"),
+						Family:    []byte("cf"),
+						Qualifier: []byte("b"),
+						Value:     int64Value(5),
+					}}},
+				},
+			},
+		}},
+	}, nil)
+
+	// every call sees the value as if increments were sent one by one
+	for i, c := range calls {
+		exp := int64(33 + i)
+		if i == 10 {
+			exp = 5
+		}
+		res := <-c.ResultChan()
+		if res.Error != nil {
+			t.Fatalf("call %d: unexpected error: %v", i, res.Error)
+		}
+		cells := res.Msg.(*pb.MutateResponse).Result.Cell
+		if len(cells) != 1 || !bytes.Equal(cells[0].Value, int64Value(exp)) {
+			t.Errorf("call %d: expected value %d, got %v", i, exp, cells)
+		}
+	}
+}
+
+func TestMultiCoalesceIncrementsException(t *testing.T) {
+	var calls []hrpc.Call
+	for i := 0; i < 3; i++ {
+		inc, _ := hrpc.NewIncStrSingle(context.Background(), "reg0", "counter", "cf", "a", 1)
+		inc.SetRegion(reg0)
+		calls = append(calls, inc)
+	}
+	m := newMulti(1000)
+	m.coalesceIncrements = true
+	m.add(calls)
+	m.ToProto()
+	m.returnResults(&pb.MultiResponse{
+		RegionActionResult: []*pb.RegionActionResult{&pb.RegionActionResult{
+			ResultOrException: []*pb.ResultOrException{
+				&pb.ResultOrException{
+					Index: proto.Uint32(1),
+					Exception: &pb.NameBytesPair{
+						Name:  proto.String("java.io.IOException"),
+						Value: []byte("oops"),
+					},
+				},
+			},
+		}},
+	}, nil)
+	for i, c := range calls {
+		if res := <-c.ResultChan(); res.Error == nil {
+			t.Errorf("call %d: expected an error", i)
+		}
+	}
+}
+
+func TestMultiOrderWithinRegion(t *testing.T) {
+	newCall := func(key string, inc bool) hrpc.Call {
+		var c hrpc.Call
+		var err error
+		if inc {
+			c, err = hrpc.NewIncStrSingle(context.Background(), "reg0", key, "cf", "a", 1)
+		} else {
+			c, err = hrpc.NewPutStr(context.Background(), "reg0", key,
+				map[string]map[string][]byte{"cf": map[string][]byte{"a": []byte("v")}})
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		c.SetRegion(reg0)
+		return c
+	}
+
+	// the put of the counter is between increments, so they can't be coalesced
+	calls := []hrpc.Call{
+		newCall("counter", true),
+		newCall("other", true),
+		newCall("counter", false),
+		newCall("other", true),
+		newCall("counter", true),
+		newCall("counter", true),
+	}
+	for _, coalesce := range []bool{false, true} {
+		t.Run(fmt.Sprintf("coalesce=%v", coalesce), func(t *testing.T) {
+			m := newMulti(1000)
+			m.coalesceIncrements = coalesce
+			m.add(calls)
+			mr := m.ToProto().(*pb.MultiRequest)
+			if len(mr.RegionAction) != 1 {
+				t.Fatalf("expected 1 region action, got %d", len(mr.RegionAction))
+			}
+			expIndices := []uint32{1, 2, 3, 4, 5, 6}
+			if coalesce {
+				// increments of "other" and the last two of "counter" are coalesced
+				expIndices = []uint32{1, 2, 3, 5}
+			}
+			var indices []uint32
+			for _, a := range mr.RegionAction[0].Action {
+				indices = append(indices, a.GetIndex())
+			}
+			if !reflect.DeepEqual(expIndices, indices) {
+				t.Errorf("expected actions %v, got %v", expIndices, indices)
+			}
+		})
+	}
+}
+
+func TestAtomicMultiError(t *testing.T) {
+	p, err := hrpc.NewPutStr(context.Background(), "test", "row",
+		map[string]map[string][]byte{"cf": {"q": []byte("v")}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	exception := &pb.NameBytesPair{
+		Name:  proto.String("org.apache.hadoop.hbase.NotServingRegionException"),
+		Value: []byte("region is not online"),
+	}
+	for _, tcase := range []struct {
+		name string
+		resp *pb.MultiResponse
+		err  bool
+	}{{
+		name: "processed",
+		resp: &pb.MultiResponse{
+			RegionActionResult: []*pb.RegionActionResult{{
+				ResultOrException: []*pb.ResultOrException{{Index: proto.Uint32(1)}},
+			}},
+			Processed: proto.Bool(true),
+		},
+	}, {
+		name: "region exception",
+		resp: &pb.MultiResponse{
+			RegionActionResult: []*pb.RegionActionResult{{Exception: exception}},
+		},
+		err: true,
+	}, {
+		name: "action exception",
+		resp: &pb.MultiResponse{
+			RegionActionResult: []*pb.RegionActionResult{{
+				ResultOrException: []*pb.ResultOrException{{
+					Index:     proto.Uint32(1),
+					Exception: exception,
+				}},
+			}},
+		},
+		err: true,
+	}} {
+		t.Run(tcase.name, func(t *testing.T) {
+			cam, err := hrpc.NewCheckAndMutate([]*hrpc.Mutate{p}, "cf", "q", filter.Equal,
+				filter.NewBinaryComparator(filter.NewByteArrayComparable([]byte("v"))))
+			if err != nil {
+				t.Fatal(err)
+			}
+			returnResult(cam, tcase.resp, nil)
+			res := <-cam.ResultChan()
+			if !tcase.err {
+				if res.Error != nil {
+					t.Errorf("expected no error, got %v", res.Error)
+				}
+				return
+			}
+			if _, ok := res.Error.(NotServingRegionError); !ok {
+				t.Errorf("expected a NotServingRegionError, got %v", res.Error)
+			}
+		})
+	}
+}
@@ -11,7 +11,6 @@ package region
 import (
 	"context"
 	"fmt"
-	"net"
 	"time"
 
 	"github.com/baiweiguo/gohbase/compression"
@@ -20,30 +19,34 @@ import (
 
 // NewClient creates a new RegionClient.
 func NewClient(addr string, ctype ClientType, queueSize int, flushInterval time.Duration,
-	effectiveUser string, readTimeout time.Duration, codec compression.Codec) hrpc.RegionClient {
+	effectiveUser string, readTimeout time.Duration, codec compression.Codec,
+	options ...ClientOption) hrpc.RegionClient {
 	c := &client{
-		addr:          addr,
-		ctype:         ctype,
-		rpcQueueSize:  queueSize,
-		flushInterval: flushInterval,
-		effectiveUser: effectiveUser,
-		readTimeout:   readTimeout,
-		rpcs:          make(chan []hrpc.Call),
-		done:          make(chan struct{}),
-		sent:          make(map[uint32]hrpc.Call),
+		addr:           addr,
+		ctype:          ctype,
+		rpcQueueSize:   queueSize,
+		flushInterval:  flushInterval,
+		maxRequestSize: DefaultMaxRequestSize,
+		effectiveUser:  effectiveUser,
+		readTimeout:    readTimeout,
+		rpcs:           make(chan []hrpc.Call),
+		done:           make(chan struct{}),
+		sent:           make(map[uint32]hrpc.Call),
 	}
 
 	if codec != nil {
 		c.compressor = &compressor{Codec: codec}
 	}
+	for _, option := range options {
+		option(c)
+	}
 	return c
 }
 
 func (c *client) Dial(ctx context.Context) error {
 	c.dialOnce.Do(func() {
-		var d net.Dialer
 		var err error
-		c.conn, err = d.DialContext(ctx, "tcp", c.addr)
+		c.conn, err = c.dial(ctx)
 		if err != nil {
 			c.fail(fmt.Errorf("failed to dial RegionServer: %s", err))
 			return
@@ -67,9 +70,9 @@ func (c *client) Dial(ctx context.Context) error {
 		}
 
 		if c.ctype == RegionClient {
-			go c.processRPCs() // Batching goroutine
+			c.goroutine(c.processRPCs) // Batching goroutine
 		}
-		go c.receiveRPCs() // Reader goroutine
+		c.goroutine(c.receiveRPCs) // Reader goroutine
 	})
 
 	select {
@@ -0,0 +1,51 @@
+// Copyright (C) 2026  The GoHBase Authors.  All rights reserved.
+// This file is part of GoHBase.
+// Use of this source code is governed by the Apache License 2.0
+// that can be found in the COPYING file.
+
+package region
+
+import (
+	"net"
+
+	"github.com/baiweiguo/gohbase/hrpc"
+)
+
+// TapDirection tells whether a frame passed to a Tap is a request or a response.
+type TapDirection int
+
+const (
+	// TapRequest is a request sent to the RegionServer.
+	TapRequest TapDirection = iota
+	// TapResponse is a response received from the RegionServer.
+	TapResponse
+)
+
+// Tap is called with the frames of rpcs exchanged with the RegionServer,
+// e.g. to debug protocol issues. A frame is what follows its 4 bytes length
+// on the wire: the varint-delimited header, the varint-delimited request or
+// response and the cellblocks, if any. The frame must not be modified nor
+// retained after Tap returns. Tap is called from the goroutines sending and
+// receiving rpcs, so it should be fast.
+type Tap func(rpc hrpc.Call, dir TapDirection, frame []byte)
+
+// WithTap returns an option that makes the client call tap with the frames
+// of every rpc it sends and receives. There's no tap by default.
+func WithTap(tap Tap) ClientOption {
+	return func(c *client) {
+		c.tap = tap
+	}
+}
+
+// tapRequest calls the tap of the client with the request frame made of b
+// without its length and the cellblocks.
+func (c *client) tapRequest(rpc hrpc.Call, b []byte, cellblocks net.Buffers) {
+	frame := b[4:]
+	if cellblocks != nil {
+		frame = append([]byte(nil), frame...)
+		for _, cb := range cellblocks {
+			frame = append(frame, cb...)
+		}
+	}
+	c.tap(rpc, TapRequest, frame)
+}
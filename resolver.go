@@ -0,0 +1,57 @@
+// Copyright (C) 2026  The GoHBase Authors.  All rights reserved.
+// This file is part of GoHBase.
+// Use of this source code is governed by the Apache License 2.0
+// that can be found in the COPYING file.
+
+package gohbase
+
+import (
+	"context"
+	"time"
+
+	"github.com/baiweiguo/gohbase/compression"
+	"github.com/baiweiguo/gohbase/hrpc"
+	"github.com/baiweiguo/gohbase/region"
+)
+
+// RegionResolver locates the region holding a key of a table and the address
+// of the RegionServer serving it. It replaces the lookups in hbase:meta of a
+// client created with NewClientWithResolver, which allows to test code using
+// the client without an HBase cluster.
+type RegionResolver interface {
+	// ResolveRegion returns the region holding key of table and the address
+	// of its RegionServer. TableNotFound should be returned if the table
+	// or the region doesn't exist. Regions can be created with region.NewInfo.
+	ResolveRegion(ctx context.Context, table, key []byte) (hrpc.RegionInfo, string, error)
+}
+
+// NewClientWithResolver creates a new HBase client that resolves regions with
+// resolver instead of looking them up in hbase:meta. If newRegionClient isn't
+// nil, it is used to create the clients of RegionServers, so that both the
+// region lookups and the RPCs can be served by fakes in unit tests.
+func NewClientWithResolver(resolver RegionResolver,
+	newRegionClient func(addr string) hrpc.RegionClient, options ...Option) Client {
+	// the resolver is set by an option so that it's in place before
+	// any background goroutine of the client is started
+	options = append(options[:len(options):len(options)], func(c *client) {
+		c.resolver = resolver
+		if newRegionClient != nil {
+			c.newRegionClientFn = func(addr string, _ region.ClientType, _ int,
+				_ time.Duration, _ string, _ time.Duration, _ compression.Codec,
+				_ ...region.ClientOption) hrpc.RegionClient {
+				return newRegionClient(addr)
+			}
+		}
+	})
+	return newClient("", options...)
+}
+
+// resolveRegion looks up the region holding key of table either with the
+// resolver of the client, if any, or in hbase:meta.
+func (c *client) resolveRegion(ctx context.Context,
+	table, key []byte) (hrpc.RegionInfo, string, error) {
+	if c.resolver != nil {
+		return c.resolver.ResolveRegion(ctx, table, key)
+	}
+	return c.metaLookup(ctx, table, key)
+}
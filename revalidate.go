@@ -0,0 +1,71 @@
+// Copyright (C) 2026  The GoHBase Authors.  All rights reserved.
+// This file is part of GoHBase.
+// Use of this source code is governed by the Apache License 2.0
+// that can be found in the COPYING file.
+
+package gohbase
+
+import (
+	"bytes"
+	"context"
+	"math/rand"
+	"time"
+
+	"github.com/baiweiguo/gohbase/hrpc"
+	log "github.com/sirupsen/logrus"
+)
+
+// revalidateRegions periodically checks a sample of the cached regions
+// against hbase:meta until the client is closed. The interval is jittered,
+// so that clients started at the same time don't look up meta together.
+func (c *client) revalidateRegions() {
+	for {
+		interval := c.revalidationInterval/2 +
+			time.Duration(rand.Int63n(int64(c.revalidationInterval)))
+		select {
+		case <-c.ctx.Done():
+			return
+		case <-c.after(interval):
+		}
+		for _, reg := range c.regions.sample(c.revalidationSample) {
+			if c.ctx.Err() != nil {
+				return
+			}
+			c.revalidateRegion(reg)
+		}
+	}
+}
+
+// revalidateRegion looks up the region in hbase:meta and evicts it from caches
+// if it doesn't exist anymore or it's served by another regionserver,
+// so that it's looked up again next time it's needed.
+func (c *client) revalidateRegion(reg hrpc.RegionInfo) {
+	rc := reg.Client()
+	if rc == nil {
+		// region is being established
+		return
+	}
+	ctx, cancel := context.WithTimeout(c.ctx, c.regionLookupTimeout)
+	defer cancel()
+	current, addr, err := c.resolveRegion(ctx, fullyQualifiedTable(reg), reg.StartKey())
+	if err != nil && err != TableNotFound {
+		log.WithFields(log.Fields{
+			"region": reg,
+			"err":    err,
+		}).Debug("failed revalidating region")
+		return
+	}
+	addr = c.rewriteAddr(addr)
+	if err == nil && bytes.Equal(current.Name(), reg.Name()) && addr == rc.Addr() {
+		return
+	}
+
+	log.WithFields(log.Fields{
+		"region": reg,
+		"client": rc,
+		"addr":   addr,
+		"err":    err,
+	}).Info("evicting stale region from cache")
+	c.regions.del(reg)
+	c.clients.del(reg)
+}
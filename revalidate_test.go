@@ -0,0 +1,71 @@
+// Copyright (C) 2026  The GoHBase Authors.  All rights reserved.
+// This file is part of GoHBase.
+// Use of this source code is governed by the Apache License 2.0
+// that can be found in the COPYING file.
+
+package gohbase
+
+import (
+	"testing"
+	"time"
+
+	"github.com/baiweiguo/gohbase/region"
+)
+
+func TestRevalidateRegions(t *testing.T) {
+	c := newMockClient(nil)
+	defer c.cancel()
+
+	// pretend regionserver:0 has meta table
+	rc := c.clients.put("regionserver:0", c.metaRegionInfo, newRegionClientFn("regionserver:0"))
+	c.metaRegionInfo.SetClient(rc)
+
+	// the mocked meta says that regions are served by the regionserver
+	// with the name of their table
+	moved := region.NewInfo(1434573235908, nil, []byte("moved"),
+		[]byte("moved,,1434573235908.56f833d5569a27c7a43fbf547b4924a4."), nil, nil)
+	rc = c.clients.put("regionserver:1", moved, newRegionClientFn("regionserver:1"))
+	moved.SetClient(rc)
+	c.regions.put(moved)
+
+	stays := region.NewInfo(1434573235908, nil, []byte("stays"),
+		[]byte("stays,,1434573235908.56f833d5569a27c7a43fbf547b4924a4."), nil, nil)
+	rc = c.clients.put("stays", stays, newRegionClientFn("stays"))
+	stays.SetClient(rc)
+	c.regions.put(stays)
+
+	ticks := make(chan time.Time)
+	intervals := make(chan time.Duration, 10)
+	c.after = func(d time.Duration) <-chan time.Time {
+		intervals <- d
+		return ticks
+	}
+	c.revalidationInterval = time.Minute
+	c.revalidationSample = 10
+	go c.revalidateRegions()
+
+	d := <-intervals
+	if d < 30*time.Second || d >= 90*time.Second {
+		t.Errorf("expected interval between 30s and 90s, got %s", d)
+	}
+	if c.getRegionFromCache([]byte("moved"), []byte("key")) == nil {
+		t.Fatal("expected moved region to be cached before the interval passes")
+	}
+
+	ticks <- time.Now()
+	// wait for the revalidation to finish
+	<-intervals
+
+	if c.getRegionFromCache([]byte("moved"), []byte("key")) != nil {
+		t.Error("expected moved region to be evicted")
+	}
+	if c.getRegionFromCache([]byte("stays"), []byte("key")) == nil {
+		t.Error("expected region that didn't move to stay cached")
+	}
+	if moved.Client() != nil {
+		t.Error("expected moved region to have no client")
+	}
+	if stays.Client() == nil {
+		t.Error("expected region that didn't move to keep its client")
+	}
+}
@@ -12,7 +12,11 @@ import (
 	"fmt"
 	"io"
 	"math"
+	"net"
+	"sort"
 	"strconv"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/baiweiguo/gohbase/hrpc"
@@ -44,6 +48,50 @@ var (
 
 	// ErrClientClosed is returned when the gohbase client has been closed
 	ErrClientClosed = errors.New("client is closed")
+
+	// ErrStaleMeta is returned when hbase:meta keeps returning a region that
+	// doesn't contain the looked up key for longer than allowed by
+	// MetaLookupMaxStaleness option. It usually happens right after a region
+	// split, when only one of the daughter regions is in hbase:meta.
+	ErrStaleMeta = errors.New("hbase:meta returned a region that doesn't contain the key")
+
+	// ErrMasterUnavailable is returned by admin operations when the master
+	// couldn't be looked up for longer than allowed by MasterLookupMaxWait option.
+	ErrMasterUnavailable = errors.New("master is unavailable")
+
+	// ErrRegionChanged is returned by scanners of ScanRegion when the scanned
+	// region has been split, merged or otherwise replaced by another region.
+	ErrRegionChanged = errors.New("region has been replaced by another region")
+
+	// ErrRegionGap is returned by scanners when the region the scan continues in
+	// doesn't start where the previous one stopped, e.g. because of a hole in
+	// hbase:meta, instead of silently skipping the rows in between.
+	ErrRegionGap = errors.New("gap between regions")
+
+	// ErrRegionUnavailable is returned when a region couldn't be established
+	// and NoAutoReconnect option doesn't let the client retry.
+	ErrRegionUnavailable = errors.New("region is unavailable")
+
+	// ErrCellTooLarge is matched with errors.Is by the region.CellTooLargeError
+	// returned for mutations with a cell larger than the maximum size of a cell
+	// set with MaxCellSize option or configured in HBase.
+	ErrCellTooLarge = region.ErrCellTooLarge
+
+	// ErrClusterIDMismatch is returned by NewClientWithClusterID when
+	// the cluster has another ID than expected.
+	ErrClusterIDMismatch = errors.New("cluster ID mismatch")
+
+	// ErrRegionLookupTimeout is returned when looking up a region takes longer
+	// than allowed with MaxRegionLookupDuration option.
+	ErrRegionLookupTimeout = errors.New("region lookup took too long")
+
+	// ErrUnsupportedFeature is matched by the errors returned for rpcs requesting
+	// a feature the version of HBase set with ServerVersion option doesn't support.
+	ErrUnsupportedFeature = errors.New("feature unsupported by HBase version")
+
+	// ErrReplicaNotFound is returned for requests with ReplicaID option when
+	// the region of the request has no such replica.
+	ErrReplicaNotFound = errors.New("region replica not found")
 )
 
 const (
@@ -57,6 +105,7 @@ func (c *client) getRegionForRpc(ctx context.Context, rpc hrpc.Call) (hrpc.Regio
 	for i := 0; i < maxFindRegionTries; i++ {
 		// Check the cache for a region that can handle this request
 		if reg := c.getRegionFromCache(rpc.Table(), rpc.Key()); reg != nil {
+			atomic.AddUint64(&c.stats.CacheHits, 1)
 			return reg, nil
 		}
 
@@ -73,27 +122,81 @@ func (c *client) SendRPC(rpc hrpc.Call) (msg proto.Message, err error) {
 	start := time.Now()
 	description := rpc.Description()
 	ctx, sp := observability.StartSpan(rpc.Context(), description)
+	if timeout := c.rpcTimeout(rpc); timeout > 0 {
+		if _, ok := ctx.Deadline(); !ok {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, timeout)
+			defer cancel()
+		}
+	}
 	defer func() {
 		result := "ok"
 		if err != nil {
 			result = "error"
 			sp.SetStatus(codes.Error, err.Error())
+			if errors.Is(err, context.DeadlineExceeded) {
+				atomic.AddUint64(&c.stats.DeadlinesExceeded, 1)
+			}
 		}
 
-		o := operationDurationSeconds.WithLabelValues(description, result)
+		o := operationDurationSeconds.WithLabelValues(description, result, metricLabel(rpc))
 
 		observability.ObserveWithTrace(ctx, o, time.Since(start).Seconds())
 		sp.End()
 	}()
 
+	var retries int
+	defer func() {
+		atomic.AddUint64(&c.stats.Retries, uint64(retries))
+		if r, ok := rpc.(retriesSetter); ok {
+			r.SetRetries(retries)
+		}
+	}()
+
+	if err := c.checkCellSize(rpc); err != nil {
+		return nil, err
+	}
+	if err := c.checkFeatures(rpc); err != nil {
+		return nil, err
+	}
+	if t, ok := rpc.(hrpc.Targetable); ok && t.TargetRegionServer() != "" {
+		return c.sendRPCToServer(ctx, rpc, t.TargetRegionServer())
+	}
+	if r, ok := rpc.(interface{ ReplicaID() int }); ok && r.ReplicaID() > 0 {
+		return c.sendRPCToReplica(ctx, rpc, r.ReplicaID())
+	}
+
 	backoff := backoffStart
-	for {
-		rc, err := c.getRegionAndClientForRPC(ctx, rpc)
+	for ; ; retries++ {
+		reg, rc, err := c.acquireRegionAndClient(ctx, rpc)
 		if err != nil {
 			return nil, err
 		}
-		msg, err = c.sendRPCToRegionClient(ctx, rpc, rc)
-		switch err.(type) {
+		attempt, attemptCtx, cancel := c.newAttempt(ctx, rpc)
+		msg, err = c.sendRPCToRegionClient(attemptCtx, attempt, rc)
+		cancel()
+		c.inFlight.add(reg, -1)
+		if err == context.DeadlineExceeded && attemptCtx != ctx && ctx.Err() == nil {
+			// the attempt timed out, the next one may still get its response
+			sp.AddEvent("retrySleep")
+			backoff, err = sleepAndIncreaseBackoff(ctx, backoff)
+			if err != nil {
+				return msg, err
+			}
+			continue // retry
+		}
+		switch e := err.(type) {
+		case region.ThrottledError:
+			// wait at least as long as the server asked to
+			sp.AddEvent("retrySleep")
+			if e.Wait > backoff {
+				backoff = e.Wait
+			}
+			backoff, err = sleepAndIncreaseBackoff(ctx, backoff)
+			if err != nil {
+				return msg, err
+			}
+			continue // retry
 		case region.RetryableError:
 			sp.AddEvent("retrySleep")
 			backoff, err = sleepAndIncreaseBackoff(ctx, backoff)
@@ -102,30 +205,118 @@ func (c *client) SendRPC(rpc hrpc.Call) (msg proto.Message, err error) {
 			}
 			continue // retry
 		case region.ServerError, region.NotServingRegionError:
+			// there's no point in retrying with a server that isn't HBase
+			if c.noAutoReconnect || err == region.ErrNotHBaseServer {
+				return msg, err
+			}
 			continue // retry
 		}
 		return msg, err
 	}
 }
 
+// rpcTimeout returns the timeout of rpc set with ReadRPCTimeout or WriteRPCTimeout
+// option depending on whether it reads or writes, or zero if there's none.
+func (c *client) rpcTimeout(rpc hrpc.Call) time.Duration {
+	switch rpc.(type) {
+	case hrpc.ReadCall:
+		return c.readRPCTimeout
+	case hrpc.WriteCall:
+		return c.writeRPCTimeout
+	}
+	return 0
+}
+
+// newAttempt returns the call to send as an attempt at sending rpc and its
+// context, limited by the AttemptTimeout option for the calls that can be sent
+// again while previous attempts may still be processed, see hrpc.NewAttempt,
+// and the function canceling it. Other calls are sent as is within ctx.
+func (c *client) newAttempt(ctx context.Context, rpc hrpc.Call) (
+	hrpc.Call, context.Context, context.CancelFunc) {
+	if c.attemptTimeout > 0 {
+		if attempt, ok := hrpc.NewAttempt(rpc); ok {
+			attemptCtx, cancel := context.WithTimeout(ctx, c.attemptTimeout)
+			return attempt, attemptCtx, cancel
+		}
+	}
+	return rpc, ctx, func() {}
+}
+
+// checkCellSize returns a region.CellTooLargeError if rpc is a mutation with
+// a cell larger than the limit set with MaxCellSize option. The size of a cell
+// is the size of the KeyValue it's sent to HBase as.
+func (c *client) checkCellSize(rpc hrpc.Call) error {
+	m, ok := rpc.(interface{ KeyValueSizes() (int64, int64) })
+	if !ok || c.maxCellSize <= 0 {
+		return nil
+	}
+	if _, size := m.KeyValueSizes(); size > c.maxCellSize {
+		return region.CellTooLargeError{Size: size, Limit: c.maxCellSize}
+	}
+	return nil
+}
+
+// metricLabel returns the label of the metrics of rpc, see hrpc.MetricLabel.
+func metricLabel(rpc hrpc.Call) string {
+	if l, ok := rpc.(interface{ MetricLabel() string }); ok {
+		return l.MetricLabel()
+	}
+	return ""
+}
+
+// retriesSetter is implemented by calls that record
+// the number of times they were retried.
+type retriesSetter interface {
+	SetRetries(retries int)
+}
+
+// acquireRegionAndClient returns the region and region client to send rpc
+// with like getRegionAndClientForRPC, with the rpc acquired in flight to the
+// region so that the region client isn't closed as idle meanwhile. The caller
+// must add -1 to the rpcs in flight of the region once done with it.
+func (c *client) acquireRegionAndClient(ctx context.Context, rpc hrpc.Call) (
+	hrpc.RegionInfo, hrpc.RegionClient, error) {
+	for {
+		reg, rc, err := c.getRegionAndClientForRPC(ctx, rpc)
+		if err != nil {
+			return nil, nil, err
+		}
+		if c.inFlight.acquire(reg, rc) {
+			return reg, rc, nil
+		}
+		// rc is being closed as idle or was replaced, look it up again
+	}
+}
+
 func (c *client) getRegionAndClientForRPC(ctx context.Context, rpc hrpc.Call) (
-	hrpc.RegionClient, error) {
+	hrpc.RegionInfo, hrpc.RegionClient, error) {
 	for {
 		reg, err := c.getRegionForRpc(ctx, rpc)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
+		waited := false
 		if ch := reg.AvailabilityChan(); ch != nil { // region is currently unavailable
 			select {
 			case <-ctx.Done():
-				return nil, ctx.Err()
+				return nil, nil, ctx.Err()
 			case <-c.done:
-				return nil, ErrClientClosed
+				return nil, nil, ErrClientClosed
 			case <-ch:
 			}
+			waited = true
 		}
 
 		client := reg.Client()
+		if client == nil {
+			if err := c.breakers.check(reg); err != nil {
+				return nil, nil, err
+			}
+		}
+		if client == nil && waited && c.noAutoReconnect && reg.Context().Err() == nil {
+			// the region has just failed to be established
+			return nil, nil, ErrRegionUnavailable
+		}
 		if client == nil {
 			// There was an error getting the region client. Mark the
 			// region as unavailable.
@@ -137,9 +328,9 @@ func (c *client) getRegionAndClientForRPC(ctx context.Context, rpc hrpc.Call) (
 			if ch := reg.AvailabilityChan(); ch != nil {
 				select {
 				case <-ctx.Done():
-					return nil, ctx.Err()
+					return nil, nil, ctx.Err()
 				case <-c.done:
-					return nil, ErrClientClosed
+					return nil, nil, ErrClientClosed
 				case <-ch:
 				}
 			}
@@ -150,11 +341,18 @@ func (c *client) getRegionAndClientForRPC(ctx context.Context, rpc hrpc.Call) (
 			}
 			client = reg.Client()
 			if client == nil {
+				if reg == c.adminRegionInfo && c.masterLookupMaxWait > 0 {
+					// the master couldn't be looked up in time
+					return nil, nil, ErrMasterUnavailable
+				}
+				if c.noAutoReconnect {
+					return nil, nil, ErrRegionUnavailable
+				}
 				continue
 			}
 		}
 		rpc.SetRegion(reg)
-		return client, nil
+		return reg, client, nil
 	}
 }
 
@@ -165,13 +363,22 @@ var (
 		"RPC in batch not executed due to another error")
 )
 
-// SendBatch will execute all the Calls in batch. Every Call must have
-// the same table and must be Batchable.
+// SendBatch will execute all the Calls in batch. Every Call must be
+// Batchable. Calls can be for different tables, e.g. to get rows of
+// several tables at once.
 //
 // SendBatch will discover the correct region and region server for
-// each Call and dispatch the Calls accordingly. SendBatch is not an
-// atomic operation. Some calls may fail and others succeed. Calls
-// sharing a region will execute in the order passed into SendBatch.
+// each Call and dispatch the Calls accordingly, with a single request
+// per region server whatever the tables of its regions, unless it would be
+// larger than MaxRequestSize and is split into several ones. Regions that
+// aren't in cache yet are looked up together with a single scan of
+// hbase:meta per table.
+// SendBatch is not an atomic operation. Some calls may fail and others
+// succeed. Calls
+// sharing a region will execute in the order passed into SendBatch,
+// which also holds when increments are coalesced with CoalesceIncrements.
+// Calls for different regions are sent to their region servers in
+// parallel and no ordering between them is guaranteed.
 //
 // SendBatch returns a slice of [hrpc.RPCResult] each containing a
 // response and an error. The results will be returned in the same
@@ -182,9 +389,21 @@ var (
 // be checked.
 func (c *client) SendBatch(ctx context.Context, batch []hrpc.Call) (
 	res []hrpc.RPCResult, allOK bool) {
+	return c.SendBatchWithOptions(ctx, batch)
+}
+
+// SendBatchWithOptions sends batch like SendBatch with the given options.
+// With hrpc.FailFast option, it returns as soon as a call fails instead of
+// waiting for the results of the others.
+func (c *client) SendBatchWithOptions(ctx context.Context, batch []hrpc.Call,
+	options ...hrpc.BatchOption) (res []hrpc.RPCResult, allOK bool) {
 	if len(batch) == 0 {
 		return nil, true
 	}
+	var opts hrpc.BatchOptions
+	for _, option := range options {
+		option(&opts)
+	}
 
 	allOK = true
 
@@ -198,13 +417,12 @@ func (c *client) SendBatch(ctx context.Context, batch []hrpc.Call) (
 			sp.SetStatus(codes.Error, "batch error")
 		}
 
-		o := operationDurationSeconds.WithLabelValues(description, result)
+		o := operationDurationSeconds.WithLabelValues(description, result, "")
 
 		observability.ObserveWithTrace(ctx, o, time.Since(start).Seconds())
 		sp.End()
 	}()
 
-	table := batch[0].Table()
 	res = make([]hrpc.RPCResult, len(batch))
 	rpcToRes := make(map[hrpc.Call]int, len(batch))
 	for i, rpc := range batch {
@@ -222,25 +440,48 @@ func (c *client) SendBatch(ctx context.Context, batch []hrpc.Call) (
 		// occurs.
 		res[i].Error = NotExecutedError
 
-		if !bytes.Equal(rpc.Table(), table) {
-			res[i].Error = fmt.Errorf("multiple tables in batch request: %q and %q",
-				string(table), string(rpc.Table()))
-			allOK = false
-		} else if b, batchable := rpc.(hrpc.Batchable); !batchable || b.SkipBatch() {
+		if b, batchable := rpc.(hrpc.Batchable); !batchable || b.SkipBatch() {
 			res[i].Error = errors.New("non-batchable call passed to SendBatch")
 			allOK = false
+		} else if err := c.checkCellSize(rpc); err != nil {
+			res[i].Error = err
+			allOK = false
+		} else if err := c.checkFeatures(rpc); err != nil {
+			res[i].Error = err
+			allOK = false
 		}
 	}
 	if !allOK {
 		return res, allOK
 	}
 
+	var tables [][]byte
+	for _, rpc := range batch {
+		if !containsTable(tables, rpc.Table()) {
+			tables = append(tables, rpc.Table())
+		}
+	}
+	for _, table := range tables {
+		c.prefetchRegions(ctx, table, batch)
+	}
 	rpcByClient, ok := c.findClients(ctx, batch, res)
 	if !ok {
+		for _, rpcs := range rpcByClient {
+			for _, rpc := range rpcs {
+				c.inFlight.add(rpc.Region(), -1)
+			}
+		}
 		return res, false
 	}
 	sendBatchSplitCount.Observe(float64(len(rpcByClient)))
 
+	var cancel context.CancelFunc
+	if opts.FailFast {
+		// give up on the other calls as soon as one fails
+		ctx, cancel = context.WithCancel(ctx)
+		defer cancel()
+	}
+
 	// Send each group of RPCs to region client to be executed.
 	type clientAndRPCs struct {
 		client hrpc.RegionClient
@@ -258,6 +499,26 @@ func (c *client) SendBatch(ctx context.Context, batch []hrpc.Call) (
 	func() { // func used to scope the span
 		ctx, sp := observability.StartSpan(ctx, "waitForResult")
 		defer sp.End()
+		if opts.FailFast {
+			// wait for the groups concurrently to notice the first failure
+			var (
+				wg     sync.WaitGroup
+				failed uint32
+			)
+			for _, cAndR := range cAndRs {
+				wg.Add(1)
+				go func(cAndR clientAndRPCs) {
+					defer wg.Done()
+					if !c.waitForCompletion(ctx, cAndR.client, cAndR.rpcs, res, rpcToRes) {
+						atomic.StoreUint32(&failed, 1)
+						cancel()
+					}
+				}(cAndR)
+			}
+			wg.Wait()
+			fail = atomic.LoadUint32(&failed) == 1
+			return
+		}
 		for _, cAndR := range cAndRs {
 			ok := c.waitForCompletion(ctx, cAndR.client, cAndR.rpcs, res, rpcToRes)
 			if !ok {
@@ -272,9 +533,9 @@ func (c *client) SendBatch(ctx context.Context, batch []hrpc.Call) (
 
 // findClients takes a batch of rpcs and discovers the region and
 // region client associated with each. A map is returned with rpcs
-// grouped by their region client. If any error is encountered, the
-// corresponding slot in res will be updated with that error and a
-// BatchError is returned.
+// grouped by their region client, each of them acquired in flight to its
+// region. If any error is encountered, the corresponding slot in res will be
+// updated with that error and a BatchError is returned.
 //
 // findClients will not return on the first errror encountered. It
 // will iterate through all the RPCs to ensure that all unknown
@@ -285,7 +546,7 @@ func (c *client) findClients(ctx context.Context, batch []hrpc.Call, res []hrpc.
 	rpcByClient := make(map[hrpc.RegionClient][]hrpc.Call)
 	ok := true
 	for i, rpc := range batch {
-		rc, err := c.getRegionAndClientForRPC(ctx, rpc)
+		_, rc, err := c.acquireRegionAndClient(ctx, rpc)
 		if err != nil {
 			res[i].Error = err
 			ok = false
@@ -296,6 +557,85 @@ func (c *client) findClients(ctx context.Context, batch []hrpc.Call, res []hrpc.
 	return rpcByClient, ok
 }
 
+// containsTable returns true if table is one of tables.
+func containsTable(tables [][]byte, table []byte) bool {
+	for _, t := range tables {
+		if bytes.Equal(t, table) {
+			return true
+		}
+	}
+	return false
+}
+
+// prefetchRegions looks up in a single scan of hbase:meta the regions of the
+// calls of the batch for table that aren't in cache yet, instead of looking each
+// of them up with its own request to hbase:meta. Regions that couldn't be
+// prefetched are looked up one by one when the batch is dispatched.
+func (c *client) prefetchRegions(ctx context.Context, table []byte, batch []hrpc.Call) {
+	if c.clientType == region.MasterClient || c.resolver != nil ||
+		bytes.Equal(table, metaTableName) {
+		return
+	}
+	var keys [][]byte
+	for _, rpc := range batch {
+		if bytes.Equal(rpc.Table(), table) && c.getRegionFromCache(table, rpc.Key()) == nil {
+			keys = append(keys, rpc.Key())
+		}
+	}
+	if len(keys) < 2 {
+		return
+	}
+	sort.Slice(keys, func(i, j int) bool { return bytes.Compare(keys[i], keys[j]) < 0 })
+
+	// scan hbase:meta backwards from the region of the greatest key
+	// until the region of the smallest key
+	rpc, err := hrpc.NewScanRange(ctx, metaTableName,
+		createRegionSearchKey(table, keys[len(keys)-1]), table,
+		hrpc.Families(infoFamily),
+		hrpc.Reversed(),
+		hrpc.NumberOfRows(uint32(len(keys))))
+	if err != nil {
+		return
+	}
+	scanner := c.Scan(rpc)
+	defer scanner.Close()
+	for {
+		resp, err := scanner.Next()
+		if err != nil {
+			if err != io.EOF {
+				log.WithFields(log.Fields{
+					"table": strconv.Quote(string(table)),
+					"err":   err,
+				}).Debug("failed prefetching regions")
+			}
+			return
+		}
+		reg, addr, err := region.ParseRegionInfo(resp)
+		if err != nil || !bytes.Equal(table, fullyQualifiedTable(reg)) {
+			return
+		}
+
+		// keys[i:] are not before the region
+		i := sort.Search(len(keys), func(i int) bool {
+			return bytes.Compare(keys[i], reg.StartKey()) >= 0
+		})
+		if i < len(keys) &&
+			(len(reg.StopKey()) == 0 || bytes.Compare(keys[i], reg.StopKey()) < 0) {
+			reg.MarkUnavailable()
+			if overlaps, replaced := c.regions.put(reg); replaced {
+				for _, r := range overlaps {
+					c.clients.del(r)
+				}
+				go c.establishRegion(c.ctx, reg, c.rewriteAddr(addr))
+			}
+		}
+		if i == 0 {
+			return
+		}
+		keys = keys[:i]
+	}
+}
+
 func (c *client) waitForCompletion(ctx context.Context, rc hrpc.RegionClient,
 	rpcs []hrpc.Call, results []hrpc.RPCResult, rpcToRes map[hrpc.Call]int) bool {
 
@@ -305,10 +645,13 @@ loop:
 	for i, rpc := range rpcs {
 		select {
 		case res := <-rpc.ResultChan():
+			c.inFlight.add(rpc.Region(), -1)
 			results[rpcToRes[rpc]] = res
 			if res.Error != nil {
 				c.handleResultError(res.Error, rpc.Region(), rc)
 				ok = false
+			} else if c.breakers != nil {
+				c.breakers.success(rc.Addr())
 			}
 		case <-ctx.Done():
 			canceledIndex = i
@@ -322,6 +665,7 @@ loop:
 	// the ResultChan for the remaining RPCs. If not ready the result
 	// will be the context error.
 	for _, rpc := range rpcs[canceledIndex:] {
+		c.inFlight.add(rpc.Region(), -1)
 		select {
 		case res := <-rpc.ResultChan():
 			results[rpcToRes[rpc]] = res
@@ -345,8 +689,22 @@ func (c *client) handleResultError(err error, reg hrpc.RegionInfo, rc hrpc.Regio
 		// unavailable (as opposed to all regions sharing
 		// the client), and start a goroutine to reestablish
 		// it.
-		if reg.MarkUnavailable() {
-			go c.reestablishRegion(reg)
+		if c.noAutoReconnect {
+			// the next rpc to the region will reestablish it
+			reg.SetClient(nil)
+		} else if reg.MarkUnavailable() {
+			delay := c.nsreStorm.record(time.Now())
+			go func() {
+				// wait for the shared backoff of a storm of such errors, if any
+				if delay > 0 {
+					select {
+					case <-time.After(delay):
+					case <-c.ctx.Done():
+						return
+					}
+				}
+				c.reestablishRegion(reg)
+			}()
 		}
 	case region.ServerError:
 		// If it was an unrecoverable error, the region client is
@@ -355,7 +713,9 @@ func (c *client) handleResultError(err error, reg hrpc.RegionInfo, rc hrpc.Regio
 			// If this is the admin client, mark the region
 			// as unavailable and start up a goroutine to
 			// reconnect if it wasn't already marked as such.
-			if reg.MarkUnavailable() {
+			if c.noAutoReconnect {
+				reg.SetClient(nil)
+			} else if reg.MarkUnavailable() {
 				go c.reestablishRegion(reg)
 			}
 		} else {
@@ -376,7 +736,7 @@ func sendBlocking(ctx context.Context, rc hrpc.RegionClient, rpc hrpc.Call) (
 	case res = <-rpc.ResultChan():
 		return res, nil
 	case <-ctx.Done():
-		return res, rpc.Context().Err()
+		return res, ctx.Err()
 	}
 }
 
@@ -388,10 +748,73 @@ func (c *client) sendRPCToRegionClient(ctx context.Context, rpc hrpc.Call, rc hr
 	}
 	if res.Error != nil {
 		c.handleResultError(res.Error, rpc.Region(), rc)
+	} else if c.breakers != nil {
+		c.breakers.success(rc.Addr())
 	}
 	return res.Msg, res.Error
 }
 
+// sendRPCToServer sends rpc to the RegionServer at addr set with
+// hrpc.TargetRegionServer option. The region of rpc is looked up in hbase:meta
+// and a connection to addr is established just for rpc, so that neither of them
+// end up in the caches of the client, whatever they contain.
+func (c *client) sendRPCToServer(ctx context.Context, rpc hrpc.Call, addr string) (
+	proto.Message, error) {
+	reg, _, err := c.lookupRegion(ctx, rpc.Table(), rpc.Key())
+	if err != nil {
+		return nil, err
+	}
+	rpc.SetRegion(reg)
+	return c.sendRPCOverConn(ctx, rpc, addr)
+}
+
+// sendRPCToReplica sends rpc to the RegionServer of the replica
+// with the given ID of its region over a dedicated connection.
+func (c *client) sendRPCToReplica(ctx context.Context, rpc hrpc.Call, replicaID int) (
+	proto.Message, error) {
+	reg, err := c.getRegionForRpc(ctx, rpc)
+	if err != nil {
+		return nil, err
+	}
+	replicas := reg.Replicas()
+	if replicaID >= len(replicas) || replicas[replicaID] == "" {
+		return nil, fmt.Errorf("%w: no replica %d of %s", ErrReplicaNotFound, replicaID, reg)
+	}
+	rpc.SetRegion(region.NewReplicaInfo(reg, replicaID))
+	return c.sendRPCOverConn(ctx, rpc, c.rewriteAddr(replicas[replicaID]))
+}
+
+// sendRPCOverConn sends rpc to the RegionServer at addr over a dedicated
+// connection that is closed afterwards.
+func (c *client) sendRPCOverConn(ctx context.Context, rpc hrpc.Call, addr string) (
+	proto.Message, error) {
+	rc := c.newRegionClientFn(addr, c.clientType, c.rpcQueueSize, c.flushInterval,
+		c.effectiveUser, c.regionReadTimeout, c.compressionCodec, c.regionClientOptions...)
+	defer rc.Close()
+	dialCtx, cancel := context.WithTimeout(ctx, c.regionLookupTimeout)
+	err := c.dial(dialCtx, rc)
+	cancel()
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to RegionServer %s: %w", addr, err)
+	}
+
+	res, err := sendBlocking(ctx, rc, rpc)
+	if err != nil {
+		return nil, err
+	}
+	return res.Msg, res.Error
+}
+
+// dial connects rc to its server and reports the outcome to the dial observer.
+func (c *client) dial(ctx context.Context, rc hrpc.RegionClient) error {
+	start := time.Now()
+	err := rc.Dial(ctx)
+	if c.dialObserver != nil {
+		c.dialObserver(rc.Addr(), time.Since(start), err)
+	}
+	return err
+}
+
 // clientDown removes client from cache and marks all the regions
 // sharing this region's client as unavailable, and start a goroutine
 // to reconnect for each of them.
@@ -402,6 +825,18 @@ func (c *client) sendRPCToRegionClient(ctx context.Context, rpc hrpc.Call, rc hr
 // even if it doesn't appear in the clients cache.
 func (c *client) clientDown(client hrpc.RegionClient, reg hrpc.RegionInfo) {
 	downregions := c.clients.clientDown(client)
+	if downregions != nil && c.breakers != nil {
+		// count the failure once per client
+		c.breakers.failure(client.Addr())
+	}
+	if c.noAutoReconnect {
+		// the next rpcs to the regions will reestablish them
+		reg.SetClient(nil)
+		for downreg := range downregions {
+			downreg.SetClient(nil)
+		}
+		return
+	}
 	if reg.MarkUnavailable() {
 		reg.SetClient(nil)
 		go c.reestablishRegion(reg)
@@ -422,16 +857,40 @@ func (c *client) lookupRegion(ctx context.Context,
 	var reg hrpc.RegionInfo
 	var addr string
 	var err error
+	var staleSince time.Time
+	start := time.Now()
 	backoff := backoffStart
+	parent := ctx
+	if c.clientType != region.MasterClient && c.maxRegionLookupDuration > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.maxRegionLookupDuration)
+		defer cancel()
+	}
 	for {
 		// If it takes longer than regionLookupTimeout, fail so that we can sleep
-		lookupCtx, cancel := context.WithTimeout(ctx, c.regionLookupTimeout)
+		timeout := c.regionLookupTimeout
+		if c.clientType == region.MasterClient && c.masterLookupMaxWait > 0 {
+			if left := c.masterLookupMaxWait - time.Since(start); left < timeout {
+				timeout = left
+			}
+		}
+		lookupCtx, cancel := context.WithTimeout(ctx, timeout)
+		atomic.AddUint64(&c.stats.RegionLookups, 1)
 		if c.clientType == region.MasterClient {
 			log.WithField("resource", zk.Master).Debug("looking up master")
 
 			addr, err = c.zkLookup(lookupCtx, zk.Master)
 			cancel()
 			reg = c.adminRegionInfo
+			if err != nil && c.masterLookupMaxWait > 0 &&
+				time.Since(start) >= c.masterLookupMaxWait {
+				log.WithFields(log.Fields{
+					"resource": zk.Master,
+					"err":      err,
+				}).Error("failed looking up master, giving up")
+
+				return nil, "", ErrMasterUnavailable
+			}
 		} else if bytes.Equal(table, metaTableName) {
 			log.WithField("resource", zk.Meta).Debug("looking up region server of hbase:meta")
 
@@ -444,7 +903,7 @@ func (c *client) lookupRegion(ctx context.Context,
 				"key":   strconv.Quote(string(key)),
 			}).Debug("looking up region")
 
-			reg, addr, err = c.metaLookup(lookupCtx, table, key)
+			reg, addr, err = c.resolveRegion(lookupCtx, table, key)
 			cancel()
 			if err == TableNotFound {
 				log.WithFields(log.Fields{
@@ -456,9 +915,21 @@ func (c *client) lookupRegion(ctx context.Context,
 				return nil, "", err
 			} else if err == ErrClientClosed {
 				return nil, "", err
+			} else if errors.Is(err, ErrStaleMeta) {
+				// hbase:meta might not have the daughters of a split region yet,
+				// give it some time to catch up
+				if staleSince.IsZero() {
+					staleSince = time.Now()
+				}
+				if time.Since(staleSince) >= c.metaLookupMaxStaleness {
+					return nil, "", err
+				}
+			} else {
+				staleSince = time.Time{}
 			}
 		}
 		if err == nil {
+			addr = c.rewriteAddr(addr)
 			log.WithFields(log.Fields{
 				"table":  strconv.Quote(string(table)),
 				"key":    strconv.Quote(string(key)),
@@ -477,8 +948,12 @@ func (c *client) lookupRegion(ctx context.Context,
 		}).Error("failed looking up region")
 
 		// This will be hit if there was an error locating the region
+		lookupErr := err
 		backoff, err = sleepAndIncreaseBackoff(ctx, backoff)
-		if err != nil {
+		if err != nil && parent.Err() == nil {
+			return nil, "", fmt.Errorf("%w: failed for %v: %s",
+				ErrRegionLookupTimeout, time.Since(start), lookupErr)
+		} else if err != nil {
 			return nil, "", err
 		}
 	}
@@ -512,14 +987,39 @@ func (c *client) findRegion(ctx context.Context, table, key []byte) (hrpc.Region
 	}
 
 	// Start a goroutine to connect to the region
-	go c.establishRegion(reg, addr)
+	go c.establishRegion(c.ctx, reg, addr)
 
 	// Wait for the new region to become
 	// available, and then send the RPC
 	return reg, nil
 }
 
+// tableRegions looks up all the regions of table in the order of their keys,
+// in hbase:meta or with the resolver of the client, without caching them.
+func (c *client) tableRegions(ctx context.Context, table []byte) ([]hrpc.RegionInfo, error) {
+	var regions []hrpc.RegionInfo
+	var key []byte
+	for {
+		reg, _, err := c.lookupRegion(ctx, table, key)
+		if err != nil {
+			return nil, err
+		}
+		regions = append(regions, reg)
+		stop := reg.StopKey()
+		if len(stop) == 0 {
+			return regions, nil
+		}
+		if bytes.Compare(stop, key) <= 0 {
+			return nil, fmt.Errorf("%w: looked up table=%q key=%q got region=%s",
+				ErrStaleMeta, table, key, reg)
+		}
+		key = stop
+	}
+}
+
 // Searches in the regions cache for the region hosting the given row.
+// Keys are compared with bytes.Compare whatever their encoding is, because
+// HBase sorts rows, and splits tables into regions, in lexicographic byte order.
 func (c *client) getRegionFromCache(table, key []byte) hrpc.RegionInfo {
 	if c.clientType == region.MasterClient {
 		return c.adminRegionInfo
@@ -545,9 +1045,26 @@ func (c *client) getRegionFromCache(table, key []byte) hrpc.RegionInfo {
 		return nil
 	}
 
+	// The region found is the one of the name at or right before the search
+	// key, whose start key should be at or before the key as well. Don't trust
+	// a region whose name and start key disagree, the key has to be looked up.
+	if bytes.Compare(key, region.StartKey()) < 0 {
+		return nil
+	}
+
 	return region
 }
 
+// RegionSearchKey returns the key of hbase:meta to search for in order to
+// locate the region holding key of table, with the fully qualified name of
+// the table, e.g. "namespace:table". The region is the one of the row of
+// hbase:meta at or right before the search key, which a reversed scan starting
+// at it returns first. Keys that would make the search key longer than
+// the maximum length of a row are shortened.
+func RegionSearchKey(table, key []byte) []byte {
+	return createRegionSearchKey(table, key)
+}
+
 // Creates the META key to search for in order to locate the given key.
 func createRegionSearchKey(table, key []byte) []byte {
 	// Shorten the key such that the generated meta key is <= MAX_ROW_LENGTH (MaxInt16), otherwise
@@ -601,9 +1118,10 @@ func (c *client) metaLookup(ctx context.Context,
 			"  Looked up table=%q key=%q got region=%s", table, key, reg)
 	} else if len(reg.StopKey()) != 0 &&
 		bytes.Compare(key, reg.StopKey()) >= 0 {
-		// This would indicate a hole in the meta table.
-		return nil, "", fmt.Errorf("wtf: meta returned an entry for the wrong region!"+
-			"  Looked up table=%q key=%q got region=%s", table, key, reg)
+		// This would indicate a hole in the meta table,
+		// likely because of a split that is in progress.
+		return nil, "", fmt.Errorf("%w: looked up table=%q key=%q got region=%s",
+			ErrStaleMeta, table, key, reg)
 	}
 	return reg, addr, nil
 }
@@ -626,11 +1144,21 @@ func (c *client) reestablishRegion(reg hrpc.RegionInfo) {
 	select {
 	case <-c.done:
 		return
+	case <-c.ctx.Done():
+		return
 	default:
 	}
 
+	if addr := c.loadedAddrs.take(reg); addr != "" {
+		// region loaded with LoadRegionCache, connect to it for the first time
+		log.WithField("region", reg).Debug("establishing loaded region")
+		c.establishRegion(c.ctx, reg, addr)
+		return
+	}
+
 	log.WithField("region", reg).Debug("reestablishing region")
-	c.establishRegion(reg, "")
+	atomic.AddUint64(&c.stats.Reconnects, 1)
+	c.establishRegion(c.ctx, reg, "")
 }
 
 // probeKey returns a key in region that is unlikely to have data at it
@@ -647,8 +1175,10 @@ func probeKey(reg hrpc.RegionInfo) []byte {
 // isRegionEstablished checks whether regionserver accepts rpcs for the region.
 // Returns the cause if not established.
 func isRegionEstablished(rc hrpc.RegionClient, reg hrpc.RegionInfo) error {
+	// the probe key is longer than the start key of the region,
+	// which can be as long as row keys can be
 	probe, err := hrpc.NewGet(context.Background(), fullyQualifiedTable(reg), probeKey(reg),
-		hrpc.SkipBatch())
+		hrpc.SkipBatch(), hrpc.MaxLengths(math.MaxInt32, 0, 0))
 	if err != nil {
 		panic(fmt.Sprintf("should not happen: %s", err))
 	}
@@ -661,18 +1191,40 @@ func isRegionEstablished(rc hrpc.RegionClient, reg hrpc.RegionInfo) error {
 	}
 
 	switch res.Error.(type) {
-	case region.ServerError, region.NotServingRegionError, region.RetryableError:
+	case region.ServerError, region.NotServingRegionError, region.RetryableError,
+		region.ThrottledError:
 		return res.Error
 	default:
 		return nil
 	}
 }
 
-func (c *client) establishRegion(reg hrpc.RegionInfo, addr string) {
+// regionContext returns a context that is done as soon as either the region
+// is dead or ctx is done.
+func regionContext(ctx context.Context,
+	reg hrpc.RegionInfo) (context.Context, context.CancelFunc) {
+	regCtx, cancel := context.WithCancel(reg.Context())
+	go func() {
+		select {
+		case <-ctx.Done():
+			cancel()
+		case <-regCtx.Done():
+		}
+	}()
+	return regCtx, cancel
+}
+
+// establishRegion connects to the regionserver of the region, looking the region
+// up first if addr is empty, and retries until it succeeds, the region is dead or
+// ctx is done. The region is marked available when establishRegion returns,
+// unless the client has been closed.
+func (c *client) establishRegion(ctx context.Context, reg hrpc.RegionInfo, addr string) {
 	var backoff time.Duration
 	var err error
+	regCtx, cancel := regionContext(ctx, reg)
+	defer func() { cancel() }()
 	for {
-		backoff, err = sleepAndIncreaseBackoff(reg.Context(), backoff)
+		backoff, err = c.backoffs.sleep(regCtx, reg, backoff)
 		if err != nil {
 			// region is dead
 			reg.MarkAvailable()
@@ -682,7 +1234,7 @@ func (c *client) establishRegion(reg hrpc.RegionInfo, addr string) {
 			// need to look up region and address of the regionserver
 			originalReg := reg
 			// lookup region forever until we get it or we learn that it doesn't exist
-			reg, addr, err = c.lookupRegion(originalReg.Context(),
+			reg, addr, err = c.lookupRegion(regCtx,
 				fullyQualifiedTable(originalReg), originalReg.StartKey())
 
 			if err == TableNotFound {
@@ -709,9 +1261,35 @@ func (c *client) establishRegion(reg hrpc.RegionInfo, addr string) {
 				}).Info("region became dead while establishing client for it")
 
 				return
-			} else if err == ErrClientClosed {
+			} else if err == ErrClientClosed || c.ctx.Err() != nil {
 				// client has been closed
 				return
+			} else if errors.Is(err, ErrRegionLookupTimeout) {
+				// don't know where the region is, let rpcs look it up again
+				// and find out if it still can't be located
+				c.regions.del(originalReg)
+				c.clients.del(originalReg)
+				originalReg.MarkAvailable()
+
+				log.WithFields(log.Fields{
+					"region": originalReg.String(),
+					"err":    err,
+				}).Info("failed to look up region in time")
+
+				return
+			} else if err == ErrMasterUnavailable || ctx.Err() != nil {
+				// let the waiting rpcs find out that there's no client
+				originalReg.MarkAvailable()
+				return
+			} else if errors.Is(err, ErrStaleMeta) {
+				log.WithFields(log.Fields{
+					"region":  originalReg.String(),
+					"err":     err,
+					"backoff": backoff,
+				}).Warn("hbase:meta is stale, retrying looking up region")
+
+				reg, addr = originalReg, ""
+				continue
 			} else if err != nil {
 				log.WithFields(log.Fields{
 					"region":  originalReg.String(),
@@ -737,12 +1315,23 @@ func (c *client) establishRegion(reg hrpc.RegionInfo, addr string) {
 				// let rpcs know that they can retry and either get the newly
 				// added region from cache or lookup the one they need
 				originalReg.MarkAvailable()
+				cancel()
+				regCtx, cancel = regionContext(ctx, reg)
 			} else {
 				// same region, discard the looked up one
 				reg = originalReg
 			}
 		}
 
+		if c.breakers.isOpen(addr) {
+			// don't reconnect to the regionserver until the cooldown of its
+			// circuit breaker is over, let the waiting rpcs fail meanwhile
+			c.breakers.skip(reg, addr)
+			reg.SetClient(nil)
+			reg.MarkAvailable()
+			return
+		}
+
 		var client hrpc.RegionClient
 		if reg == c.adminRegionInfo {
 			// admin region is used for talking to master, so it only has one connection to
@@ -753,16 +1342,17 @@ func (c *client) establishRegion(reg hrpc.RegionInfo, addr string) {
 		} else {
 			client = c.clients.put(addr, reg, func() hrpc.RegionClient {
 				return c.newRegionClientFn(addr, c.clientType, c.rpcQueueSize, c.flushInterval,
-					c.effectiveUser, c.regionReadTimeout, c.compressionCodec)
+					c.effectiveUser, c.regionReadTimeout, c.compressionCodec,
+					c.regionClientOptions...)
 			})
 		}
 
 		// connect to the region's regionserver.
 		// only the first caller to Dial gets to actually connect, other concurrent calls
 		// will block until connected or an error.
-		dialCtx, cancel := context.WithTimeout(reg.Context(), c.regionLookupTimeout)
-		err = client.Dial(dialCtx)
-		cancel()
+		dialCtx, dialCancel := context.WithTimeout(regCtx, c.regionLookupTimeout)
+		err = c.dial(dialCtx, client)
+		dialCancel()
 
 		if err == nil {
 			if reg == c.adminRegionInfo {
@@ -772,6 +1362,7 @@ func (c *client) establishRegion(reg hrpc.RegionInfo, addr string) {
 			}
 
 			if err = isRegionEstablished(client, reg); err == nil {
+				c.breakers.established(reg)
 				// set region client so that as soon as we mark it available,
 				// concurrent readers are able to find the client
 				reg.SetClient(client)
@@ -792,6 +1383,18 @@ func (c *client) establishRegion(reg hrpc.RegionInfo, addr string) {
 			c.clientDown(client, reg)
 		}
 
+		if c.noAutoReconnect {
+			// let the waiting rpcs fail rather than retrying
+			reg.SetClient(nil)
+			reg.MarkAvailable()
+
+			log.WithFields(log.Fields{
+				"region": reg,
+				"err":    err,
+			}).Debug("region was not established")
+			return
+		}
+
 		log.WithFields(log.Fields{
 			"region":  reg,
 			"backoff": backoff,
@@ -829,6 +1432,20 @@ type zkResult struct {
 	err  error
 }
 
+// rewriteAddr maps the host of addr with the function given
+// with RewriteHost option, if any.
+func (c *client) rewriteAddr(addr string) string {
+	if c.rewriteHost == nil || addr == "" {
+		return addr
+	}
+	host, port, err := net.SplitHostPort(addr)
+	if err != nil {
+		// no port
+		return c.rewriteHost(addr)
+	}
+	return net.JoinHostPort(c.rewriteHost(host), port)
+}
+
 // zkLookup asynchronously looks up the meta region or HMaster in ZooKeeper.
 func (c *client) zkLookup(ctx context.Context, resource zk.ResourceName) (string, error) {
 	// We make this a buffered channel so that if we stop waiting due to a
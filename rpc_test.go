@@ -8,17 +8,24 @@ package gohbase
 import (
 	"bytes"
 	"context"
+	"encoding/binary"
 	"errors"
 	"fmt"
+	"math"
 	"math/rand"
+	"net"
 	"reflect"
+	"runtime"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/baiweiguo/gohbase/compression"
+	"github.com/baiweiguo/gohbase/filter"
 	"github.com/baiweiguo/gohbase/hrpc"
 	"github.com/baiweiguo/gohbase/pb"
 	"github.com/baiweiguo/gohbase/region"
@@ -26,6 +33,7 @@ import (
 	"github.com/baiweiguo/gohbase/test/mock"
 	mockRegion "github.com/baiweiguo/gohbase/test/mock/region"
 	mockZk "github.com/baiweiguo/gohbase/test/mock/zk"
+	"github.com/baiweiguo/gohbase/testserver"
 	"github.com/baiweiguo/gohbase/zk"
 	"github.com/golang/mock/gomock"
 	"github.com/prometheus/client_golang/prometheus"
@@ -42,6 +50,7 @@ func newRegionClientFn(addr string) func() hrpc.RegionClient {
 }
 
 func newMockClient(zkClient zk.Client) *client {
+	ctx, cancel := context.WithCancel(context.Background())
 	return &client{
 		clientType: region.RegionClient,
 		regions:    keyRegionCache{regions: b.TreeNew[[]byte, hrpc.RegionInfo](region.Compare)},
@@ -57,6 +66,8 @@ func newMockClient(zkClient zk.Client) *client {
 		regionLookupTimeout: region.DefaultLookupTimeout,
 		regionReadTimeout:   region.DefaultReadTimeout,
 		newRegionClientFn:   newMockRegionClient,
+		ctx:                 ctx,
+		cancel:              cancel,
 	}
 }
 
@@ -301,7 +312,8 @@ func TestEstablishRegionDialFail(t *testing.T) {
 
 	newRegionClientFnCallCount := 0
 	c.newRegionClientFn = func(_ string, _ region.ClientType, _ int, _ time.Duration,
-		_ string, _ time.Duration, _ compression.Codec) hrpc.RegionClient {
+		_ string, _ time.Duration, _ compression.Codec,
+		_ ...region.ClientOption) hrpc.RegionClient {
 		var rc hrpc.RegionClient
 		if newRegionClientFnCallCount == 0 {
 			rc = rcFailDial
@@ -324,7 +336,7 @@ func TestEstablishRegionDialFail(t *testing.T) {
 	c.metaRegionInfo.SetClient(rc1)
 
 	// should get stuck if the region is never established
-	c.establishRegion(reg, "regionserver:1")
+	c.establishRegion(c.ctx, reg, "regionserver:1")
 
 	if len(c.clients.regions) != 2 {
 		t.Errorf("Expected 2 clients in cache, got %d", len(c.clients.regions))
@@ -335,6 +347,59 @@ func TestEstablishRegionDialFail(t *testing.T) {
 	}
 }
 
+func TestEstablishRegionCancel(t *testing.T) {
+	ctrl := test.NewController(t)
+	defer ctrl.Finish()
+
+	c := newMockClient(nil)
+
+	dialing := make(chan struct{})
+	rc := mockRegion.NewMockRegionClient(ctrl)
+	rc.EXPECT().Addr().Return("regionserver:1").AnyTimes()
+	rc.EXPECT().String().Return("regionserver:1").AnyTimes()
+	// block connecting until the establishment is cancelled
+	rc.EXPECT().Dial(gomock.Any()).DoAndReturn(func(ctx context.Context) error {
+		close(dialing)
+		<-ctx.Done()
+		return ctx.Err()
+	}).Times(1)
+	c.newRegionClientFn = func(_ string, _ region.ClientType, _ int, _ time.Duration,
+		_ string, _ time.Duration, _ compression.Codec,
+		_ ...region.ClientOption) hrpc.RegionClient {
+		return rc
+	}
+
+	reg := region.NewInfo(
+		0, nil, []byte("test1"), []byte("test1,,1434573235908.56f833d5569a27c7a43fbf547b4924a4."),
+		nil, nil)
+	reg.MarkUnavailable()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		c.establishRegion(ctx, reg, "regionserver:1")
+		close(done)
+	}()
+
+	<-dialing
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("establishRegion didn't return after being cancelled")
+	}
+
+	if reg.IsUnavailable() {
+		t.Error("Expected region to be available")
+	}
+	if reg.Context().Err() != nil {
+		t.Error("Expected region to be alive")
+	}
+	if reg.Client() != nil {
+		t.Errorf("Expected region to have no client, got %v", reg.Client())
+	}
+}
+
 func TestEstablishClientConcurrent(t *testing.T) {
 	// test that the same client isn't added when establishing it concurrently
 	// if there's a race, this test will only fail sometimes
@@ -362,7 +427,7 @@ func TestEstablishClientConcurrent(t *testing.T) {
 		r := r
 		wg.Add(1)
 		go func() {
-			c.establishRegion(r, "regionserver:1")
+			c.establishRegion(c.ctx, r, "regionserver:1")
 			wg.Done()
 		}()
 	}
@@ -539,6 +604,82 @@ func TestReestablishDeadRegion(t *testing.T) {
 	}
 }
 
+func TestParentContext(t *testing.T) {
+	ctrl := test.NewController(t)
+	defer ctrl.Finish()
+	// meta can't be located, so that regions are being reestablished forever
+	zkClient := mockZk.NewMockClient(ctrl)
+	zkClient.EXPECT().LocateResource(zk.Meta).Return("", errors.New("ooops")).AnyTimes()
+	c := newMockClient(zkClient)
+	c.done = make(chan struct{})
+	ctx, cancel := context.WithCancel(context.Background())
+	ParentContext(ctx)(c)
+	c.initContext()
+
+	goroutines := runtime.NumGoroutine()
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		reg := region.NewInfo(0, nil, []byte("test1"),
+			[]byte(fmt.Sprintf("test1,%d,1434573235908.yoloyoloyoloyoloyoloyoloyoloyolo.", i)),
+			[]byte(strconv.Itoa(i)), []byte(strconv.Itoa(i+1)))
+		reg.MarkUnavailable()
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			c.reestablishRegion(reg)
+		}()
+	}
+	// let reestablishing get stuck
+	time.Sleep(50 * time.Millisecond)
+
+	cancel()
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("reestablishing regions didn't stop after parent context was cancelled")
+	}
+
+	select {
+	case <-c.done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected client to be closed")
+	}
+
+	// background goroutines such as the one reestablishing meta region should be gone too
+	for i := 0; runtime.NumGoroutine() > goroutines; i++ {
+		if i == 100 {
+			t.Fatalf("expected at most %d goroutines, got %d",
+				goroutines, runtime.NumGoroutine())
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestContext(t *testing.T) {
+	c := newMockClient(nil)
+	c.done = make(chan struct{})
+	ctx, cancel := context.WithTimeout(c.Context(), time.Hour)
+	defer cancel()
+	if err := ctx.Err(); err != nil {
+		t.Fatalf("expected the context not to be done, got %v", err)
+	}
+
+	c.Close()
+	select {
+	case <-ctx.Done():
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected the derived context to be cancelled when the client is closed")
+	}
+	if err := ctx.Err(); err != context.Canceled {
+		t.Errorf("expected %v, got %v", context.Canceled, err)
+	}
+}
+
 func TestFindRegion(t *testing.T) {
 	// TODO: check regions are deleted from client's cache
 	// when regions are replaced
@@ -700,6 +841,104 @@ func TestMetaLookupCanceledContext(t *testing.T) {
 	}
 }
 
+// makeMetaResult returns a row of hbase:meta for the region of table
+// between startKey and stopKey located at addr
+func makeMetaResult(table, startKey, stopKey []byte, addr string) *pb.Result {
+	row := []byte(fmt.Sprintf("%s,%s,1434573235908.56f833d5569a27c7a43fbf547b4924a4.",
+		table, startKey))
+	regionInfo, err := proto.Marshal(&pb.RegionInfo{
+		RegionId: proto.Uint64(1434573235908),
+		TableName: &pb.TableName{
+			Namespace: []byte("default"),
+			Qualifier: table,
+		},
+		StartKey: startKey,
+		EndKey:   stopKey,
+		Offline:  proto.Bool(false),
+	})
+	if err != nil {
+		panic(err)
+	}
+	return &pb.Result{Cell: []*pb.Cell{
+		&pb.Cell{
+			Row:       row,
+			Family:    []byte("info"),
+			Qualifier: []byte("regioninfo"),
+			Value:     append([]byte("PBUF"), regionInfo...),
+		},
+		&pb.Cell{
+			Row:       row,
+			Family:    []byte("info"),
+			Qualifier: []byte("server"),
+			Value:     []byte(addr),
+		},
+	}}
+}
+
+func TestLookupRegionStaleMeta(t *testing.T) {
+	ctrl := test.NewController(t)
+	defer ctrl.Finish()
+
+	// first daughter of the split region, doesn't contain the key
+	daughterA := makeMetaResult([]byte("split"), nil, []byte("m"), "regionserver:1")
+	// second daughter of the split region, contains the key
+	daughterB := makeMetaResult([]byte("split"), []byte("m"), nil, "regionserver:2")
+
+	tests := []struct {
+		name         string
+		maxStaleness time.Duration
+		staleReplies int
+		expErr       error
+	}{
+		{name: "retried", maxStaleness: time.Minute, staleReplies: 2},
+		{name: "exceeded", maxStaleness: 0, staleReplies: 100, expErr: ErrStaleMeta},
+	}
+	for _, tcase := range tests {
+		t.Run(tcase.name, func(t *testing.T) {
+			c := newMockClient(nil)
+			MetaLookupMaxStaleness(tcase.maxStaleness)(c)
+
+			// pretend regionserver:0 has meta table
+			rc := mockRegion.NewMockRegionClient(ctrl)
+			rc.EXPECT().String().Return("mock region client").AnyTimes()
+			c.clients.put("regionserver:0", c.metaRegionInfo, func() hrpc.RegionClient {
+				return rc
+			})
+			c.metaRegionInfo.SetClient(rc)
+
+			var lookups int
+			rc.EXPECT().QueueRPC(gomock.Any()).AnyTimes().Do(func(rpc hrpc.Call) {
+				lookups++
+				res := daughterB
+				if lookups <= tcase.staleReplies {
+					res = daughterA
+				}
+				rpc.ResultChan() <- hrpc.RPCResult{Msg: &pb.ScanResponse{
+					Results: []*pb.Result{res}}}
+			})
+
+			reg, addr, err := c.lookupRegion(context.Background(),
+				[]byte("split"), []byte("m"))
+			if !errors.Is(err, tcase.expErr) {
+				t.Fatalf("expected error %v, got %v", tcase.expErr, err)
+			}
+			if tcase.expErr != nil {
+				if lookups != 1 {
+					t.Errorf("expected 1 lookup, got %d", lookups)
+				}
+				return
+			}
+			if lookups != tcase.staleReplies+1 {
+				t.Errorf("expected %d lookups, got %d", tcase.staleReplies+1, lookups)
+			}
+			if !bytes.Equal(reg.StartKey(), []byte("m")) || addr != "regionserver:2" {
+				t.Errorf("expected the second daughter region at regionserver:2, got %s at %s",
+					reg, addr)
+			}
+		})
+	}
+}
+
 func TestConcurrentRetryableError(t *testing.T) {
 	ctrl := test.NewController(t)
 	defer ctrl.Finish()
@@ -772,6 +1011,33 @@ func TestConcurrentRetryableError(t *testing.T) {
 	}
 }
 
+func TestRegionSearchKey(t *testing.T) {
+	long := bytes.Repeat([]byte("k"), math.MaxInt16)
+	for _, tcase := range []struct {
+		table, key []byte
+		expected   []byte
+	}{
+		{table: []byte("test"), key: nil, expected: []byte("test,,:")},
+		{table: []byte("test"), key: []byte("row"), expected: []byte("test,row,:")},
+		{table: []byte("ns:test"), key: []byte("a,b"), expected: []byte("ns:test,a,b,:")},
+		{table: []byte("test"), key: []byte{0, 255}, expected: []byte("test,\x00\xff,:")},
+		{table: []byte("test"), key: long,
+			expected: append(append([]byte("test,"), long[:math.MaxInt16-7]...), ",:"...)},
+	} {
+		got := RegionSearchKey(tcase.table, tcase.key)
+		if !bytes.Equal(tcase.expected, got) {
+			t.Errorf("expected %q for table %q and key %q, got %q",
+				tcase.expected, tcase.table, tcase.key, got)
+		}
+		if internal := createRegionSearchKey(tcase.table, tcase.key); !bytes.Equal(internal, got) {
+			t.Errorf("expected %q as looked up internally, got %q", internal, got)
+		}
+		if len(got) > math.MaxInt16 {
+			t.Errorf("expected a key of at most %d bytes, got %d", math.MaxInt16, len(got))
+		}
+	}
+}
+
 func TestProbeKey(t *testing.T) {
 	regions := []hrpc.RegionInfo{
 		region.NewInfo(0, nil, nil, nil, nil, nil),
@@ -815,6 +1081,7 @@ func BenchmarkPrometheusWithLabelValues(b *testing.B) {
 		o = operationDurationSeconds.WithLabelValues(
 			description,
 			result,
+			"",
 		)
 	}
 }
@@ -825,10 +1092,94 @@ func BenchmarkPrometheusWith(b *testing.B) {
 		o = operationDurationSeconds.With(prometheus.Labels{
 			"operation": description,
 			"result":    result,
+			"label":     "",
 		})
 	}
 }
 
+func TestMetricLabel(t *testing.T) {
+	registry := prometheus.NewPedanticRegistry()
+	registry.MustRegister(operationDurationSeconds)
+	// count returns the number of Gets observed with label
+	count := func(label string) uint64 {
+		families, err := registry.Gather()
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, f := range families {
+			for _, m := range f.GetMetric() {
+				labels := map[string]string{}
+				for _, l := range m.GetLabel() {
+					labels[l.GetName()] = l.GetValue()
+				}
+				if labels["operation"] == "Get" && labels["result"] == "ok" &&
+					labels["label"] == label {
+					return m.GetHistogram().GetSampleCount()
+				}
+			}
+		}
+		return 0
+	}
+
+	c := NewClientWithResolver(singleRegionResolver{}, func(addr string) hrpc.RegionClient {
+		return &flakyRegionClient{addr: addr, srv: &flakyServer{}}
+	})
+	defer c.Close()
+
+	before, unlabeled := count("user-profile-read"), count("")
+	for i := 0; i < 3; i++ {
+		g, err := hrpc.NewGetStr(context.Background(), "test", "row",
+			hrpc.MetricLabel("user-profile-read"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if g.MetricLabel() != "user-profile-read" {
+			t.Fatalf("expected label %q, got %q", "user-profile-read", g.MetricLabel())
+		}
+		if _, err := c.Get(g); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if n := count("user-profile-read") - before; n != 3 {
+		t.Errorf("expected 3 Gets to be recorded under the label, got %d", n)
+	}
+	if n := count("") - unlabeled; n != 0 {
+		t.Errorf("expected no unlabeled Gets to be recorded, got %d", n)
+	}
+}
+
+type statsRegionClient struct {
+	hrpc.RegionClient
+	stats hrpc.ConnStats
+}
+
+func (c *statsRegionClient) ConnStats() hrpc.ConnStats {
+	return c.stats
+}
+
+func TestConnStats(t *testing.T) {
+	c := newMockClient(nil)
+	stats := map[string]hrpc.ConnStats{
+		"regionserver:1": {BytesSent: 10, BytesReceived: 20, RPCsCompleted: 2},
+		"regionserver:2": {BytesSent: 5, BytesReceived: 0, RPCsFailed: 1},
+	}
+	for i, addr := range []string{"regionserver:1", "regionserver:2", "regionserver:3"} {
+		reg := region.NewInfo(0, nil, []byte("test"),
+			[]byte(fmt.Sprintf("test,%d,1234567890042.56f833d5569a27c7a43fbf547b4924a4.", i)),
+			[]byte(strconv.Itoa(i)), []byte(strconv.Itoa(i+1)))
+		rc := newRegionClientFn(addr)()
+		if s, ok := stats[addr]; ok {
+			rc = &statsRegionClient{RegionClient: rc, stats: s}
+		}
+		c.clients.put(addr, reg, func() hrpc.RegionClient { return rc })
+	}
+
+	// regionserver:3 doesn't keep stats
+	if got := c.ConnStats(); !reflect.DeepEqual(stats, got) {
+		t.Errorf("expected %v, got %v", stats, got)
+	}
+}
+
 func TestSendBatchBasic(t *testing.T) {
 	ctrl := test.NewController(t)
 	defer ctrl.Finish()
@@ -894,108 +1245,570 @@ func TestSendBatchBasic(t *testing.T) {
 
 }
 
-func TestSendBatchBadInput(t *testing.T) {
+func TestRewriteHost(t *testing.T) {
 	ctrl := test.NewController(t)
 	defer ctrl.Finish()
+	zkClient := mockZk.NewMockClient(ctrl)
+	zkClient.EXPECT().LocateResource(zk.Meta).Return("regionserver:1", nil).MinTimes(1)
+	c := newMockClient(zkClient)
+	RewriteHost(func(host string) string {
+		return host + ".example.com"
+	})(c)
+
+	var dialedM sync.Mutex
+	var dialed []string
+	c.newRegionClientFn = func(addr string, ctype region.ClientType, queueSize int,
+		flushInterval time.Duration, effectiveUser string, readTimeout time.Duration,
+		codec compression.Codec, options ...region.ClientOption) hrpc.RegionClient {
+		dialedM.Lock()
+		dialed = append(dialed, addr)
+		dialedM.Unlock()
+		return newMockRegionClient(addr, ctype, queueSize, flushInterval,
+			effectiveUser, readTimeout, codec, options...)
+	}
 
-	zkc := mockZk.NewMockClient(ctrl)
-	zkc.EXPECT().LocateResource(zk.Meta).Return("regionserver:1", nil).AnyTimes()
-	c := newMockClient(zkc)
+	mockCall := mock.NewMockCall(ctrl)
+	mockCall.EXPECT().Context().Return(context.Background()).AnyTimes()
+	mockCall.EXPECT().Description().AnyTimes()
+	mockCall.EXPECT().Table().Return([]byte("test")).AnyTimes()
+	mockCall.EXPECT().Key().Return([]byte("theKey")).AnyTimes()
+	mockCall.EXPECT().SetRegion(gomock.Any()).AnyTimes()
+	result := make(chan hrpc.RPCResult, 1)
+	result <- hrpc.RPCResult{Msg: &pb.ScanResponse{}}
+	mockCall.EXPECT().ResultChan().Return(result).Times(1)
+	if _, err := c.SendRPC(mockCall); err != nil {
+		t.Fatal(err)
+	}
 
-	newRPC := func(table string, batchable bool) hrpc.Call {
-		if batchable {
-			rpc, err := hrpc.NewPutStr(context.Background(), table, "key",
-				map[string]map[string][]byte{"cf": {"foo": []byte("bar")}})
-			if err != nil {
-				t.Fatal(err)
-			}
-			return rpc
-		}
-		rpc, err := hrpc.NewScanStr(context.Background(), table)
+	// both the address of hbase:meta from zookeeper and the address
+	// of the region from hbase:meta are rewritten
+	exp := []string{"regionserver.example.com:1", "regionserver.example.com:2"}
+	dialedM.Lock()
+	defer dialedM.Unlock()
+	if !reflect.DeepEqual(exp, dialed) {
+		t.Errorf("expected to dial %v, got %v", exp, dialed)
+	}
+}
+
+func TestInFlightRPCs(t *testing.T) {
+	ctrl := test.NewController(t)
+	defer ctrl.Finish()
+	c := newMockClient(nil)
+
+	name := "test,,1434573235908.56f833d5569a27c7a43fbf547b4924a4."
+	reg := region.NewInfo(0, nil, []byte("test"), []byte(name), nil, nil)
+	queued := make(chan hrpc.Call, 3)
+	rc := mockRegion.NewMockRegionClient(ctrl)
+	rc.EXPECT().Addr().Return("regionserver:1").AnyTimes()
+	rc.EXPECT().String().Return("regionserver:1").AnyTimes()
+	rc.EXPECT().QueueRPC(gomock.Any()).Times(3).Do(func(rpc hrpc.Call) {
+		// don't respond until the test says so
+		queued <- rpc
+	})
+	c.regions.put(reg)
+	c.clients.put("regionserver:1", reg, func() hrpc.RegionClient { return rc })
+	reg.SetClient(rc)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 3; i++ {
+		get, err := hrpc.NewGetStr(context.Background(), "test", "key", hrpc.SkipBatch())
 		if err != nil {
 			t.Fatal(err)
 		}
-		return rpc
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := c.Get(get); err != nil {
+				t.Error(err)
+			}
+		}()
 	}
 
-	rpc1 := newRPC("table", true)
-	rpc2 := newRPC("table", true)
+	calls := make([]hrpc.Call, 0, 3)
+	for i := 0; i < 3; i++ {
+		calls = append(calls, <-queued)
+	}
+	if exp, got := map[string]int{name: 3}, c.InFlightRPCs(); !reflect.DeepEqual(exp, got) {
+		t.Errorf("expected %v in flight, got %v", exp, got)
+	}
 
-	for _, tc := range []struct {
-		name   string
-		batch  []hrpc.Call
-		expErr []string
-	}{{
-		name:   "duplicate",
-		batch:  []hrpc.Call{rpc1, rpc1},
-		expErr: []string{NotExecutedError.Error(), "duplicate call"},
-	}, {
-		name:  "duplicate2",
-		batch: []hrpc.Call{rpc1, rpc2, rpc2, newRPC("table", true), rpc1},
-		expErr: []string{NotExecutedError.Error(), NotExecutedError.Error(),
-			"duplicate call", NotExecutedError.Error(), "duplicate call"},
-	}, {
-		name:   "tables",
-		batch:  []hrpc.Call{newRPC("table", true), newRPC("different_table", true)},
-		expErr: []string{NotExecutedError.Error(), "multiple tables"},
-	}, {
-		name:   "batchable",
-		batch:  []hrpc.Call{newRPC("table", false)},
-		expErr: []string{"non-batchable"},
-	}, {
-		name: "various errors",
-		batch: []hrpc.Call{rpc1,
-			newRPC("table", false),
-			rpc1,
-			newRPC("table2", true),
-			newRPC("table", true)},
-		expErr: []string{NotExecutedError.Error(),
-			"non-batchable",
-			"duplicate call",
-			"multiple tables",
-			NotExecutedError.Error()},
-	}} {
-		t.Run(tc.name, func(t *testing.T) {
-			if len(tc.batch) != len(tc.expErr) {
-				t.Fatalf("test case provides mismatched batch (%d) and expErr (%d) sizes",
-					len(tc.batch), len(tc.expErr))
-			}
+	for _, call := range calls {
+		call.ResultChan() <- hrpc.RPCResult{Msg: &pb.GetResponse{}}
+	}
+	wg.Wait()
+	if got := c.InFlightRPCs(); len(got) != 0 {
+		t.Errorf("expected no rpcs in flight, got %v", got)
+	}
+}
 
-			results, ok := c.SendBatch(context.Background(), tc.batch)
-			if ok {
-				t.Errorf("expected !ok from SendBatch, got %t", ok)
-			}
-			if len(results) != len(tc.batch) {
-				t.Fatalf("result size (%d) does not match batch size (%d)",
-					len(results), len(tc.batch))
+func TestReplicaID(t *testing.T) {
+	ctrl := test.NewController(t)
+	defer ctrl.Finish()
+	c := newMockClient(nil)
+
+	row := hrpc.ToLocalResult(metaRow)
+	row.Cells = append(row.Cells, &hrpc.Cell{
+		Row:       row.Cells[0].Row,
+		Family:    []byte("info"),
+		Qualifier: []byte("server_0001"),
+		Value:     []byte("regionserver:3"),
+	})
+	reg, addr, err := region.ParseRegionInfo(row)
+	if err != nil {
+		t.Fatal(err)
+	}
+	primary := mockRegion.NewMockRegionClient(ctrl)
+	primary.EXPECT().Addr().Return(addr).AnyTimes()
+	primary.EXPECT().String().Return(addr).AnyTimes()
+	c.regions.put(reg)
+	c.clients.put(addr, reg, func() hrpc.RegionClient { return primary })
+	reg.SetClient(primary)
+
+	var dialed []string
+	c.newRegionClientFn = func(addr string, _ region.ClientType, _ int, _ time.Duration,
+		_ string, _ time.Duration, _ compression.Codec,
+		_ ...region.ClientOption) hrpc.RegionClient {
+		dialed = append(dialed, addr)
+		rc := mockRegion.NewMockRegionClient(ctrl)
+		rc.EXPECT().Dial(gomock.Any()).Return(nil)
+		rc.EXPECT().QueueRPC(gomock.Any()).Do(func(rpc hrpc.Call) {
+			get := rpc.(*hrpc.Get).ToProto().(*pb.GetRequest)
+			if exp := region.NewReplicaInfo(reg, 1).Name(); !bytes.Equal(
+				get.Region.Value, exp) {
+				t.Errorf("expected request to region %q, got %q", exp, get.Region.Value)
 			}
-			for i, res := range results {
-				if res.Error == nil {
-					t.Errorf("expected error in res[%d], but got nil for request %v",
-						i, tc.batch[i])
-					continue
-				}
-				if !strings.Contains(res.Error.Error(), tc.expErr[i]) {
-					t.Errorf("expected error to contain %q, but got %q", tc.expErr[i], res.Error)
-				}
+			if get.Get.GetConsistency() != pb.Consistency_TIMELINE {
+				t.Errorf("expected timeline consistency, got %v", get.Get.Consistency)
 			}
+			rpc.ResultChan() <- hrpc.RPCResult{Msg: &pb.GetResponse{
+				Result: &pb.Result{Stale: proto.Bool(true)}}}
 		})
+		rc.EXPECT().Close()
+		return rc
+	}
+
+	get, err := hrpc.NewGetStr(context.Background(), "test", "key", hrpc.ReplicaID(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := c.Get(get)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !res.Stale {
+		t.Error("expected a stale result")
+	}
+	if exp := []string{"regionserver:3"}; !reflect.DeepEqual(exp, dialed) {
+		t.Errorf("expected to connect to %v, got %v", exp, dialed)
+	}
+
+	get, err = hrpc.NewGetStr(context.Background(), "test", "key", hrpc.ReplicaID(2))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.Get(get); !errors.Is(err, ErrReplicaNotFound) {
+		t.Errorf("expected %v, got %v", ErrReplicaNotFound, err)
 	}
 }
 
-// TestFindClient ensures findClients groups RPCs in a batch by region
-// server and preserves the ordering of requests. And that each RPC
-// has its region assigned.
-func TestFindClients(t *testing.T) {
+func TestSendBatchOrder(t *testing.T) {
+	ctrl := test.NewController(t)
+	defer ctrl.Finish()
 	c := newMockClient(nil)
-	// pretend regionserver:0 has meta table
-	rc := c.clients.put("regionserver:0", c.metaRegionInfo, newRegionClientFn("regionserver:0"))
-	c.metaRegionInfo.SetClient(rc)
 
-	registerRegion := func(reg hrpc.RegionInfo, addr string) {
-		rc := c.clients.put(addr, reg, newRegionClientFn(addr))
+	// two regions of the table at different regionservers
+	queued := map[string][]string{}
+	for _, r := range []struct {
+		addr, startKey, stopKey string
+	}{
+		{addr: "regionserver:1", startKey: "", stopKey: "m"},
+		{addr: "regionserver:2", startKey: "m", stopKey: ""},
+	} {
+		reg := region.NewInfo(0, nil, []byte("test"),
+			[]byte("test,"+r.startKey+",1434573235908.56f833d5569a27c7a43fbf547b4924a4."),
+			[]byte(r.startKey), []byte(r.stopKey))
+		rc := mockRegion.NewMockRegionClient(ctrl)
+		addr := r.addr
+		rc.EXPECT().Addr().Return(addr).AnyTimes()
+		rc.EXPECT().String().Return(addr).AnyTimes()
+		rc.EXPECT().QueueBatch(gomock.Any(), gomock.Any()).Times(1).Do(
+			func(ctx context.Context, rpcs []hrpc.Call) {
+				for _, rpc := range rpcs {
+					queued[addr] = append(queued[addr], string(rpc.Key()))
+					rpc.ResultChan() <- hrpc.RPCResult{Msg: &pb.MutateResponse{}}
+				}
+			})
+		c.regions.put(reg)
+		c.clients.put(addr, reg, func() hrpc.RegionClient { return rc })
 		reg.SetClient(rc)
-		overlaps, replaced := c.regions.put(reg)
+	}
+
+	keys := []string{"c", "x", "a", "n", "b", "z", "a", "m"}
+	batch := make([]hrpc.Call, len(keys))
+	for i, key := range keys {
+		var err error
+		batch[i], err = hrpc.NewPutStr(context.Background(), "test", key, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	if res, ok := c.SendBatch(context.Background(), batch); !ok {
+		t.Fatalf("unexpected failure: %v", res)
+	}
+
+	// calls sharing a region are queued in the order they were passed in
+	exp := map[string][]string{
+		"regionserver:1": []string{"c", "a", "b", "a"},
+		"regionserver:2": []string{"x", "n", "z", "m"},
+	}
+	if !reflect.DeepEqual(exp, queued) {
+		t.Errorf("expected calls to be queued in order %v, got %v", exp, queued)
+	}
+}
+
+func TestSendBatchPrefetchRegions(t *testing.T) {
+	ctrl := test.NewController(t)
+	defer ctrl.Finish()
+	c := newMockClient(nil)
+
+	// 10 regions of 5 keys each, at different regionservers
+	var metaRows []*pb.Result
+	for i := 0; i < 10; i++ {
+		var startKey, stopKey []byte
+		if i > 0 {
+			startKey = []byte(fmt.Sprintf("%02d", i*5))
+		}
+		if i < 9 {
+			stopKey = []byte(fmt.Sprintf("%02d", (i+1)*5))
+		}
+		info, err := proto.Marshal(&pb.RegionInfo{
+			RegionId:  proto.Uint64(1434573235908),
+			TableName: &pb.TableName{Namespace: []byte("default"), Qualifier: []byte("test")},
+			StartKey:  startKey,
+			EndKey:    stopKey,
+			Offline:   proto.Bool(false),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		row := []byte("test," + string(startKey) +
+			",1434573235908.56f833d5569a27c7a43fbf547b4924a4.")
+		metaRows = append(metaRows, &pb.Result{Cell: []*pb.Cell{{
+			Row:       row,
+			Family:    []byte("info"),
+			Qualifier: []byte("regioninfo"),
+			Value:     append([]byte("PBUF"), info...),
+		}, {
+			Row:       row,
+			Family:    []byte("info"),
+			Qualifier: []byte("server"),
+			Value:     []byte(fmt.Sprintf("regionserver:%d", i)),
+		}}})
+	}
+
+	var metaRPCs int32
+	metaClient := mockRegion.NewMockRegionClient(ctrl)
+	metaClient.EXPECT().Addr().Return("regionserver:meta").AnyTimes()
+	metaClient.EXPECT().String().Return("regionserver:meta").AnyTimes()
+	metaClient.EXPECT().QueueRPC(gomock.Any()).AnyTimes().Do(func(call hrpc.Call) {
+		atomic.AddInt32(&metaRPCs, 1)
+		scan := call.(*hrpc.Scan)
+		// reversed scan of the rows up to the start row
+		var results []*pb.Result
+		for i := len(metaRows) - 1; i >= 0 && len(results) < int(scan.NumberOfRows()); i-- {
+			if bytes.Compare(metaRows[i].Cell[0].Row, scan.StartRow()) <= 0 {
+				results = append(results, metaRows[i])
+			}
+		}
+		call.ResultChan() <- hrpc.RPCResult{Msg: &pb.ScanResponse{Results: results}}
+	})
+	c.clients.put("regionserver:meta", c.metaRegionInfo,
+		func() hrpc.RegionClient { return metaClient })
+	c.metaRegionInfo.SetClient(metaClient)
+
+	c.newRegionClientFn = func(addr string, _ region.ClientType, _ int, _ time.Duration,
+		_ string, _ time.Duration, _ compression.Codec,
+		_ ...region.ClientOption) hrpc.RegionClient {
+		rc := mockRegion.NewMockRegionClient(ctrl)
+		rc.EXPECT().Addr().Return(addr).AnyTimes()
+		rc.EXPECT().String().Return(addr).AnyTimes()
+		rc.EXPECT().Dial(gomock.Any()).Return(nil).AnyTimes()
+		rc.EXPECT().QueueRPC(gomock.Any()).AnyTimes().Do(func(call hrpc.Call) {
+			// region probe
+			call.ResultChan() <- hrpc.RPCResult{Msg: &pb.GetResponse{}}
+		})
+		rc.EXPECT().QueueBatch(gomock.Any(), gomock.Any()).AnyTimes().Do(
+			func(ctx context.Context, rpcs []hrpc.Call) {
+				for _, rpc := range rpcs {
+					rpc.ResultChan() <- hrpc.RPCResult{Msg: &pb.GetResponse{}}
+				}
+			})
+		return rc
+	}
+
+	batch := make([]hrpc.Call, 50)
+	for i := range batch {
+		var err error
+		batch[i], err = hrpc.NewGetStr(context.Background(), "test", fmt.Sprintf("%02d", i))
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	if res, ok := c.SendBatch(context.Background(), batch); !ok {
+		t.Fatalf("unexpected failure: %v", res)
+	}
+
+	if n := atomic.LoadInt32(&metaRPCs); n != 1 {
+		t.Errorf("expected regions to be looked up with 1 meta RPC, got %d", n)
+	}
+	if n := c.regions.regions.Len(); n != 10 {
+		t.Errorf("expected 10 regions in cache, got %d", n)
+	}
+	for _, rpc := range batch {
+		i, _ := strconv.Atoi(string(rpc.Key()))
+		if exp := fmt.Sprintf("regionserver:%d", i/5); rpc.Region().Client().Addr() != exp {
+			t.Errorf("expected key %q to be sent to %s, got %s",
+				rpc.Key(), exp, rpc.Region().Client().Addr())
+		}
+	}
+}
+
+func TestSendBatchMultipleTables(t *testing.T) {
+	ctrl := test.NewController(t)
+	defer ctrl.Finish()
+	c := newMockClient(nil)
+
+	// tables t1 and t2 are served by regionserver:1 and t3 by regionserver:2
+	queued := make(map[string][]string)
+	newRegionClient := func(addr string) hrpc.RegionClient {
+		rc := mockRegion.NewMockRegionClient(ctrl)
+		rc.EXPECT().Addr().Return(addr).AnyTimes()
+		rc.EXPECT().String().Return(addr).AnyTimes()
+		rc.EXPECT().QueueBatch(gomock.Any(), gomock.Any()).Times(1).Do(
+			func(ctx context.Context, rpcs []hrpc.Call) {
+				for _, rpc := range rpcs {
+					table, key := string(rpc.Table()), string(rpc.Key())
+					queued[addr] = append(queued[addr], table+"/"+key)
+					rpc.ResultChan() <- hrpc.RPCResult{Msg: &pb.GetResponse{
+						Result: &pb.Result{Cell: []*pb.Cell{{
+							Row:   rpc.Key(),
+							Value: []byte(table),
+						}}},
+					}}
+				}
+			})
+		return rc
+	}
+	rcs := map[string]hrpc.RegionClient{
+		"regionserver:1": newRegionClient("regionserver:1"),
+		"regionserver:2": newRegionClient("regionserver:2"),
+	}
+	for table, addr := range map[string]string{
+		"t1": "regionserver:1",
+		"t2": "regionserver:1",
+		"t3": "regionserver:2",
+	} {
+		reg := region.NewInfo(0, nil, []byte(table),
+			[]byte(table+",,1434573235908.56f833d5569a27c7a43fbf547b4924a4."), nil, nil)
+		rc := rcs[addr]
+		c.regions.put(reg)
+		c.clients.put(addr, reg, func() hrpc.RegionClient { return rc })
+		reg.SetClient(rc)
+	}
+
+	var batch []hrpc.Call
+	for _, tk := range [][2]string{{"t1", "a"}, {"t3", "b"}, {"t2", "c"}, {"t1", "d"}} {
+		get, err := hrpc.NewGetStr(context.Background(), tk[0], tk[1])
+		if err != nil {
+			t.Fatal(err)
+		}
+		batch = append(batch, get)
+	}
+	res, ok := c.SendBatch(context.Background(), batch)
+	if !ok {
+		t.Fatalf("expected the batch to succeed, got %v", res)
+	}
+	for i, r := range res {
+		cell := r.Msg.(*pb.GetResponse).GetResult().GetCell()[0]
+		if !bytes.Equal(cell.Row, batch[i].Key()) || !bytes.Equal(cell.Value, batch[i].Table()) {
+			t.Errorf("expected the result of %s/%s, got %s/%s",
+				batch[i].Table(), batch[i].Key(), cell.Value, cell.Row)
+		}
+	}
+	expected := map[string][]string{
+		"regionserver:1": {"t1/a", "t2/c", "t1/d"},
+		"regionserver:2": {"t3/b"},
+	}
+	if !reflect.DeepEqual(expected, queued) {
+		t.Errorf("expected calls to be queued as %v, got %v", expected, queued)
+	}
+}
+
+func TestSendBatchFailFast(t *testing.T) {
+	ctrl := test.NewController(t)
+	defer ctrl.Finish()
+	c := newMockClient(nil)
+
+	// regionserver:1 never answers and regionserver:2 fails right away
+	errFailed := errors.New("failed")
+	queued := make(chan context.Context, 1)
+	hanging := mockRegion.NewMockRegionClient(ctrl)
+	hanging.EXPECT().Addr().Return("regionserver:1").AnyTimes()
+	hanging.EXPECT().String().Return("regionserver:1").AnyTimes()
+	hanging.EXPECT().QueueBatch(gomock.Any(), gomock.Any()).Times(1).Do(
+		func(ctx context.Context, rpcs []hrpc.Call) { queued <- ctx })
+	failing := mockRegion.NewMockRegionClient(ctrl)
+	failing.EXPECT().Addr().Return("regionserver:2").AnyTimes()
+	failing.EXPECT().String().Return("regionserver:2").AnyTimes()
+	failing.EXPECT().QueueBatch(gomock.Any(), gomock.Any()).Times(1).Do(
+		func(ctx context.Context, rpcs []hrpc.Call) {
+			for _, rpc := range rpcs {
+				rpc.ResultChan() <- hrpc.RPCResult{Error: errFailed}
+			}
+		})
+	for table, rc := range map[string]hrpc.RegionClient{"t1": hanging, "t2": failing} {
+		reg := region.NewInfo(0, nil, []byte(table),
+			[]byte(table+",,1434573235908.56f833d5569a27c7a43fbf547b4924a4."), nil, nil)
+		rc := rc
+		c.regions.put(reg)
+		c.clients.put(rc.Addr(), reg, func() hrpc.RegionClient { return rc })
+		reg.SetClient(rc)
+	}
+
+	var batch []hrpc.Call
+	for _, table := range []string{"t1", "t2"} {
+		get, err := hrpc.NewGetStr(context.Background(), table, "a")
+		if err != nil {
+			t.Fatal(err)
+		}
+		batch = append(batch, get)
+	}
+	done := make(chan struct{})
+	var (
+		res []hrpc.RPCResult
+		ok  bool
+	)
+	go func() {
+		res, ok = c.SendBatchWithOptions(context.Background(), batch, hrpc.FailFast())
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected the batch to return on the first failure")
+	}
+	if ok {
+		t.Fatal("expected the batch to fail")
+	}
+	if res[0].Error != context.Canceled {
+		t.Errorf("expected the call left to be canceled, got %v", res[0].Error)
+	}
+	if res[1].Error != errFailed {
+		t.Errorf("expected %v, got %v", errFailed, res[1].Error)
+	}
+	if err := (<-queued).Err(); err != context.Canceled {
+		t.Errorf("expected the other requests to be canceled, got %v", err)
+	}
+}
+
+func TestSendBatchBadInput(t *testing.T) {
+	ctrl := test.NewController(t)
+	defer ctrl.Finish()
+
+	zkc := mockZk.NewMockClient(ctrl)
+	zkc.EXPECT().LocateResource(zk.Meta).Return("regionserver:1", nil).AnyTimes()
+	c := newMockClient(zkc)
+
+	newRPC := func(table string, batchable bool) hrpc.Call {
+		if batchable {
+			rpc, err := hrpc.NewPutStr(context.Background(), table, "key",
+				map[string]map[string][]byte{"cf": {"foo": []byte("bar")}})
+			if err != nil {
+				t.Fatal(err)
+			}
+			return rpc
+		}
+		rpc, err := hrpc.NewScanStr(context.Background(), table)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return rpc
+	}
+
+	rpc1 := newRPC("table", true)
+	rpc2 := newRPC("table", true)
+
+	for _, tc := range []struct {
+		name   string
+		batch  []hrpc.Call
+		expErr []string
+	}{{
+		name:   "duplicate",
+		batch:  []hrpc.Call{rpc1, rpc1},
+		expErr: []string{NotExecutedError.Error(), "duplicate call"},
+	}, {
+		name:  "duplicate2",
+		batch: []hrpc.Call{rpc1, rpc2, rpc2, newRPC("table", true), rpc1},
+		expErr: []string{NotExecutedError.Error(), NotExecutedError.Error(),
+			"duplicate call", NotExecutedError.Error(), "duplicate call"},
+	}, {
+		name:   "batchable",
+		batch:  []hrpc.Call{newRPC("table", false)},
+		expErr: []string{"non-batchable"},
+	}, {
+		name: "various errors",
+		batch: []hrpc.Call{rpc1,
+			newRPC("table", false),
+			rpc1,
+			newRPC("table2", true),
+			newRPC("table", true)},
+		expErr: []string{NotExecutedError.Error(),
+			"non-batchable",
+			"duplicate call",
+			NotExecutedError.Error(),
+			NotExecutedError.Error()},
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			if len(tc.batch) != len(tc.expErr) {
+				t.Fatalf("test case provides mismatched batch (%d) and expErr (%d) sizes",
+					len(tc.batch), len(tc.expErr))
+			}
+
+			results, ok := c.SendBatch(context.Background(), tc.batch)
+			if ok {
+				t.Errorf("expected !ok from SendBatch, got %t", ok)
+			}
+			if len(results) != len(tc.batch) {
+				t.Fatalf("result size (%d) does not match batch size (%d)",
+					len(results), len(tc.batch))
+			}
+			for i, res := range results {
+				if res.Error == nil {
+					t.Errorf("expected error in res[%d], but got nil for request %v",
+						i, tc.batch[i])
+					continue
+				}
+				if !strings.Contains(res.Error.Error(), tc.expErr[i]) {
+					t.Errorf("expected error to contain %q, but got %q", tc.expErr[i], res.Error)
+				}
+			}
+		})
+	}
+}
+
+// TestFindClient ensures findClients groups RPCs in a batch by region
+// server and preserves the ordering of requests. And that each RPC
+// has its region assigned.
+func TestFindClients(t *testing.T) {
+	c := newMockClient(nil)
+	// pretend regionserver:0 has meta table
+	rc := c.clients.put("regionserver:0", c.metaRegionInfo, newRegionClientFn("regionserver:0"))
+	c.metaRegionInfo.SetClient(rc)
+
+	registerRegion := func(reg hrpc.RegionInfo, addr string) {
+		rc := c.clients.put(addr, reg, newRegionClientFn(addr))
+		reg.SetClient(rc)
+		overlaps, replaced := c.regions.put(reg)
 		if len(overlaps) > 0 {
 			t.Fatalf("overlaps: %v replaced: %t", overlaps, replaced)
 		}
@@ -1530,3 +2343,1559 @@ func TestSendBatchWaitForCompletion(t *testing.T) {
 		}
 	})
 }
+
+func TestSendRPCRetries(t *testing.T) {
+	ctrl := test.NewController(t)
+	defer ctrl.Finish()
+
+	c := newMockClient(nil)
+	reg := region.NewInfo(0, nil, []byte("test"),
+		[]byte("test,,1234567890042.56f833d5569a27c7a43fbf547b4924a4."), nil, nil)
+	rc := mockRegion.NewMockRegionClient(ctrl)
+	rc.EXPECT().String().Return("mock region client").AnyTimes()
+	rc.EXPECT().Addr().Return("regionserver:1").AnyTimes()
+	c.regions.put(reg)
+	c.clients.put("regionserver:1", reg, func() hrpc.RegionClient { return rc })
+	reg.SetClient(rc)
+
+	// the region flaps once and then serves the call
+	gomock.InOrder(
+		rc.EXPECT().QueueRPC(gomock.Any()).Do(func(rpc hrpc.Call) {
+			rpc.ResultChan() <- hrpc.RPCResult{Error: region.RetryableError{}}
+		}),
+		rc.EXPECT().QueueRPC(gomock.Any()).Do(func(rpc hrpc.Call) {
+			rpc.ResultChan() <- hrpc.RPCResult{Msg: &pb.GetResponse{}}
+		}),
+	)
+
+	get, err := hrpc.NewGetStr(context.Background(), "test", "theKey")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.SendRPC(get); err != nil {
+		t.Fatal(err)
+	}
+	if get.Retries() != 1 {
+		t.Errorf("expected 1 retry, got %d", get.Retries())
+	}
+}
+
+func TestSendRPCRetriesNonce(t *testing.T) {
+	ctrl := test.NewController(t)
+	defer ctrl.Finish()
+
+	c := newMockClient(nil)
+	reg := region.NewInfo(0, nil, []byte("test"),
+		[]byte("test,,1234567890042.56f833d5569a27c7a43fbf547b4924a4."), nil, nil)
+	rc := mockRegion.NewMockRegionClient(ctrl)
+	rc.EXPECT().String().Return("mock region client").AnyTimes()
+	rc.EXPECT().Addr().Return("regionserver:1").AnyTimes()
+	c.regions.put(reg)
+	c.clients.put("regionserver:1", reg, func() hrpc.RegionClient { return rc })
+	reg.SetClient(rc)
+
+	var nonces []uint64
+	sentNonce := func(rpc hrpc.Call) {
+		r := rpc.ToProto().(*pb.MutateRequest)
+		if r.GetNonceGroup() != hrpc.NonceGroup() {
+			t.Errorf("expected nonce group %d, got %d", hrpc.NonceGroup(), r.GetNonceGroup())
+		}
+		nonces = append(nonces, r.GetMutation().GetNonce())
+	}
+	// the region flaps once and then serves the calls
+	gomock.InOrder(
+		rc.EXPECT().QueueRPC(gomock.Any()).Do(func(rpc hrpc.Call) {
+			sentNonce(rpc)
+			rpc.ResultChan() <- hrpc.RPCResult{Error: region.RetryableError{}}
+		}),
+		rc.EXPECT().QueueRPC(gomock.Any()).Do(func(rpc hrpc.Call) {
+			sentNonce(rpc)
+			rpc.ResultChan() <- hrpc.RPCResult{Msg: &pb.MutateResponse{}}
+		}).Times(2),
+	)
+
+	for i := 0; i < 2; i++ {
+		inc, err := hrpc.NewIncStrSingle(context.Background(), "test", "theKey",
+			"cf", "q", 1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := c.SendRPC(inc); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if len(nonces) != 3 {
+		t.Fatalf("expected 3 sent rpcs, got %d", len(nonces))
+	}
+	if nonces[0] == 0 || nonces[0] != nonces[1] {
+		t.Errorf("expected the retry to be sent with the same nonce, got %d and %d",
+			nonces[0], nonces[1])
+	}
+	if nonces[2] == 0 || nonces[2] == nonces[1] {
+		t.Errorf("expected the next increment to be sent with another nonce, got %d",
+			nonces[2])
+	}
+}
+
+func TestResultRegion(t *testing.T) {
+	ctrl := test.NewController(t)
+	defer ctrl.Finish()
+
+	c := newMockClient(nil)
+	reg := region.NewInfo(0, nil, []byte("test"),
+		[]byte("test,a,1234567890042.56f833d5569a27c7a43fbf547b4924a4."),
+		[]byte("a"), []byte("m"))
+	rc := mockRegion.NewMockRegionClient(ctrl)
+	rc.EXPECT().String().Return("mock region client").AnyTimes()
+	rc.EXPECT().Addr().Return("regionserver:1").AnyTimes()
+	c.regions.put(reg)
+	c.clients.put("regionserver:1", reg, func() hrpc.RegionClient { return rc })
+	reg.SetClient(rc)
+
+	gomock.InOrder(
+		rc.EXPECT().QueueRPC(gomock.Any()).Do(func(rpc hrpc.Call) {
+			rpc.ResultChan() <- hrpc.RPCResult{Msg: &pb.GetResponse{}}
+		}),
+		rc.EXPECT().QueueRPC(gomock.Any()).Do(func(rpc hrpc.Call) {
+			rpc.ResultChan() <- hrpc.RPCResult{Msg: &pb.MutateResponse{}}
+		}),
+	)
+
+	expected := &hrpc.RegionBounds{
+		Name:     reg.Name(),
+		StartKey: []byte("a"),
+		StopKey:  []byte("m"),
+	}
+
+	get, err := hrpc.NewGetStr(context.Background(), "test", "key")
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := c.Get(get)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(expected, res.Region) {
+		t.Errorf("expected Get result region %v, got %v", expected, res.Region)
+	}
+
+	put, err := hrpc.NewPutStr(context.Background(), "test", "key",
+		map[string]map[string][]byte{"cf": map[string][]byte{"q": []byte("v")}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err = c.Put(put)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(expected, res.Region) {
+		t.Errorf("expected Put result region %v, got %v", expected, res.Region)
+	}
+}
+
+func TestSendRPCThrottled(t *testing.T) {
+	ctrl := test.NewController(t)
+	defer ctrl.Finish()
+
+	c := newMockClient(nil)
+	reg := region.NewInfo(0, nil, []byte("test"),
+		[]byte("test,,1234567890042.56f833d5569a27c7a43fbf547b4924a4."), nil, nil)
+	rc := mockRegion.NewMockRegionClient(ctrl)
+	rc.EXPECT().String().Return("mock region client").AnyTimes()
+	rc.EXPECT().Addr().Return("regionserver:1").AnyTimes()
+	c.regions.put(reg)
+	c.clients.put("regionserver:1", reg, func() hrpc.RegionClient { return rc })
+	reg.SetClient(rc)
+
+	// the server asks to wait much longer than the default backoff
+	wait := 10 * backoffStart
+	var throttled time.Time
+	gomock.InOrder(
+		rc.EXPECT().QueueRPC(gomock.Any()).Do(func(rpc hrpc.Call) {
+			throttled = time.Now()
+			rpc.ResultChan() <- hrpc.RPCResult{Error: region.ThrottledError{Wait: wait}}
+		}),
+		rc.EXPECT().QueueRPC(gomock.Any()).Do(func(rpc hrpc.Call) {
+			if waited := time.Since(throttled); waited < wait {
+				t.Errorf("expected to wait at least %v before retrying, waited %v",
+					wait, waited)
+			}
+			rpc.ResultChan() <- hrpc.RPCResult{Msg: &pb.GetResponse{}}
+		}),
+	)
+
+	get, err := hrpc.NewGetStr(context.Background(), "test", "theKey")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.SendRPC(get); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestMutateProcessed(t *testing.T) {
+	srv := testserver.New()
+	defer srv.Close()
+	srv.CreateTable("test")
+	c := NewClient("", ZookeeperClient(srv), Dialer(srv.Dial))
+	defer c.Close()
+
+	put, err := hrpc.NewPutStr(context.Background(), "test", "row",
+		map[string]map[string][]byte{"cf": {"a": []byte("1")}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := c.Put(put)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Processed == nil || !*res.Processed {
+		t.Errorf("expected put to be processed, got %v", res.Processed)
+	}
+
+	// HBase doesn't tell whether a delete found anything to delete
+	del, err := hrpc.NewDelStr(context.Background(), "test", "missing",
+		map[string]map[string][]byte{"cf": {"a": nil}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err = c.Delete(del)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Processed == nil || !*res.Processed {
+		t.Errorf("expected delete of a missing cell to be processed, got %v", res.Processed)
+	}
+}
+
+func TestRPCTimeouts(t *testing.T) {
+	ctrl := test.NewController(t)
+	defer ctrl.Finish()
+
+	c := newMockClient(nil)
+	ReadRPCTimeout(10 * time.Millisecond)(c)
+	WriteRPCTimeout(time.Second)(c)
+	reg := region.NewInfo(0, nil, []byte("test"),
+		[]byte("test,,1234567890042.56f833d5569a27c7a43fbf547b4924a4."), nil, nil)
+	rc := mockRegion.NewMockRegionClient(ctrl)
+	rc.EXPECT().String().Return("mock region client").AnyTimes()
+	rc.EXPECT().Addr().Return("regionserver:1").AnyTimes()
+	c.regions.put(reg)
+	c.clients.put("regionserver:1", reg, func() hrpc.RegionClient { return rc })
+	reg.SetClient(rc)
+
+	// the regionserver never replies
+	rc.EXPECT().QueueRPC(gomock.Any()).AnyTimes()
+
+	get, err := hrpc.NewGetStr(context.Background(), "test", "theKey")
+	if err != nil {
+		t.Fatal(err)
+	}
+	start := time.Now()
+	if _, err := c.Get(get); err != context.DeadlineExceeded {
+		t.Errorf("expected %v, got %v", context.DeadlineExceeded, err)
+	}
+	if took := time.Since(start); took < c.readRPCTimeout || took >= c.writeRPCTimeout {
+		t.Errorf("expected Get to time out after %v, took %v", c.readRPCTimeout, took)
+	}
+
+	WriteRPCTimeout(10 * time.Millisecond)(c)
+	ReadRPCTimeout(time.Second)(c)
+	put, err := hrpc.NewPutStr(context.Background(), "test", "theKey",
+		map[string]map[string][]byte{"cf": {"a": []byte("1")}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	start = time.Now()
+	if _, err := c.Put(put); err != context.DeadlineExceeded {
+		t.Errorf("expected %v, got %v", context.DeadlineExceeded, err)
+	}
+	if took := time.Since(start); took < c.writeRPCTimeout || took >= c.readRPCTimeout {
+		t.Errorf("expected Put to time out after %v, took %v", c.writeRPCTimeout, took)
+	}
+
+	start = time.Now()
+	if _, err := c.CheckAndMutate([]*hrpc.Mutate{put}, "cf", "a", filter.Equal,
+		filter.NewBinaryComparator(filter.NewByteArrayComparable([]byte("0")))); err !=
+		context.DeadlineExceeded {
+		t.Errorf("expected %v, got %v", context.DeadlineExceeded, err)
+	}
+	if took := time.Since(start); took < c.writeRPCTimeout || took >= c.readRPCTimeout {
+		t.Errorf("expected CheckAndMutate to time out after %v, took %v",
+			c.writeRPCTimeout, took)
+	}
+
+	// deadline of the caller wins
+	ctx, cancel := context.WithTimeout(context.Background(), 40*time.Millisecond)
+	defer cancel()
+	get, err = hrpc.NewGetStr(ctx, "test", "theKey")
+	if err != nil {
+		t.Fatal(err)
+	}
+	start = time.Now()
+	if _, err := c.Get(get); err != context.DeadlineExceeded {
+		t.Errorf("expected %v, got %v", context.DeadlineExceeded, err)
+	}
+	if took := time.Since(start); took < 40*time.Millisecond {
+		t.Errorf("expected Get to time out after the deadline of its context, took %v", took)
+	}
+}
+
+// unavailableZk is a ZooKeeper client that can't locate anything.
+type unavailableZk struct{}
+
+func (unavailableZk) LocateResource(zk.ResourceName) (string, error) {
+	return "", errors.New("zookeeper is unavailable")
+}
+
+func TestMasterLookupMaxWait(t *testing.T) {
+	ac := newAdminClient("", ZookeeperClient(unavailableZk{}),
+		MasterLookupMaxWait(100*time.Millisecond))
+	defer ac.(*client).Close()
+
+	ltn, err := hrpc.NewListTableNames(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	start := time.Now()
+	if _, err := ac.ListTableNames(ltn); err != ErrMasterUnavailable {
+		t.Errorf("expected %v, got %v", ErrMasterUnavailable, err)
+	}
+	if took := time.Since(start); took > time.Second {
+		t.Errorf("expected admin operation to fail within %v, took %v", time.Second, took)
+	}
+
+	// next operations look up the master again
+	start = time.Now()
+	if _, err := ac.ListTableNames(ltn); err != ErrMasterUnavailable {
+		t.Errorf("expected %v, got %v", ErrMasterUnavailable, err)
+	}
+	if took := time.Since(start); took < 100*time.Millisecond {
+		t.Errorf("expected the master to be looked up again, took %v", took)
+	}
+}
+
+func TestRegisterTableDefaults(t *testing.T) {
+	srv := testserver.New()
+	defer srv.Close()
+	srv.CreateTable("test")
+	c := NewClient("", ZookeeperClient(srv), Dialer(srv.Dial)).(*client)
+	defer c.Close()
+
+	put, err := hrpc.NewPutStr(context.Background(), "test", "row",
+		map[string]map[string][]byte{"cf": {"a": []byte("1")}, "cf2": {"b": []byte("2")}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.Put(put); err != nil {
+		t.Fatal(err)
+	}
+
+	// Families option can't be used with mutations, so it's skipped for them
+	c.RegisterTableDefaults("test", hrpc.Families(map[string][]string{"cf": nil}))
+	put, err = hrpc.NewPutStr(context.Background(), "test", "row",
+		map[string]map[string][]byte{"cf2": {"c": []byte("3")}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.Put(put); err != nil {
+		t.Fatal(err)
+	}
+
+	families := func(res *hrpc.Result) map[string]int {
+		m := make(map[string]int)
+		for _, cell := range res.Cells {
+			m[string(cell.Family)]++
+		}
+		return m
+	}
+
+	get, err := hrpc.NewGetStr(context.Background(), "test", "row")
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := c.Get(get)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if exp, got := map[string]int{"cf": 1}, families(res); !reflect.DeepEqual(exp, got) {
+		t.Errorf("expected default families %v, got %v", exp, got)
+	}
+
+	get, err = hrpc.NewGetStr(context.Background(), "test", "row",
+		hrpc.Families(map[string][]string{"cf2": nil}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err = c.Get(get)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if exp, got := map[string]int{"cf2": 2}, families(res); !reflect.DeepEqual(exp, got) {
+		t.Errorf("expected overridden families %v, got %v", exp, got)
+	}
+
+	// defaults apply to scans too
+	scan, err := hrpc.NewScanStr(context.Background(), "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	scanner := c.Scan(scan)
+	res, err = scanner.Next()
+	if err != nil {
+		t.Fatal(err)
+	}
+	scanner.Close()
+	if exp, got := map[string]int{"cf": 1}, families(res); !reflect.DeepEqual(exp, got) {
+		t.Errorf("expected default families in scan %v, got %v", exp, got)
+	}
+
+	// without defaults all the families are read
+	c.RegisterTableDefaults("test")
+	get, err = hrpc.NewGetStr(context.Background(), "test", "row")
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err = c.Get(get)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if exp, got := map[string]int{"cf": 1, "cf2": 2}, families(res); !reflect.DeepEqual(exp, got) {
+		t.Errorf("expected all families %v, got %v", exp, got)
+	}
+}
+
+func TestNoAutoReconnect(t *testing.T) {
+	ctrl := test.NewController(t)
+	defer ctrl.Finish()
+	c := newMockClient(nil)
+	NoAutoReconnect()(c)
+
+	// pretend regionserver:0 has meta table
+	rc := c.clients.put("regionserver:0", c.metaRegionInfo, newRegionClientFn("regionserver:0"))
+	c.metaRegionInfo.SetClient(rc)
+
+	// "test" is at the moment at regionserver:1, which fails
+	reg := region.NewInfo(0, nil, []byte("test"),
+		[]byte("test,,1434573235908.56f833d5569a27c7a43fbf547b4924a4."), nil, nil)
+	rcDown := mockRegion.NewMockRegionClient(ctrl)
+	rcDown.EXPECT().Addr().Return("regionserver:1").AnyTimes()
+	rcDown.EXPECT().String().Return("regionserver:1").AnyTimes()
+	rcDown.EXPECT().QueueRPC(gomock.Any()).Do(func(rpc hrpc.Call) {
+		rpc.ResultChan() <- hrpc.RPCResult{Error: region.ServerError{}}
+	}).Times(1)
+	reg.SetClient(c.clients.put("regionserver:1", reg, func() hrpc.RegionClient {
+		return rcDown
+	}))
+	c.regions.put(reg)
+
+	// meta says "test" is at regionserver:2, which can't be connected to
+	rcFailDial := mockRegion.NewMockRegionClient(ctrl)
+	rcFailDial.EXPECT().Addr().Return("regionserver:2").AnyTimes()
+	rcFailDial.EXPECT().String().Return("regionserver:2").AnyTimes()
+	rcFailDial.EXPECT().Dial(gomock.Any()).Return(errors.New("ooops")).Times(1)
+	c.newRegionClientFn = func(_ string, _ region.ClientType, _ int, _ time.Duration,
+		_ string, _ time.Duration, _ compression.Codec,
+		_ ...region.ClientOption) hrpc.RegionClient {
+		return rcFailDial
+	}
+
+	get, err := hrpc.NewGetStr(context.Background(), "test", "row")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.SendRPC(get); !errors.As(err, &region.ServerError{}) {
+		t.Fatalf("expected ServerError, got %v", err)
+	}
+	if reg.IsUnavailable() {
+		t.Error("expected region not to be reestablished in background")
+	}
+	if reg.Client() != nil {
+		t.Errorf("expected region to have no client, got %v", reg.Client())
+	}
+
+	if _, err := c.SendRPC(get); err != ErrRegionUnavailable {
+		t.Fatalf("expected %v, got %v", ErrRegionUnavailable, err)
+	}
+	if reg.IsUnavailable() {
+		t.Error("expected region not to be reestablished in background")
+	}
+}
+
+func TestMaxCellSize(t *testing.T) {
+	ctrl := test.NewController(t)
+	defer ctrl.Finish()
+
+	c := newMockClient(nil)
+	MaxCellSize(100)(c)
+	reg := region.NewInfo(0, nil, []byte("test"),
+		[]byte("test,,1234567890042.56f833d5569a27c7a43fbf547b4924a4."), nil, nil)
+	rc := mockRegion.NewMockRegionClient(ctrl)
+	rc.EXPECT().String().Return("mock region client").AnyTimes()
+	rc.EXPECT().Addr().Return("regionserver:1").AnyTimes()
+	c.regions.put(reg)
+	c.clients.put("regionserver:1", reg, func() hrpc.RegionClient { return rc })
+	reg.SetClient(rc)
+
+	// key "key", family "cf" and qualifier "q" take 26 bytes of a cell
+	newPut := func(valueSize int) *hrpc.Mutate {
+		put, err := hrpc.NewPutStr(context.Background(), "test", "key",
+			map[string]map[string][]byte{"cf": map[string][]byte{
+				"q": make([]byte, valueSize)}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return put
+	}
+
+	// rejected by the client
+	_, err := c.SendRPC(newPut(75))
+	if !errors.Is(err, ErrCellTooLarge) {
+		t.Fatalf("expected %v, got %v", ErrCellTooLarge, err)
+	}
+	var tooLarge region.CellTooLargeError
+	if !errors.As(err, &tooLarge) || tooLarge.Size != 101 || tooLarge.Limit != 100 {
+		t.Errorf("expected cell of size 101 over limit 100, got %v", err)
+	}
+	res, _ := c.SendBatch(context.Background(), []hrpc.Call{newPut(75)})
+	if !errors.Is(res[0].Error, ErrCellTooLarge) {
+		t.Errorf("expected %v in batch, got %v", ErrCellTooLarge, res[0].Error)
+	}
+
+	// rejected by the regionserver, which has a lower limit
+	serverErr := region.CellTooLargeError{Size: 100, Limit: 50,
+		Err: errors.New("HBase Java exception org.apache.hadoop.hbase.DoNotRetryIOException")}
+	rc.EXPECT().QueueRPC(gomock.Any()).Do(func(rpc hrpc.Call) {
+		rpc.ResultChan() <- hrpc.RPCResult{Error: serverErr}
+	}).Times(1)
+	_, err = c.SendRPC(newPut(74))
+	if !errors.Is(err, ErrCellTooLarge) {
+		t.Fatalf("expected %v, got %v", ErrCellTooLarge, err)
+	}
+	if !errors.As(err, &tooLarge) || tooLarge.Size != 100 || tooLarge.Limit != 50 {
+		t.Errorf("expected cell of size 100 over limit 50, got %v", err)
+	}
+}
+
+func TestClusterID(t *testing.T) {
+	srv := testserver.New()
+	defer srv.Close()
+	srv.SetClusterID("cluster-a")
+
+	c := NewClient("", ZookeeperClient(srv), Dialer(srv.Dial)).(*client)
+	defer c.Close()
+	if id, err := c.ClusterID(); err != nil || id != "cluster-a" {
+		t.Errorf("expected cluster ID %q, got %q, %v", "cluster-a", id, err)
+	}
+
+	ca, err := NewClientWithClusterID("", "cluster-a", ZookeeperClient(srv), Dialer(srv.Dial))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ca.Close()
+
+	_, err = NewClientWithClusterID("", "cluster-b", ZookeeperClient(srv), Dialer(srv.Dial))
+	if !errors.Is(err, ErrClusterIDMismatch) {
+		t.Errorf("expected %v, got %v", ErrClusterIDMismatch, err)
+	}
+
+	// the ZooKeeper client can't read the cluster ID
+	_, err = NewClientWithClusterID("", "cluster-a", ZookeeperClient(unavailableZk{}))
+	if err == nil || errors.Is(err, ErrClusterIDMismatch) {
+		t.Errorf("expected an error reading the cluster ID, got %v", err)
+	}
+}
+
+// metaZk is a ZooKeeper client locating hbase:meta at regionserver:meta.
+type metaZk struct{}
+
+func (metaZk) LocateResource(resource zk.ResourceName) (string, error) {
+	if resource != zk.Meta.Prepend(defaultZkRoot) {
+		return "", fmt.Errorf("can't locate %s", resource)
+	}
+	return "regionserver:meta", nil
+}
+
+func TestGetTableState(t *testing.T) {
+	ctrl := test.NewController(t)
+	defer ctrl.Finish()
+
+	// the table:state column of the rows of the tables in hbase:meta,
+	// which has none for tables that don't exist
+	states := map[string]pb.Table_State{
+		"enabled":     pb.Table_ENABLED,
+		"ns:disabled": pb.Table_DISABLED,
+		"disabling":   pb.Table_DISABLING,
+		"enabling":    pb.Table_ENABLING,
+	}
+	ac := newAdminClient("", ZookeeperClient(metaZk{}))
+	defer ac.(*client).Close()
+	ac.(*client).newRegionClientFn = func(addr string, ctype region.ClientType, _ int,
+		_ time.Duration, _ string, _ time.Duration, _ compression.Codec,
+		_ ...region.ClientOption) hrpc.RegionClient {
+		if addr != "regionserver:meta" || ctype != region.RegionClient {
+			t.Errorf("unexpected connection to %s of type %s", addr, ctype)
+		}
+		rc := mockRegion.NewMockRegionClient(ctrl)
+		rc.EXPECT().Addr().Return(addr).AnyTimes()
+		rc.EXPECT().String().Return(addr).AnyTimes()
+		rc.EXPECT().Dial(gomock.Any()).Return(nil)
+		rc.EXPECT().Close()
+		rc.EXPECT().QueueRPC(gomock.Any()).Do(func(call hrpc.Call) {
+			get := call.(*hrpc.Get)
+			cols := get.ToProto().(*pb.GetRequest).GetGet().GetColumn()
+			if !bytes.Equal(get.Table(), metaTableName) || len(cols) != 1 ||
+				string(cols[0].GetFamily()) != "table" ||
+				len(cols[0].GetQualifier()) != 1 || string(cols[0].GetQualifier()[0]) != "state" {
+				t.Errorf("unexpected get of %s %v", get.Table(), cols)
+			}
+			result := &pb.Result{}
+			if state, ok := states[string(get.Key())]; ok {
+				value, err := proto.Marshal(&pb.Table{State: state.Enum()})
+				if err != nil {
+					t.Fatal(err)
+				}
+				result.Cell = []*pb.Cell{{
+					Row:       get.Key(),
+					Family:    []byte("table"),
+					Qualifier: []byte("state"),
+					Value:     value,
+				}}
+			}
+			call.ResultChan() <- hrpc.RPCResult{Msg: &pb.GetResponse{Result: result}}
+		})
+		return rc
+	}
+
+	for table, expected := range states {
+		if state, err := ac.GetTableState(table); err != nil || state != expected {
+			t.Errorf("expected table %q to be %s, got %s, %v", table, expected, state, err)
+		}
+	}
+	if _, err := ac.GetTableState("unknown"); !errors.Is(err, TableNotFound) {
+		t.Errorf("expected TableNotFound for an unknown table, got %v", err)
+	}
+
+	// hbase:meta can't be located
+	ac = newAdminClient("", ZookeeperClient(unavailableZk{}))
+	defer ac.(*client).Close()
+	if _, err := ac.GetTableState("enabled"); err == nil {
+		t.Error("expected an error reading the state of a table")
+	}
+}
+
+func TestMaxRegionLookupDuration(t *testing.T) {
+	// hbase:meta can never be located
+	c := newClient("", ZookeeperClient(unavailableZk{}),
+		MaxRegionLookupDuration(200*time.Millisecond))
+	defer c.Close()
+
+	get, err := hrpc.NewGetStr(context.Background(), "test", "theKey")
+	if err != nil {
+		t.Fatal(err)
+	}
+	start := time.Now()
+	_, err = c.Get(get)
+	took := time.Since(start)
+	if !errors.Is(err, ErrRegionLookupTimeout) {
+		t.Fatalf("expected %v, got %v", ErrRegionLookupTimeout, err)
+	}
+	if took < 200*time.Millisecond || took > 2*time.Second {
+		t.Errorf("expected the lookup to abort after 200ms, took %v", took)
+	}
+}
+
+func TestTargetRegionServer(t *testing.T) {
+	ctrl := test.NewController(t)
+	defer ctrl.Finish()
+
+	c := newMockClient(nil)
+	// pretend regionserver:0 has meta table
+	rc := c.clients.put("regionserver:0", c.metaRegionInfo, newRegionClientFn("regionserver:0"))
+	c.metaRegionInfo.SetClient(rc)
+
+	// the cache says "test" is at regionserver:1, which must not be used
+	reg := region.NewInfo(0, nil, []byte("test"),
+		[]byte("test,,1434573235908.56f833d5569a27c7a43fbf547b4924a4."), nil, nil)
+	rcCached := mockRegion.NewMockRegionClient(ctrl)
+	rcCached.EXPECT().Addr().Return("regionserver:1").AnyTimes()
+	rcCached.EXPECT().String().Return("regionserver:1").AnyTimes()
+	reg.SetClient(c.clients.put("regionserver:1", reg, func() hrpc.RegionClient {
+		return rcCached
+	}))
+	c.regions.put(reg)
+
+	rcTarget := mockRegion.NewMockRegionClient(ctrl)
+	rcTarget.EXPECT().Addr().Return("regionserver:7").AnyTimes()
+	rcTarget.EXPECT().String().Return("regionserver:7").AnyTimes()
+	gomock.InOrder(
+		rcTarget.EXPECT().Dial(gomock.Any()).Return(nil),
+		rcTarget.EXPECT().QueueRPC(gomock.Any()).Do(func(rpc hrpc.Call) {
+			if !bytes.Equal(rpc.Region().Name(), reg.Name()) {
+				t.Errorf("expected region %q, got %q", reg.Name(), rpc.Region().Name())
+			}
+			rpc.ResultChan() <- hrpc.RPCResult{Msg: &pb.GetResponse{}}
+		}),
+		rcTarget.EXPECT().Close(),
+	)
+	c.newRegionClientFn = func(addr string, _ region.ClientType, _ int, _ time.Duration,
+		_ string, _ time.Duration, _ compression.Codec,
+		_ ...region.ClientOption) hrpc.RegionClient {
+		if addr != "regionserver:7" {
+			t.Errorf("expected a client for regionserver:7, got %s", addr)
+		}
+		return rcTarget
+	}
+
+	get, err := hrpc.NewGetStr(context.Background(), "test", "theKey",
+		hrpc.TargetRegionServer("regionserver:7"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.Get(get); err != nil {
+		t.Fatal(err)
+	}
+
+	// caches are left as they were
+	if r := c.getRegionFromCache([]byte("test"), []byte("theKey")); r != reg {
+		t.Errorf("expected cached region %v, got %v", reg, r)
+	}
+	if reg.Client() != rcCached {
+		t.Errorf("expected region client %v, got %v", rcCached, reg.Client())
+	}
+	if n := len(c.clients.regions); n != 2 {
+		t.Errorf("expected 2 cached clients, got %d", n)
+	}
+
+	// pinned calls can't be batched
+	res, ok := c.SendBatch(context.Background(), []hrpc.Call{get})
+	if ok || res[0].Error == nil {
+		t.Error("expected a pinned call to be rejected by SendBatch")
+	}
+}
+
+func TestStats(t *testing.T) {
+	ctrl := test.NewController(t)
+	defer ctrl.Finish()
+
+	var calls int32
+	newRegionClient := func(addr string) hrpc.RegionClient {
+		rc := mockRegion.NewMockRegionClient(ctrl)
+		rc.EXPECT().Dial(gomock.Any()).Return(nil).AnyTimes()
+		rc.EXPECT().Addr().Return(addr).AnyTimes()
+		rc.EXPECT().String().Return(addr).AnyTimes()
+		rc.EXPECT().Close().AnyTimes()
+		rc.EXPECT().QueueRPC(gomock.Any()).AnyTimes().Do(func(rpc hrpc.Call) {
+			switch {
+			case string(rpc.Key()) == "slow":
+				// never answered
+			case atomic.AddInt32(&calls, 1) == 2:
+				// the first rpc after the probe of the region loses the connection
+				rpc.ResultChan() <- hrpc.RPCResult{
+					Error: region.ServerError{}}
+			default:
+				rpc.ResultChan() <- hrpc.RPCResult{
+					Msg: &pb.GetResponse{Result: &pb.Result{}}}
+			}
+		})
+		return rc
+	}
+	c := NewClientWithResolver(singleRegionResolver{}, newRegionClient).(*client)
+	defer c.Close()
+
+	check := func(expected hrpc.ClientStats) {
+		t.Helper()
+		if stats := c.Stats(); stats != expected {
+			t.Errorf("expected stats %+v, got %+v", expected, stats)
+		}
+	}
+
+	// cache miss, reconnect, retry and success
+	get, err := hrpc.NewGetStr(context.Background(), "test", "row")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.Get(get); err != nil {
+		t.Fatal(err)
+	}
+	check(hrpc.ClientStats{RegionLookups: 2, CacheHits: 1, Reconnects: 1, Retries: 1})
+
+	// cache hit
+	get, err = hrpc.NewGetStr(context.Background(), "test", "row")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.Get(get); err != nil {
+		t.Fatal(err)
+	}
+	check(hrpc.ClientStats{RegionLookups: 2, CacheHits: 2, Reconnects: 1, Retries: 1})
+
+	// deadline exceeded
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	get, err = hrpc.NewGetStr(ctx, "test", "slow")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.Get(get); err != context.DeadlineExceeded {
+		t.Fatalf("expected %v, got %v", context.DeadlineExceeded, err)
+	}
+	check(hrpc.ClientStats{RegionLookups: 2, CacheHits: 3, Reconnects: 1, Retries: 1,
+		DeadlinesExceeded: 1})
+}
+
+func TestCoprocessorService(t *testing.T) {
+	ctrl := test.NewController(t)
+	defer ctrl.Finish()
+
+	c := newMockClient(nil)
+	reg := region.NewInfo(0, nil, []byte("test"),
+		[]byte("test,,1234567890042.56f833d5569a27c7a43fbf547b4924a4."), nil, nil)
+	rc := mockRegion.NewMockRegionClient(ctrl)
+	rc.EXPECT().String().Return("mock region client").AnyTimes()
+	rc.EXPECT().Addr().Return("regionserver:1").AnyTimes()
+	c.regions.put(reg)
+	c.clients.put("regionserver:1", reg, func() hrpc.RegionClient { return rc })
+	reg.SetClient(rc)
+
+	rc.EXPECT().QueueRPC(gomock.Any()).Times(1).Do(func(rpc hrpc.Call) {
+		if rpc.Name() != "ExecService" {
+			t.Errorf("expected an ExecService call, got %s", rpc.Name())
+		}
+		b, err := proto.Marshal(rpc.ToProto())
+		if err != nil {
+			t.Fatal(err)
+		}
+		var req pb.CoprocessorServiceRequest
+		if err := proto.Unmarshal(b, &req); err != nil {
+			t.Fatal(err)
+		}
+		expected := &pb.CoprocessorServiceRequest{
+			Region: &pb.RegionSpecifier{
+				Type:  pb.RegionSpecifier_REGION_NAME.Enum(),
+				Value: reg.Name(),
+			},
+			Call: &pb.CoprocessorServiceCall{
+				Row:         []byte("theKey"),
+				ServiceName: proto.String("hbase.pb.AggregateService"),
+				MethodName:  proto.String("GetRowNum"),
+				Request:     []byte("request"),
+			},
+		}
+		if !proto.Equal(expected, &req) {
+			t.Errorf("expected request %v, got %v", expected, &req)
+		}
+		rpc.ResultChan() <- hrpc.RPCResult{Msg: &pb.CoprocessorServiceResponse{
+			Region: expected.Region,
+			Value: &pb.NameBytesPair{
+				Name:  proto.String("hbase.pb.AggregateResponse"),
+				Value: []byte("response"),
+			},
+		}}
+	})
+
+	res, err := c.CoprocessorService(context.Background(), []byte("test"), []byte("theKey"),
+		"hbase.pb.AggregateService", "GetRowNum", []byte("request"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(res) != "response" {
+		t.Errorf("expected response %q, got %q", "response", res)
+	}
+
+	if _, err := c.CoprocessorService(context.Background(), []byte("test"),
+		[]byte("theKey"), "", "GetRowNum", nil); err == nil {
+		t.Error("expected an error without a service")
+	}
+}
+
+// threeRegionsResolver splits every table into regions at "b" and "c".
+type threeRegionsResolver struct{}
+
+func (threeRegionsResolver) ResolveRegion(ctx context.Context,
+	table, key []byte) (hrpc.RegionInfo, string, error) {
+	var start, stop []byte
+	switch {
+	case bytes.Compare(key, []byte("b")) < 0:
+		stop = []byte("b")
+	case bytes.Compare(key, []byte("c")) < 0:
+		start, stop = []byte("b"), []byte("c")
+	default:
+		start = []byte("c")
+	}
+	name := []byte(fmt.Sprintf("%s,%s,1234567890042", table, start))
+	return region.NewInfo(0, nil, table, name, start, stop), "regionserver:1", nil
+}
+
+func TestCoprocessorServiceAll(t *testing.T) {
+	ctrl := test.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		m                  sync.Mutex
+		invoked            []string
+		inFlight, maxInFly int
+	)
+	newRegionClient := func(addr string) hrpc.RegionClient {
+		rc := mockRegion.NewMockRegionClient(ctrl)
+		rc.EXPECT().Dial(gomock.Any()).Return(nil).AnyTimes()
+		rc.EXPECT().Addr().Return(addr).AnyTimes()
+		rc.EXPECT().String().Return(addr).AnyTimes()
+		rc.EXPECT().Close().AnyTimes()
+		rc.EXPECT().QueueRPC(gomock.Any()).AnyTimes().Do(func(rpc hrpc.Call) {
+			cs, ok := rpc.(*hrpc.CoprocessorService)
+			if !ok {
+				// probe of the region
+				rpc.ResultChan() <- hrpc.RPCResult{Msg: &pb.GetResponse{}}
+				return
+			}
+			m.Lock()
+			invoked = append(invoked, string(cs.Region().StartKey()))
+			if inFlight++; inFlight > maxInFly {
+				maxInFly = inFlight
+			}
+			m.Unlock()
+			go func() {
+				time.Sleep(10 * time.Millisecond)
+				m.Lock()
+				inFlight--
+				m.Unlock()
+				if string(cs.Key()) == "b" {
+					rpc.ResultChan() <- hrpc.RPCResult{Error: errors.New("endpoint failed")}
+					return
+				}
+				rpc.ResultChan() <- hrpc.RPCResult{Msg: &pb.CoprocessorServiceResponse{
+					Value: &pb.NameBytesPair{
+						Name:  proto.String("response"),
+						Value: append([]byte("count of "), cs.Key()...),
+					},
+				}}
+			}()
+		})
+		return rc
+	}
+	c := NewClientWithResolver(threeRegionsResolver{}, newRegionClient,
+		CoprocessorConcurrency(2)).(*client)
+	defer c.Close()
+
+	res, err := c.CoprocessorServiceAll(context.Background(), []byte("test"),
+		"hbase.pb.AggregateService", "GetRowNum", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sort.Strings(invoked)
+	if expected := []string{"", "b", "c"}; !reflect.DeepEqual(expected, invoked) {
+		t.Errorf("expected the endpoints of regions %q to be invoked, got %q",
+			expected, invoked)
+	}
+	if maxInFly > 2 {
+		t.Errorf("expected at most 2 endpoints invoked at once, got %d", maxInFly)
+	}
+	if len(res) != 3 {
+		t.Fatalf("expected 3 results, got %v", res)
+	}
+	for name, r := range res {
+		switch name {
+		case "test,,1234567890042", "test,c,1234567890042":
+			start := strings.Split(name, ",")[1]
+			if r.Err != nil || string(r.Value) != "count of "+start {
+				t.Errorf("unexpected result of region %s: %q, %v", name, r.Value, r.Err)
+			}
+		case "test,b,1234567890042":
+			if r.Err == nil {
+				t.Errorf("expected an error for region %s, got %q", name, r.Value)
+			}
+		default:
+			t.Errorf("unexpected region %s", name)
+		}
+	}
+}
+
+func TestEstimateRange(t *testing.T) {
+	ctrl := test.NewController(t)
+	defer ctrl.Finish()
+
+	// the dataset, sorted by row
+	dataset := []struct {
+		row, qualifier string
+		valueLen       int
+	}{
+		{"a1", "q", 10}, {"a2", "q", 100}, {"a2", "r", 1}, {"b1", "q", 1000},
+		{"c1", "q", 0}, {"c2", "q", 50}, {"d", "q", 5},
+	}
+	newRegionClient := func(addr string) hrpc.RegionClient {
+		rc := mockRegion.NewMockRegionClient(ctrl)
+		rc.EXPECT().Dial(gomock.Any()).Return(nil).AnyTimes()
+		rc.EXPECT().Addr().Return(addr).AnyTimes()
+		rc.EXPECT().String().Return(addr).AnyTimes()
+		rc.EXPECT().Close().AnyTimes()
+		rc.EXPECT().QueueRPC(gomock.Any()).AnyTimes().Do(func(rpc hrpc.Call) {
+			scan, ok := rpc.(*hrpc.Scan)
+			if !ok {
+				// probe of the region
+				rpc.ResultChan() <- hrpc.RPCResult{Msg: &pb.GetResponse{}}
+				return
+			}
+			req := scan.ToProto().(*pb.ScanRequest)
+			if name := req.GetScan().GetFilter().GetName(); !strings.HasSuffix(name,
+				"KeyOnlyFilter") {
+				t.Errorf("expected a KeyOnlyFilter, got %q", name)
+			}
+			// serve the rows of the scan in the region, with the lengths of
+			// their values as values
+			reg := scan.Region()
+			var results []*pb.Result
+			for _, d := range dataset {
+				row := []byte(d.row)
+				if bytes.Compare(row, scan.StartRow()) < 0 ||
+					bytes.Compare(row, reg.StartKey()) < 0 ||
+					len(scan.StopRow()) != 0 && bytes.Compare(row, scan.StopRow()) >= 0 ||
+					len(reg.StopKey()) != 0 && bytes.Compare(row, reg.StopKey()) >= 0 {
+					continue
+				}
+				value := make([]byte, 4)
+				binary.BigEndian.PutUint32(value, uint32(d.valueLen))
+				cell := &pb.Cell{Row: row, Family: []byte("cf"),
+					Qualifier: []byte(d.qualifier), Value: value}
+				if n := len(results); n > 0 && bytes.Equal(results[n-1].Cell[0].Row, row) {
+					results[n-1].Cell = append(results[n-1].Cell, cell)
+				} else {
+					results = append(results, &pb.Result{Cell: []*pb.Cell{cell}})
+				}
+			}
+			rpc.ResultChan() <- hrpc.RPCResult{Msg: &pb.ScanResponse{
+				Results:             results,
+				MoreResultsInRegion: proto.Bool(false),
+			}}
+		})
+		return rc
+	}
+	c := NewClientWithResolver(threeRegionsResolver{}, newRegionClient).(*client)
+	defer c.Close()
+
+	est, err := c.EstimateRange(context.Background(), []byte("test"),
+		[]byte("a2"), []byte("c2"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// rows a2, b1 and c1 across the three regions
+	var size uint64
+	for _, d := range dataset[1:5] {
+		size += uint64(4 + 4 + 2 + len(d.row) + 1 + len("cf") + len(d.qualifier) +
+			8 + 1 + d.valueLen)
+	}
+	expected := hrpc.RangeEstimate{Rows: 3, Cells: 4, Bytes: size}
+	if est != expected {
+		t.Errorf("expected estimate %+v, got %+v", expected, est)
+	}
+}
+
+func TestGetSortedCells(t *testing.T) {
+	ctrl := test.NewController(t)
+	defer ctrl.Finish()
+
+	c := newMockClient(nil)
+	reg := region.NewInfo(0, nil, []byte("test"),
+		[]byte("test,,1234567890042.56f833d5569a27c7a43fbf547b4924a4."), nil, nil)
+	rc := mockRegion.NewMockRegionClient(ctrl)
+	rc.EXPECT().String().Return("mock region client").AnyTimes()
+	rc.EXPECT().Addr().Return("regionserver:1").AnyTimes()
+	c.regions.put(reg)
+	c.clients.put("regionserver:1", reg, func() hrpc.RegionClient { return rc })
+	reg.SetClient(rc)
+
+	// the server returns the cells out of order
+	qualifiers := []string{"c", "a", "b"}
+	rc.EXPECT().QueueRPC(gomock.Any()).Times(2).Do(func(rpc hrpc.Call) {
+		var cells []*pb.Cell
+		for _, q := range qualifiers {
+			cells = append(cells, &pb.Cell{Row: []byte("theKey"),
+				Family: []byte("cf"), Qualifier: []byte(q)})
+		}
+		rpc.ResultChan() <- hrpc.RPCResult{Msg: &pb.GetResponse{
+			Result: &pb.Result{Cell: cells}}}
+	})
+
+	for _, tcase := range []struct {
+		options  []func(hrpc.Call) error
+		expected []string
+	}{
+		{expected: qualifiers},
+		{options: []func(hrpc.Call) error{hrpc.SortedCells()},
+			expected: []string{"a", "b", "c"}},
+	} {
+		get, err := hrpc.NewGetStr(context.Background(), "test", "theKey",
+			tcase.options...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		res, err := c.Get(get)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var got []string
+		for _, cell := range res.Cells {
+			got = append(got, string(cell.Qualifier))
+		}
+		if !reflect.DeepEqual(tcase.expected, got) {
+			t.Errorf("expected qualifiers %q, got %q", tcase.expected, got)
+		}
+	}
+}
+
+func TestResetRegionBackoff(t *testing.T) {
+	srv := &flakyServer{down: true}
+	c := NewClientWithResolver(singleRegionResolver{},
+		func(addr string) hrpc.RegionClient {
+			return &flakyRegionClient{addr: addr, srv: srv}
+		}).(*client)
+	defer c.Close()
+
+	done := make(chan error, 1)
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+		g, err := hrpc.NewGetStr(ctx, "test", "row")
+		if err != nil {
+			done <- err
+			return
+		}
+		_, err = c.Get(g)
+		done <- err
+	}()
+
+	// the backoff of the region grows while the regionserver is down
+	name := "test,,1434573235908.56f833d5569a27c7a43fbf547b4924a4."
+	deadline := time.Now().Add(5 * time.Second)
+	for c.RegionBackoffs()[name] < 512*time.Millisecond {
+		if time.Now().After(deadline) {
+			t.Fatalf("expected the backoff to grow, got %v", c.RegionBackoffs())
+		}
+		time.Sleep(time.Millisecond)
+	}
+	if n := c.ResetRegionBackoff([]byte("test,,1.unknown.")); n != 0 {
+		t.Errorf("expected no region to be reset, got %d", n)
+	}
+
+	// once reset, reconnects start over from the initial backoff
+	dials := srv.dialCount()
+	if n := c.ResetRegionBackoff([]byte(name)); n != 1 {
+		t.Fatalf("expected 1 region to be reset, got %d", n)
+	}
+	time.Sleep(100 * time.Millisecond)
+	if n := srv.dialCount() - dials; n < 3 {
+		t.Errorf("expected at least 3 dials after resetting the backoff, got %d", n)
+	}
+
+	// and the region is established right away once the regionserver is back
+	deadline = time.Now().Add(5 * time.Second)
+	for c.RegionBackoffs()[name] < 512*time.Millisecond {
+		if time.Now().After(deadline) {
+			t.Fatalf("expected the backoff to grow, got %v", c.RegionBackoffs())
+		}
+		time.Sleep(time.Millisecond)
+	}
+	srv.setDown(false)
+	start := time.Now()
+	if n := c.ResetRegionBackoff(nil); n != 1 {
+		t.Fatalf("expected 1 region to be reset, got %d", n)
+	}
+	if err := <-done; err != nil {
+		t.Fatal(err)
+	}
+	if d := time.Since(start); d > 250*time.Millisecond {
+		t.Errorf("expected the region to be established right away, took %v", d)
+	}
+	if backoffs := c.RegionBackoffs(); len(backoffs) != 0 {
+		t.Errorf("expected no backoffs, got %v", backoffs)
+	}
+}
+
+func TestServerVersion(t *testing.T) {
+	c := newMockClient(nil)
+	ServerVersion(hrpc.Version{Major: 0, Minor: 96, Patch: 2})(c)
+
+	get, err := hrpc.NewGetStr(context.Background(), "test", "row", hrpc.ReplicaID(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.Get(get); !errors.Is(err, ErrUnsupportedFeature) {
+		t.Errorf("expected an error matching %v, got %v", ErrUnsupportedFeature, err)
+	}
+	res, ok := c.SendBatch(context.Background(), []hrpc.Call{get})
+	if ok || !errors.Is(res[0].Error, ErrUnsupportedFeature) {
+		t.Errorf("expected an error matching %v, got %v", ErrUnsupportedFeature, res[0].Error)
+	}
+
+	// nonces are silently disabled
+	inc, err := hrpc.NewIncStrSingle(context.Background(), "test", "row", "cf", "q", 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := c.checkFeatures(inc); err != nil {
+		t.Fatal(err)
+	}
+	if n := inc.Nonce(); n != 0 {
+		t.Errorf("expected no nonce, got %d", n)
+	}
+
+	// every feature is supported by later versions
+	ServerVersion(hrpc.Version{Major: 2, Minor: 4})(c)
+	inc, err = hrpc.NewIncStrSingle(context.Background(), "test", "row", "cf", "q", 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, rpc := range []hrpc.Call{get, inc} {
+		if err := c.checkFeatures(rpc); err != nil {
+			t.Errorf("expected %s to be supported, got %v", rpc.Name(), err)
+		}
+	}
+	if inc.Nonce() == 0 {
+		t.Error("expected a nonce")
+	}
+}
+
+func TestObserveDials(t *testing.T) {
+	type dial struct {
+		addr string
+		err  error
+	}
+	var (
+		m     sync.Mutex
+		dials []dial
+	)
+	srv := &flakyServer{down: true}
+	c := NewClientWithResolver(singleRegionResolver{},
+		func(addr string) hrpc.RegionClient { return &flakyRegionClient{addr: addr, srv: srv} },
+		ObserveDials(func(addr string, duration time.Duration, err error) {
+			if duration < 0 {
+				t.Errorf("unexpected duration %v", duration)
+			}
+			m.Lock()
+			defer m.Unlock()
+			dials = append(dials, dial{addr: addr, err: err})
+			if len(dials) == 2 {
+				// the RegionServer comes back after two failed dials
+				srv.setDown(false)
+			}
+		}))
+	defer c.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	get, err := hrpc.NewGetStr(ctx, "test", "row")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.Get(get); err != nil {
+		t.Fatal(err)
+	}
+
+	m.Lock()
+	defer m.Unlock()
+	if len(dials) != 3 {
+		t.Fatalf("expected 3 dials to be observed, got %v", dials)
+	}
+	for i, d := range dials {
+		if d.addr != "regionserver:1" {
+			t.Errorf("expected dial %d to regionserver:1, got %s", i, d.addr)
+		}
+		if failed := i < 2; failed != (d.err != nil) {
+			t.Errorf("expected dial %d to fail: %v, got error %v", i, failed, d.err)
+		}
+	}
+}
+
+func TestSetBalancer(t *testing.T) {
+	ctrl := test.NewController(t)
+	defer ctrl.Finish()
+	c := newMockClient(nil)
+	c.clientType = region.MasterClient
+	c.adminRegionInfo = region.NewInfo(0, nil, nil, nil, nil, nil)
+
+	// the master answers with the state the balancer had before the request
+	running := true
+	rc := mockRegion.NewMockRegionClient(ctrl)
+	rc.EXPECT().QueueRPC(gomock.Any()).Times(2).Do(func(rpc hrpc.Call) {
+		req, ok := rpc.ToProto().(*pb.SetBalancerRunningRequest)
+		if !ok {
+			t.Errorf("unexpected request %v", rpc.ToProto())
+		}
+		prev := running
+		running = req.GetOn()
+		rpc.ResultChan() <- hrpc.RPCResult{
+			Msg: &pb.SetBalancerRunningResponse{PrevBalanceValue: proto.Bool(prev)}}
+	})
+	c.adminRegionInfo.SetClient(rc)
+
+	for _, on := range []bool{false, true} {
+		sb, err := hrpc.NewSetBalancer(context.Background(), on)
+		if err != nil {
+			t.Fatal(err)
+		}
+		prev, err := c.SetBalancer(sb)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if prev == on {
+			t.Errorf("expected the balancer to have been running: %v, got %v", !on, prev)
+		}
+		if running != on {
+			t.Errorf("expected the balancer to be running: %v, got %v", on, running)
+		}
+	}
+}
+
+// slowRegionClient answers the Gets of "row" after delay, except for the
+// attempts after the first slow ones, and every other RPC right away.
+type slowRegionClient struct {
+	addr  string
+	delay time.Duration
+	slow  int32
+
+	attempts int32
+}
+
+func (c *slowRegionClient) Dial(context.Context) error { return nil }
+func (c *slowRegionClient) Close()                     {}
+func (c *slowRegionClient) Addr() string               { return c.addr }
+func (c *slowRegionClient) String() string             { return "slowRegionClient" }
+
+func (c *slowRegionClient) QueueRPC(call hrpc.Call) {
+	res := hrpc.RPCResult{Msg: &pb.GetResponse{Result: &pb.Result{}}}
+	if string(call.Key()) != "row" {
+		call.ResultChan() <- res
+		return
+	}
+	if atomic.AddInt32(&c.attempts, 1) > c.slow {
+		call.ResultChan() <- res
+		return
+	}
+	go func() {
+		time.Sleep(c.delay)
+		call.ResultChan() <- res
+	}()
+}
+
+func (c *slowRegionClient) QueueBatch(ctx context.Context, calls []hrpc.Call) {
+	for _, call := range calls {
+		c.QueueRPC(call)
+	}
+}
+
+func TestAttemptTimeout(t *testing.T) {
+	for name, tc := range map[string]struct {
+		slow    int32
+		budget  time.Duration
+		timeout time.Duration
+		err     error
+	}{
+		"retried": {
+			slow:    2,
+			budget:  5 * time.Second,
+			timeout: 20 * time.Millisecond,
+		},
+		"budgetOver": {
+			slow:    math.MaxInt32,
+			budget:  100 * time.Millisecond,
+			timeout: 20 * time.Millisecond,
+			err:     context.DeadlineExceeded,
+		},
+		"unlimited": {
+			slow:   1,
+			budget: 5 * time.Second,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			rc := &slowRegionClient{addr: "regionserver:1", delay: 200 * time.Millisecond,
+				slow: tc.slow}
+			c := NewClientWithResolver(singleRegionResolver{},
+				func(addr string) hrpc.RegionClient { return rc },
+				AttemptTimeout(tc.timeout))
+			defer c.Close()
+
+			ctx, cancel := context.WithTimeout(context.Background(), tc.budget)
+			defer cancel()
+			get, err := hrpc.NewGetStr(ctx, "test", "row")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if _, err := c.Get(get); err != tc.err {
+				t.Fatalf("expected error %v, got %v", tc.err, err)
+			}
+
+			attempts := atomic.LoadInt32(&rc.attempts)
+			switch {
+			case tc.timeout == 0:
+				if attempts != 1 {
+					t.Errorf("expected the slow attempt to be waited for, got %d attempts",
+						attempts)
+				}
+			case tc.err == nil:
+				if attempts != tc.slow+1 {
+					t.Errorf("expected %d attempts, got %d", tc.slow+1, attempts)
+				}
+			default:
+				if attempts < 2 {
+					t.Errorf("expected multiple attempts within the budget, got %d",
+						attempts)
+				}
+			}
+		})
+	}
+}
+
+// gate holds the responses read from connections until it's opened.
+type gate struct {
+	m      sync.Mutex
+	opened chan struct{}
+}
+
+func newGate() *gate {
+	g := &gate{opened: make(chan struct{})}
+	close(g.opened)
+	return g
+}
+
+func (g *gate) close() {
+	g.m.Lock()
+	g.opened = make(chan struct{})
+	g.m.Unlock()
+}
+
+func (g *gate) open() {
+	g.m.Lock()
+	close(g.opened)
+	g.m.Unlock()
+}
+
+func (g *gate) wait() {
+	g.m.Lock()
+	opened := g.opened
+	g.m.Unlock()
+	<-opened
+}
+
+// gatedConn is a connection whose reads return once its gate is open.
+type gatedConn struct {
+	net.Conn
+	gate *gate
+}
+
+func (c gatedConn) Read(b []byte) (int, error) {
+	n, err := c.Conn.Read(b)
+	c.gate.wait()
+	return n, err
+}
+
+func TestAttemptTimeoutLateResponses(t *testing.T) {
+	srv := testserver.New()
+	defer srv.Close()
+	srv.CreateTable("test")
+	g := newGate()
+	c := NewClient("", ZookeeperClient(srv), AttemptTimeout(10*time.Millisecond),
+		Dialer(func(ctx context.Context, network, addr string) (net.Conn, error) {
+			conn, err := srv.Dial(ctx, network, addr)
+			if err != nil {
+				return nil, err
+			}
+			return gatedConn{Conn: conn, gate: g}, nil
+		}))
+	defer c.Close()
+
+	put, err := hrpc.NewPutStr(context.Background(), "test", "row",
+		map[string]map[string][]byte{"cf": {"a": []byte("1")}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.Put(put); err != nil {
+		t.Fatal(err)
+	}
+
+	// the server is slow to answer: every attempt times out and
+	// their responses arrive once the Get has been given up on
+	g.close()
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	get, err := hrpc.NewGetStr(ctx, "test", "row")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.Get(get); err != context.DeadlineExceeded {
+		t.Fatalf("expected %v, got %v", context.DeadlineExceeded, err)
+	}
+	g.open()
+
+	// the late responses don't stall the connection
+	for i := 0; i < 5; i++ {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		get, err := hrpc.NewGetStr(ctx, "test", "row", hrpc.SkipBatch())
+		if err != nil {
+			t.Fatal(err)
+		}
+		res, err := c.Get(get)
+		if err != nil {
+			t.Fatalf("expected Get %d to succeed, got %v", i, err)
+		}
+		if len(res.Cells) != 1 || string(res.Cells[0].Value) != "1" {
+			t.Errorf("expected the value of the row, got %v", res.Cells)
+		}
+	}
+}
+
+func TestRegionClientFor(t *testing.T) {
+	srv := &flakyServer{}
+	c := newMockClient(nil)
+	c.newRegionClientFn = func(addr string, _ region.ClientType, _ int, _ time.Duration,
+		_ string, _ time.Duration, _ compression.Codec,
+		_ ...region.ClientOption) hrpc.RegionClient {
+		return &flakyRegionClient{addr: addr, srv: srv}
+	}
+	reg := region.NewInfo(0, nil, []byte("test"),
+		[]byte("test,,1434573235908.56f833d5569a27c7a43fbf547b4924a4."), nil, nil)
+	cached := c.clients.put("regionserver:1", reg, func() hrpc.RegionClient {
+		return &flakyRegionClient{addr: "regionserver:1", srv: srv}
+	})
+	ctx := context.Background()
+
+	// the client of a server in cache is returned
+	rc, err := c.RegionClientFor(ctx, "regionserver", 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rc != cached {
+		t.Errorf("expected the cached client %v, got %v", cached, rc)
+	}
+
+	// a client is connected and put in cache for other servers
+	rc, err = c.RegionClientFor(ctx, "regionserver", 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rc.Addr() != "regionserver:2" {
+		t.Errorf("expected a client of regionserver:2, got %v", rc)
+	}
+	c.clients.m.RLock()
+	_, ok := c.clients.regions[rc]
+	c.clients.m.RUnlock()
+	if !ok {
+		t.Errorf("expected %v to be in cache", rc)
+	}
+	if again, err := c.RegionClientFor(ctx, "regionserver", 2); err != nil || again != rc {
+		t.Errorf("expected the same client %v, got %v, %v", rc, again, err)
+	}
+
+	// a client that can't connect isn't kept in cache
+	srv.setDown(true)
+	if rc, err := c.RegionClientFor(ctx, "regionserver", 3); err == nil {
+		t.Fatalf("expected an error, got %v", rc)
+	}
+	c.clients.m.RLock()
+	defer c.clients.m.RUnlock()
+	if n := len(c.clients.regions); n != 2 {
+		t.Errorf("expected 2 clients in cache, got %d", n)
+	}
+}
@@ -0,0 +1,81 @@
+// Copyright (C) 2026  The GoHBase Authors.  All rights reserved.
+// This file is part of GoHBase.
+// Use of this source code is governed by the Apache License 2.0
+// that can be found in the COPYING file.
+
+package gohbase
+
+import (
+	"context"
+	"sync"
+)
+
+// scanMemory caps the number of bytes reserved by the batches of results
+// fetched by scanners, see ScanMemoryLimit option. A nil scanMemory is unlimited.
+type scanMemory struct {
+	limit int64
+
+	m    sync.Mutex
+	used int64
+	// wait is closed and replaced whenever bytes are released
+	wait chan struct{}
+}
+
+func newScanMemory(limit int64) *scanMemory {
+	return &scanMemory{limit: limit, wait: make(chan struct{})}
+}
+
+// reservation returns the number of bytes reserved for a batch of at most
+// maxResultSize bytes, which is capped by the limit so that it can be acquired.
+func (sm *scanMemory) reservation(maxResultSize uint64) int64 {
+	if maxResultSize > uint64(sm.limit) {
+		return sm.limit
+	}
+	return int64(maxResultSize)
+}
+
+// acquire reserves n bytes, waiting for other scanners to release
+// theirs until ctx is done if the limit would be exceeded.
+func (sm *scanMemory) acquire(ctx context.Context, n int64) error {
+	if sm == nil {
+		return nil
+	}
+	for {
+		sm.m.Lock()
+		if sm.used+n <= sm.limit {
+			sm.used += n
+			sm.m.Unlock()
+			return nil
+		}
+		wait := sm.wait
+		sm.m.Unlock()
+
+		select {
+		case <-wait:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// release returns n bytes reserved with acquire.
+func (sm *scanMemory) release(n int64) {
+	if sm == nil || n == 0 {
+		return
+	}
+	sm.m.Lock()
+	sm.used -= n
+	close(sm.wait)
+	sm.wait = make(chan struct{})
+	sm.m.Unlock()
+}
+
+// inUse returns the number of bytes currently reserved.
+func (sm *scanMemory) inUse() int64 {
+	if sm == nil {
+		return 0
+	}
+	sm.m.Lock()
+	defer sm.m.Unlock()
+	return sm.used
+}
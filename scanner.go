@@ -33,21 +33,66 @@ type scanner struct {
 	startRow []byte
 	results  []*pb.Result
 	closed   bool
+	// buffers holds functions returning pooled buffers backing the
+	// results of fetched batches, see hrpc.ZeroCopy
+	buffers []func()
+	// regionName limits the scan to the region of that name, see ScanRegion
+	regionName []byte
+	// memory caps the bytes of the batches held by all scanners of the
+	// client and reserved is what's reserved for the current batch,
+	// see ScanMemoryLimit option
+	memory   *scanMemory
+	reserved int64
+
+	// region is the region of the current batch of results
+	region hrpc.RegionInfo
+	// tableRegions looks up the regions of a table to estimate the
+	// progress of the scan, see Position
+	tableRegions func(ctx context.Context, table []byte) ([]hrpc.RegionInfo, error)
+	// regions are the regions in the range of the scan once looked up
+	regions []hrpc.RegionInfo
 }
 
 func (s *scanner) fetch() ([]*pb.Result, error) {
+	// the previous batch has been consumed, reserve memory for the next one
+	s.releaseMemory()
+	if s.memory != nil {
+		n := s.memory.reservation(s.rpc.MaxResultSize())
+		if err := s.memory.acquire(s.rpc.Context(), n); err != nil {
+			s.close()
+			return nil, err
+		}
+		s.reserved = n
+	}
 	// keep looping until we have error, some non-empty result or until close
 	for {
+		// a new region is scanned from startRow unless the scanner is open
+		start, opening := s.startRow, s.isRegionScannerClosed()
 		resp, region, err := s.request()
 		if err != nil {
-			s.Close()
+			s.close()
 			return nil, err
 		}
 
 		s.update(resp, region)
+		s.region = region
+
+		if opening && s.regionName == nil {
+			if err := s.checkRegionGap(start, region); err != nil {
+				s.close()
+				return nil, err
+			}
+		}
+
+		if s.regionName != nil && !bytes.Equal(region.Name(), s.regionName) {
+			s.close()
+			return nil, fmt.Errorf("%w: %q is served by %q",
+				ErrRegionChanged, s.regionName, region.Name())
+		}
 
 		if s.isDone(resp, region) {
-			s.Close()
+			// the memory is held until the results are consumed
+			s.closeScanner()
 		}
 
 		if rs := resp.Results; len(rs) > 0 {
@@ -58,10 +103,30 @@ func (s *scanner) fetch() ([]*pb.Result, error) {
 	}
 }
 
+// checkRegionGap returns ErrRegionGap if region, which a scan from start was
+// sent to, doesn't contain start, so that the rows in between would be skipped.
+func (s *scanner) checkRegionGap(start []byte, region hrpc.RegionInfo) error {
+	if !s.rpc.Reversed() {
+		if bytes.Compare(region.StartKey(), start) > 0 {
+			return fmt.Errorf("%w: rows from %q to %q aren't in any region, next region is %s",
+				ErrRegionGap, start, region.StartKey(), region)
+		}
+		return nil
+	}
+	// reversed scans start from the end of the table if start is empty
+	if stop := region.StopKey(); len(stop) != 0 &&
+		(len(start) == 0 || bytes.Compare(stop, start) <= 0) {
+		return fmt.Errorf("%w: rows from %q to %q aren't in any region, next region is %s",
+			ErrRegionGap, stop, start, region)
+	}
+	return nil
+}
+
 func (s *scanner) peek() (*pb.Result, error) {
 	if len(s.results) == 0 {
 		if s.closed {
 			// done scanning
+			s.releaseMemory()
 			return nil, io.EOF
 		}
 
@@ -111,12 +176,56 @@ func (s *scanner) coalesce(result, partial *pb.Result) (*pb.Result, bool) {
 }
 
 func newScanner(c RPCClient, rpc *hrpc.Scan) *scanner {
-	return &scanner{
+	s := &scanner{
 		RPCClient:          c,
 		rpc:                rpc,
 		startRow:           rpc.StartRow(),
 		curRegionScannerID: noScannerID,
 	}
+	if !rpc.Reversed() {
+		// start from the first row range, or don't scan at all
+		// if the scan starts after all of them
+		var ok bool
+		if s.startRow, ok = s.nextRowToScan(s.startRow); !ok {
+			s.startRow = rpc.StartRow()
+			s.closed = true
+		}
+	}
+	return s
+}
+
+// nextRowToScan returns the first row from row on that can be in one of the
+// row ranges of a normal scan, and false if there's none before its stop row.
+// Without row ranges, it's row itself.
+func (s *scanner) nextRowToScan(row []byte) ([]byte, bool) {
+	ranges := s.rpc.RowRanges()
+	next, ok := nextRowInRanges(ranges, row)
+	if !ok || len(ranges) != 0 && len(s.rpc.StopRow()) != 0 &&
+		bytes.Compare(next, s.rpc.StopRow()) >= 0 {
+		return nil, false
+	}
+	return next, true
+}
+
+// nextRowInRanges returns the first row from row on that can be in one of
+// ranges sorted by start row, and false if there's none. Row ranges are
+// considered inclusive of their start and stop rows. If there are no ranges,
+// row is returned as is.
+func nextRowInRanges(ranges []*pb.RowRange, row []byte) ([]byte, bool) {
+	if len(ranges) == 0 {
+		return row, true
+	}
+	for _, r := range ranges {
+		if len(r.StopRow) != 0 && bytes.Compare(r.StopRow, row) < 0 {
+			// the range is before row
+			continue
+		}
+		if bytes.Compare(r.StartRow, row) > 0 {
+			return r.StartRow, true
+		}
+		return row, true
+	}
+	return nil, false
 }
 
 func toLocalResult(r *pb.Result) *hrpc.Result {
@@ -139,6 +248,9 @@ func (s *scanner) Next() (*hrpc.Result, error) {
 	default:
 	}
 
+	// results returned by the previous call to Next aren't used anymore
+	s.releaseBuffers()
+
 	if s.rpc.AllowPartialResults() {
 		// if client handles partials, just return it
 		result, err := s.peek()
@@ -189,12 +301,18 @@ func (s *scanner) request() (*pb.ScanResponse, hrpc.RegionInfo, error) {
 			s.rpc.Options()...)
 	} else {
 		// continuing to scan current region
+		opts := []func(hrpc.Call) error{
+			hrpc.ScannerID(s.curRegionScannerID),
+			hrpc.NumberOfRows(s.rpc.NumberOfRows()),
+		}
+		if s.rpc.ZeroCopy() {
+			opts = append(opts, hrpc.ZeroCopy())
+		}
 		rpc, err = hrpc.NewScanRange(s.rpc.Context(),
 			s.rpc.Table(),
 			s.startRow,
 			nil,
-			hrpc.ScannerID(s.curRegionScannerID),
-			hrpc.NumberOfRows(s.rpc.NumberOfRows()))
+			opts...)
 	}
 	if err != nil {
 		return nil, nil, err
@@ -204,6 +322,9 @@ func (s *scanner) request() (*pb.ScanResponse, hrpc.RegionInfo, error) {
 	if err != nil {
 		return nil, nil, err
 	}
+	if rpc.ZeroCopy() {
+		s.buffers = append(s.buffers, rpc.ReleaseBuffer)
+	}
 	scanres, ok := res.(*pb.ScanResponse)
 	if !ok {
 		return nil, nil, errors.New("got non-ScanResponse for scan request")
@@ -211,9 +332,33 @@ func (s *scanner) request() (*pb.ScanResponse, hrpc.RegionInfo, error) {
 	return scanres, rpc.Region(), nil
 }
 
+// releaseBuffers returns the pooled buffers of all fetched batches
+// except the one results are still being returned from.
+func (s *scanner) releaseBuffers() {
+	n := len(s.buffers)
+	if n > 0 && len(s.results) > 0 {
+		// the last fetched batch is still being consumed
+		n--
+	}
+	for i := 0; i < n; i++ {
+		s.buffers[i]()
+		s.buffers[i] = nil
+	}
+	s.buffers = append(s.buffers[:0], s.buffers[n:]...)
+}
+
 // update updates the scanner for the next scan request
 func (s *scanner) update(resp *pb.ScanResponse, region hrpc.RegionInfo) {
-	if s.isRegionScannerClosed() && resp.ScannerId != nil {
+	if s.rpc.Small() {
+		// small scans are closed at server side after every request,
+		// so the next one starts right after the last returned row
+		if resp.GetMoreResultsInRegion() {
+			if rs := resp.Results; len(rs) > 0 && len(rs[len(rs)-1].Cell) > 0 {
+				s.startRow = s.nextStartRow(rs[len(rs)-1].Cell[0].Row)
+			}
+			return
+		}
+	} else if s.isRegionScannerClosed() && resp.ScannerId != nil {
 		s.openRegionScanner(resp.GetScannerId())
 	}
 	if !resp.GetMoreResultsInRegion() {
@@ -222,7 +367,11 @@ func (s *scanner) update(resp *pb.ScanResponse, region hrpc.RegionInfo) {
 
 		// Normal Scan
 		if !s.rpc.Reversed() {
+			// skip the regions in between row ranges, if any
 			s.startRow = region.StopKey()
+			if next, ok := nextRowInRanges(s.rpc.RowRanges(), s.startRow); ok {
+				s.startRow = next
+			}
 			return
 		}
 
@@ -233,35 +382,74 @@ func (s *scanner) update(resp *pb.ScanResponse, region hrpc.RegionInfo) {
 			return
 		}
 
-		// create the nearest value lower than the current region startKey
-		rsk := region.StartKey()
-		// if last element is 0x0, just shorten the slice
-		if rsk[len(rsk)-1] == 0x0 {
-			s.startRow = rsk[:len(rsk)-1]
-			return
-		}
+		s.startRow = s.nextStartRow(region.StartKey())
+	}
+}
 
-		// otherwise lower the last element byte value by 1 and pad with 0xffs
-		tmp := make([]byte, len(rsk), len(rsk)+len(rowPadding))
-		copy(tmp, rsk)
-		tmp[len(tmp)-1] = tmp[len(tmp)-1] - 1
-		s.startRow = append(tmp, rowPadding...)
+// nextStartRow returns the row to continue scanning from
+// right after the given non-empty row in the direction of the scan.
+func (s *scanner) nextStartRow(row []byte) []byte {
+	if !s.rpc.Reversed() {
+		// the nearest value greater than row
+		next := make([]byte, len(row)+1)
+		copy(next, row)
+		return next
+	}
+
+	// create the nearest value lower than row
+	// if last element is 0x0, just shorten the slice
+	if row[len(row)-1] == 0x0 {
+		return row[:len(row)-1]
 	}
+
+	// otherwise lower the last element byte value by 1 and pad with 0xffs
+	tmp := make([]byte, len(row), len(row)+len(rowPadding))
+	copy(tmp, row)
+	tmp[len(tmp)-1] = tmp[len(tmp)-1] - 1
+	return append(tmp, rowPadding...)
 }
 
 func (s *scanner) Close() error {
+	s.close()
+	// results returned by Next aren't used anymore
+	for _, release := range s.buffers {
+		release()
+	}
+	s.buffers = nil
+	return nil
+}
+
+// close closes the scanner without releasing the pooled buffers backing
+// results that are still returned to the caller, e.g. along with an error.
+func (s *scanner) close() {
+	s.releaseMemory()
+	s.closeScanner()
+}
+
+func (s *scanner) closeScanner() {
 	if s.closed {
-		return nil
+		return
 	}
 	s.closed = true
 	// close the last region scanner
 	s.closeRegionScanner()
-	return nil
+}
+
+// releaseMemory releases the memory reserved for the current batch, if any.
+func (s *scanner) releaseMemory() {
+	s.memory.release(s.reserved)
+	s.reserved = 0
 }
 
 // isDone check if this scanner is done fetching new results
 func (s *scanner) isDone(resp *pb.ScanResponse, region hrpc.RegionInfo) bool {
-	if resp.MoreResults != nil && !*resp.MoreResults {
+	if s.rpc.Small() {
+		// small scans are closed after every request,
+		// so MoreResults doesn't tell whether the whole scan is done
+		if resp.GetMoreResultsInRegion() {
+			return false
+		}
+	} else if resp.MoreResults != nil && !*resp.MoreResults {
 		// or the filter for the whole scan has been exhausted, close the scanner
 		return true
 	}
@@ -271,6 +459,11 @@ func (s *scanner) isDone(resp *pb.ScanResponse, region hrpc.RegionInfo) bool {
 		return false
 	}
 
+	if s.regionName != nil {
+		// the only region to scan is done
+		return true
+	}
+
 	// Check to see if this region is the last we should scan because:
 	// (1) it's the last region
 	if len(region.StopKey()) == 0 && !s.rpc.Reversed() {
@@ -279,6 +472,12 @@ func (s *scanner) isDone(resp *pb.ScanResponse, region hrpc.RegionInfo) bool {
 	if s.rpc.Reversed() && len(region.StartKey()) == 0 {
 		return true
 	}
+	// (4) because there are no row ranges to scan after it
+	if !s.rpc.Reversed() {
+		if _, ok := s.nextRowToScan(region.StopKey()); !ok {
+			return true
+		}
+	}
 	// (3) because its stop_key is greater than or equal to the stop_key of this scanner,
 	// provided that (2) we're not trying to scan until the end of the table.
 	if !s.rpc.Reversed() {
@@ -327,3 +526,90 @@ func (s *scanner) closeRegionScanner() {
 	}
 	s.curRegionScannerID = noScannerID
 }
+
+// Position returns the region of the current batch of results and the
+// fraction of the regions in the range of the scan done before it.
+func (s *scanner) Position() (hrpc.ScanPosition, error) {
+	pos := hrpc.ScanPosition{Region: s.region}
+	if s.closed && len(s.results) == 0 {
+		pos.Fraction = 1
+		return pos, nil
+	}
+	if s.region == nil || s.regionName != nil || s.tableRegions == nil {
+		// nothing done yet, or the only region to scan isn't done
+		return pos, nil
+	}
+	if s.regions == nil {
+		regions, err := s.tableRegions(s.rpc.Context(), s.rpc.Table())
+		if err != nil {
+			return pos, err
+		}
+		for _, r := range regions {
+			if s.inRange(r) {
+				s.regions = append(s.regions, r)
+			}
+		}
+	}
+	if len(s.regions) == 0 {
+		return pos, nil
+	}
+
+	var done int
+	for _, r := range s.regions {
+		if !s.rpc.Reversed() && len(r.StopKey()) != 0 &&
+			bytes.Compare(r.StopKey(), s.region.StartKey()) <= 0 ||
+			s.rpc.Reversed() && len(s.region.StopKey()) != 0 &&
+				bytes.Compare(r.StartKey(), s.region.StopKey()) >= 0 {
+			done++
+		}
+	}
+	pos.Fraction = float64(done) / float64(len(s.regions))
+	return pos, nil
+}
+
+// inRange returns whether region has rows in the range of the scan.
+func (s *scanner) inRange(region hrpc.RegionInfo) bool {
+	low, high := s.rpc.StartRow(), s.rpc.StopRow()
+	if s.rpc.Reversed() {
+		low, high = high, low
+	}
+	return (len(high) == 0 || bytes.Compare(region.StartKey(), high) < 0) &&
+		(len(region.StopKey()) == 0 || bytes.Compare(region.StopKey(), low) > 0)
+}
+
+// parseRegionName returns the table and the start key
+// of the region with the given name.
+func parseRegionName(name []byte) (table, startKey []byte, err error) {
+	// region names are of the form table,start_key,region_id[.encoded_name.]
+	// and the start key can contain commas
+	first, last := bytes.IndexByte(name, ','), bytes.LastIndexByte(name, ',')
+	if first <= 0 || first == last {
+		return nil, nil, fmt.Errorf("malformed region name %q", name)
+	}
+	if first+1 < last {
+		startKey = name[first+1 : last]
+	}
+	return name[:first], startKey, nil
+}
+
+// failedScanner is a scanner that failed to be created.
+type failedScanner struct {
+	err error
+}
+
+func (s *failedScanner) Next() (*hrpc.Result, error) {
+	if err := s.err; err != nil {
+		s.err = nil
+		return nil, err
+	}
+	return nil, io.EOF
+}
+
+func (s *failedScanner) Position() (hrpc.ScanPosition, error) {
+	return hrpc.ScanPosition{Fraction: 1}, nil
+}
+
+func (s *failedScanner) Close() error {
+	s.err = nil
+	return nil
+}
@@ -6,9 +6,11 @@
 package gohbase
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
+	"github.com/baiweiguo/gohbase/filter"
 	"github.com/baiweiguo/gohbase/hrpc"
 	"github.com/baiweiguo/gohbase/pb"
 	"github.com/baiweiguo/gohbase/region"
@@ -20,7 +22,9 @@ import (
 	"io"
 	"reflect"
 	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 )
 
 func cp(i uint64) *uint64 {
@@ -631,3 +635,885 @@ func TestScannerClosed(t *testing.T) {
 		t.Fatalf("unexpected error %v, expected %v", err, io.EOF)
 	}
 }
+
+func TestScannerZeroCopy(t *testing.T) {
+	ctrl := test.NewController(t)
+	defer ctrl.Finish()
+	c := mock.NewMockRPCClient(ctrl)
+
+	scan, err := hrpc.NewScan(context.Background(), table, hrpc.ZeroCopy())
+	if err != nil {
+		t.Fatal(err)
+	}
+	scanner := newScanner(c, scan)
+
+	reg := region.NewInfo(0, nil, table, []byte("table,,whatever"), nil, nil)
+	var (
+		wg       sync.WaitGroup
+		released [2]int
+		requests int
+	)
+	wg.Add(1)
+	defer wg.Wait()
+	c.EXPECT().SendRPC(gomock.Any()).DoAndReturn(func(rpc hrpc.Call) (proto.Message, error) {
+		s := rpc.(*hrpc.Scan)
+		if s.IsClosing() {
+			wg.Done()
+			return &pb.ScanResponse{}, nil
+		}
+		if !s.ZeroCopy() {
+			t.Error("expected scan request to be zero copy")
+		}
+		rpc.SetRegion(reg)
+		i := requests
+		requests++
+		s.SetBufferRelease(func() { released[i]++ })
+		return &pb.ScanResponse{
+			ScannerId:           cp(42),
+			MoreResultsInRegion: proto.Bool(i == 0),
+			Results:             dup(resultsPB[i : i+1]),
+		}, nil
+	}).Times(3)
+
+	for i := 0; i < 2; i++ {
+		if _, err := scanner.Next(); err != nil {
+			t.Fatal(err)
+		}
+		// results of the current batch are still in use
+		if released[i] != 0 {
+			t.Errorf("buffer of batch %d was released while in use", i)
+		}
+		if i > 0 && released[i-1] != 1 {
+			t.Errorf("expected buffer of batch %d to be released once, got %d",
+				i-1, released[i-1])
+		}
+	}
+	if _, err := scanner.Next(); err != io.EOF {
+		t.Fatalf("expected EOF, got %v", err)
+	}
+	if released != [2]int{1, 1} {
+		t.Errorf("expected all buffers to be released once, got %v", released)
+	}
+}
+
+func TestScannerZeroCopyClose(t *testing.T) {
+	ctrl := test.NewController(t)
+	defer ctrl.Finish()
+	c := mock.NewMockRPCClient(ctrl)
+
+	scan, err := hrpc.NewScan(context.Background(), table, hrpc.ZeroCopy())
+	if err != nil {
+		t.Fatal(err)
+	}
+	scanner := newScanner(c, scan)
+
+	reg := region.NewInfo(0, nil, table, []byte("table,,whatever"), nil, nil)
+	var (
+		wg       sync.WaitGroup
+		released int
+	)
+	wg.Add(1)
+	defer wg.Wait()
+	c.EXPECT().SendRPC(gomock.Any()).DoAndReturn(func(rpc hrpc.Call) (proto.Message, error) {
+		s := rpc.(*hrpc.Scan)
+		if s.IsClosing() {
+			wg.Done()
+			return &pb.ScanResponse{}, nil
+		}
+		rpc.SetRegion(reg)
+		s.SetBufferRelease(func() { released++ })
+		return &pb.ScanResponse{
+			ScannerId:           cp(42),
+			MoreResultsInRegion: proto.Bool(true),
+			Results:             dup(resultsPB[:2]),
+		}, nil
+	}).Times(2)
+
+	if _, err := scanner.Next(); err != nil {
+		t.Fatal(err)
+	}
+	if released != 0 {
+		t.Fatal("buffer of the batch was released while in use")
+	}
+	// close before consuming the rest of the batch
+	if err := scanner.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if released != 1 {
+		t.Errorf("expected buffer to be released once on Close, got %d", released)
+	}
+	if err := scanner.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if released != 1 {
+		t.Errorf("expected buffer to be released once, got %d", released)
+	}
+}
+
+func TestScannerSmall(t *testing.T) {
+	ctrl := test.NewController(t)
+	defer ctrl.Finish()
+	c := mock.NewMockRPCClient(ctrl)
+
+	scan, err := hrpc.NewScanRange(context.Background(), table,
+		[]byte("a"), []byte("b"), hrpc.Small(true))
+	if err != nil {
+		t.Fatal(err)
+	}
+	scanner := newScanner(c, scan)
+
+	// the whole range is read in a single request closing the scanner,
+	// so neither requests to fetch more results nor to close it are made
+	s, err := hrpc.NewScanRange(context.Background(), table,
+		[]byte("a"), []byte("b"), hrpc.Small(true))
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.EXPECT().SendRPC(&scanMatcher{scan: s}).DoAndReturn(
+		func(rpc hrpc.Call) (proto.Message, error) {
+			scan := rpc.(*hrpc.Scan)
+			req := scan.ToProto().(*pb.ScanRequest)
+			if !req.GetScan().GetSmall() || !req.GetCloseScanner() {
+				t.Errorf("expected small scan request closing the scanner, got %v", req)
+			}
+			rpc.SetRegion(region1)
+			return &pb.ScanResponse{
+				MoreResultsInRegion: proto.Bool(false),
+				MoreResults:         proto.Bool(false),
+				Results:             dup(resultsPB[:1]),
+			}, nil
+		}).Times(1)
+
+	r, err := scanner.Next()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(hrpc.ToLocalResult(resultsPB[0]), r) {
+		t.Errorf("expected %v, got %v", resultsPB[0], r)
+	}
+	if _, err := scanner.Next(); err != io.EOF {
+		t.Fatalf("expected EOF, got %v", err)
+	}
+}
+
+func TestScannerSmallMoreResultsInRegion(t *testing.T) {
+	ctrl := test.NewController(t)
+	defer ctrl.Finish()
+	c := mock.NewMockRPCClient(ctrl)
+
+	scan, err := hrpc.NewScanRange(context.Background(), table,
+		nil, []byte("bar"), hrpc.Small(true))
+	if err != nil {
+		t.Fatal(err)
+	}
+	scanner := newScanner(c, scan)
+
+	// the server didn't return the whole range, so the next small scan
+	// starts right after the last returned row
+	for i, start := range [][]byte{nil, []byte("a\x00")} {
+		s, err := hrpc.NewScanRange(context.Background(), table,
+			start, []byte("bar"), hrpc.Small(true))
+		if err != nil {
+			t.Fatal(err)
+		}
+		c.EXPECT().SendRPC(&scanMatcher{scan: s}).DoAndReturn(
+			func(i int) func(rpc hrpc.Call) (proto.Message, error) {
+				return func(rpc hrpc.Call) (proto.Message, error) {
+					rpc.SetRegion(region1)
+					return &pb.ScanResponse{
+						MoreResultsInRegion: proto.Bool(i == 0),
+						MoreResults:         proto.Bool(false),
+						Results:             dup(resultsPB[i : i+1]),
+					}, nil
+				}
+			}(i)).Times(1)
+	}
+
+	for i := 0; i < 2; i++ {
+		r, err := scanner.Next()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(hrpc.ToLocalResult(resultsPB[i]), r) {
+			t.Errorf("expected %v, got %v", resultsPB[i], r)
+		}
+	}
+	if _, err := scanner.Next(); err != io.EOF {
+		t.Fatalf("expected EOF, got %v", err)
+	}
+}
+
+func TestScannerRegion(t *testing.T) {
+	ctrl := test.NewController(t)
+	defer ctrl.Finish()
+	c := mock.NewMockRPCClient(ctrl)
+
+	reg := region.NewInfo(0, nil, table, []byte("test,bar,1234567890042.56f833d5569a27."),
+		[]byte("bar"), []byte("foo"))
+	daughter := region.NewInfo(0, nil, table,
+		[]byte("test,bar,1234567890043.56f833d5569a28."), []byte("bar"), []byte("baz"))
+
+	for name, tcase := range map[string]struct {
+		region hrpc.RegionInfo
+		err    error
+	}{
+		"same region": {region: reg},
+		"split":       {region: daughter, err: ErrRegionChanged},
+	} {
+		t.Run(name, func(t *testing.T) {
+			scan, err := hrpc.NewScanRange(context.Background(), table, []byte("bar"), nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			scanner := newScanner(c, scan)
+			scanner.regionName = reg.Name()
+
+			var (
+				wg       sync.WaitGroup
+				requests int
+			)
+			wg.Add(1)
+			defer wg.Wait()
+			c.EXPECT().SendRPC(gomock.Any()).DoAndReturn(
+				func(rpc hrpc.Call) (proto.Message, error) {
+					if rpc.(*hrpc.Scan).IsClosing() {
+						wg.Done()
+						return &pb.ScanResponse{}, nil
+					}
+					requests++
+					rpc.SetRegion(tcase.region)
+					// the region doesn't end the table, but it's the only one scanned
+					return &pb.ScanResponse{
+						ScannerId:           cp(42),
+						MoreResultsInRegion: proto.Bool(false),
+						Results:             dup(resultsPB[2:3]),
+					}, nil
+				}).Times(2)
+
+			r, err := scanner.Next()
+			if tcase.err != nil {
+				if !errors.Is(err, tcase.err) {
+					t.Fatalf("expected error %v, got %v", tcase.err, err)
+				}
+				if r != nil {
+					t.Errorf("expected no result, got %v", r)
+				}
+			} else {
+				if err != nil {
+					t.Fatal(err)
+				}
+				if !reflect.DeepEqual(hrpc.ToLocalResult(resultsPB[2]), r) {
+					t.Errorf("expected %v, got %v", resultsPB[2], r)
+				}
+			}
+			if _, err := scanner.Next(); err != io.EOF {
+				t.Fatalf("expected EOF, got %v", err)
+			}
+			if requests != 1 {
+				t.Errorf("expected a single scan request, got %d", requests)
+			}
+		})
+	}
+}
+
+func TestScanRegionBadInput(t *testing.T) {
+	c := newMockClient(nil)
+	for name, s := range map[string]hrpc.Scanner{
+		"no commas": c.ScanRegion(context.Background(), []byte("test")),
+		"one comma": c.ScanRegion(context.Background(), []byte("test,1234567890042")),
+		"reversed": c.ScanRegion(context.Background(),
+			[]byte("test,,1234567890042.56f833d5569a27."), hrpc.Reversed()),
+	} {
+		if _, err := s.Next(); err == nil || err == io.EOF {
+			t.Errorf("%s: expected an error, got %v", name, err)
+		}
+		if _, err := s.Next(); err != io.EOF {
+			t.Errorf("%s: expected EOF after the error, got %v", name, err)
+		}
+	}
+}
+
+func TestParseRegionName(t *testing.T) {
+	for name, tcase := range map[string]struct {
+		table, startKey string
+	}{
+		"test,,1234567890042.56f833d5569a27.":         {table: "test"},
+		"test,foo,1234567890042.56f833d5569a27.":      {table: "test", startKey: "foo"},
+		"ns:test,f,o,o,1234567890042.56f833d5569a27.": {table: "ns:test", startKey: "f,o,o"},
+	} {
+		table, startKey, err := parseRegionName([]byte(name))
+		if err != nil {
+			t.Errorf("%q: unexpected error %v", name, err)
+			continue
+		}
+		if string(table) != tcase.table || string(startKey) != tcase.startKey {
+			t.Errorf("%q: expected table %q and start key %q, got %q and %q",
+				name, tcase.table, tcase.startKey, table, startKey)
+		}
+	}
+}
+func TestScannerMultiRowRange(t *testing.T) {
+	ctrl := test.NewController(t)
+	defer ctrl.Finish()
+	c := mock.NewMockRPCClient(ctrl)
+
+	var regions []hrpc.RegionInfo
+	for _, keys := range [][2]string{{"", "b"}, {"b", "d"}, {"d", "f"}, {"f", "h"}, {"h", ""}} {
+		regions = append(regions, region.NewInfo(0, nil, table,
+			[]byte("test,"+keys[0]+",1234567890042.56f833d5569a27."),
+			[]byte(keys[0]), []byte(keys[1])))
+	}
+	var rows []string
+	for _, prefix := range []string{"a", "b", "c", "d", "e", "f", "g", "h"} {
+		for i := 0; i < 6; i++ {
+			rows = append(rows, fmt.Sprintf("%s%d", prefix, i))
+		}
+	}
+	ranges := []*filter.RowRange{
+		// out of order on purpose
+		filter.NewRowRange([]byte("h2"), []byte("h4"), true, false),
+		filter.NewRowRange([]byte("a0"), []byte("a2"), true, false),
+		filter.NewRowRange([]byte("d1"), []byte("d3"), false, true),
+	}
+	inRanges := func(row []byte) bool {
+		for _, r := range ranges {
+			start := bytes.Compare(row, r.StartRow)
+			stop := bytes.Compare(row, r.StopRow)
+			if (start > 0 || start == 0 && *r.StartRowInclusive) &&
+				(stop < 0 || stop == 0 && *r.StopRowInclusive) {
+				return true
+			}
+		}
+		return false
+	}
+
+	scan, err := hrpc.NewScan(context.Background(), table,
+		hrpc.Filters(filter.NewMultiRowRangeFilter(ranges)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	scanner := newScanner(c, scan)
+
+	// serve scans like regionservers applying the filter would
+	var scanned []string
+	c.EXPECT().SendRPC(gomock.Any()).DoAndReturn(func(rpc hrpc.Call) (proto.Message, error) {
+		start := rpc.(*hrpc.Scan).StartRow()
+		var reg hrpc.RegionInfo
+		for _, r := range regions {
+			if bytes.Compare(start, r.StartKey()) >= 0 &&
+				(len(r.StopKey()) == 0 || bytes.Compare(start, r.StopKey()) < 0) {
+				reg = r
+			}
+		}
+		rpc.SetRegion(reg)
+		scanned = append(scanned, string(reg.StartKey()))
+		var results []*pb.Result
+		for _, row := range rows {
+			if bytes.Compare([]byte(row), start) >= 0 &&
+				(len(reg.StopKey()) == 0 || bytes.Compare([]byte(row), reg.StopKey()) < 0) &&
+				inRanges([]byte(row)) {
+				results = append(results, &pb.Result{Cell: []*pb.Cell{{Row: []byte(row)}}})
+			}
+		}
+		return &pb.ScanResponse{Results: results}, nil
+	}).AnyTimes()
+
+	var got []string
+	for {
+		r, err := scanner.Next()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			t.Fatal(err)
+		}
+		got = append(got, string(r.Cells[0].Row))
+	}
+
+	if exp := []string{"a0", "a1", "d2", "d3", "h2", "h3"}; !reflect.DeepEqual(exp, got) {
+		t.Errorf("expected rows %q, got %q", exp, got)
+	}
+	// regions starting at b and f are outside of all ranges
+	if exp := []string{"", "d", "h"}; !reflect.DeepEqual(exp, scanned) {
+		t.Errorf("expected regions starting at %q to be scanned, got %q", exp, scanned)
+	}
+}
+
+func TestScannerKeys(t *testing.T) {
+	ctrl := test.NewController(t)
+	defer ctrl.Finish()
+	c := mock.NewMockRPCClient(ctrl)
+
+	var regions []hrpc.RegionInfo
+	for _, keys := range [][2]string{{"", "b"}, {"b", "d"}, {"d", ""}} {
+		regions = append(regions, region.NewInfo(0, nil, table,
+			[]byte("test,"+keys[0]+",1234567890042.56f833d5569a27."),
+			[]byte(keys[0]), []byte(keys[1])))
+	}
+	var rows []string
+	for _, prefix := range []string{"a", "b", "c", "d", "e"} {
+		for i := 0; i < 6; i++ {
+			rows = append(rows, fmt.Sprintf("%s%d", prefix, i))
+		}
+	}
+
+	scan, err := hrpc.NewScan(context.Background(), table,
+		hrpc.Filters(filter.NewColumnPrefixFilter([]byte("q"))),
+		// out of order and duplicated on purpose
+		hrpc.Keys([]byte("e4"), []byte("a1"), []byte("e2"), []byte("a1"), []byte("a3")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	scanner := newScanner(c, scan)
+
+	// serve scans like regionservers applying the filters would
+	var scanned []string
+	c.EXPECT().SendRPC(gomock.Any()).DoAndReturn(func(rpc hrpc.Call) (proto.Message, error) {
+		s := rpc.(*hrpc.Scan)
+		var reg hrpc.RegionInfo
+		for _, r := range regions {
+			if bytes.Compare(s.StartRow(), r.StartKey()) >= 0 &&
+				(len(r.StopKey()) == 0 || bytes.Compare(s.StartRow(), r.StopKey()) < 0) {
+				reg = r
+			}
+		}
+		rpc.SetRegion(reg)
+		scanned = append(scanned, string(reg.StartKey()))
+
+		// the keys must pass along with the filter of the scan
+		list := &pb.FilterList{}
+		if err := proto.Unmarshal(
+			s.ToProto().(*pb.ScanRequest).Scan.Filter.SerializedFilter, list); err != nil {
+			t.Fatal(err)
+		}
+		if list.GetOperator() != pb.FilterList_MUST_PASS_ALL || len(list.Filters) != 2 {
+			t.Fatalf("unexpected filter list %v", list)
+		}
+		mrrf := &pb.MultiRowRangeFilter{}
+		if err := proto.Unmarshal(list.Filters[0].SerializedFilter, mrrf); err != nil {
+			t.Fatal(err)
+		}
+		prefix := &pb.ColumnPrefixFilter{}
+		if err := proto.Unmarshal(list.Filters[1].SerializedFilter, prefix); err != nil {
+			t.Fatal(err)
+		}
+		requested := func(row []byte) bool {
+			for _, r := range mrrf.RowRangeList {
+				if bytes.Equal(row, r.StartRow) && bytes.Equal(row, r.StopRow) &&
+					r.GetStartRowInclusive() && r.GetStopRowInclusive() {
+					return true
+				}
+			}
+			return false
+		}
+
+		var results []*pb.Result
+		for _, row := range rows {
+			if bytes.Compare([]byte(row), s.StartRow()) < 0 ||
+				len(reg.StopKey()) != 0 && bytes.Compare([]byte(row), reg.StopKey()) >= 0 ||
+				!requested([]byte(row)) {
+				continue
+			}
+			var cells []*pb.Cell
+			for _, q := range []string{"q", "r"} {
+				if bytes.HasPrefix([]byte(q), prefix.Prefix) {
+					cells = append(cells, &pb.Cell{Row: []byte(row), Qualifier: []byte(q)})
+				}
+			}
+			results = append(results, &pb.Result{Cell: cells})
+		}
+		return &pb.ScanResponse{Results: results}, nil
+	}).AnyTimes()
+
+	var got []string
+	for {
+		r, err := scanner.Next()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			t.Fatal(err)
+		}
+		for _, cell := range r.Cells {
+			got = append(got, string(cell.Row)+":"+string(cell.Qualifier))
+		}
+	}
+
+	if exp := []string{"a1:q", "a3:q", "e2:q", "e4:q"}; !reflect.DeepEqual(exp, got) {
+		t.Errorf("expected cells %q, got %q", exp, got)
+	}
+	// the region starting at b holds none of the keys
+	if exp := []string{"", "d"}; !reflect.DeepEqual(exp, scanned) {
+		t.Errorf("expected regions starting at %q to be scanned, got %q", exp, scanned)
+	}
+}
+
+func TestScannerPosition(t *testing.T) {
+	ctrl := test.NewController(t)
+	defer ctrl.Finish()
+	c := mock.NewMockRPCClient(ctrl)
+
+	var regions []hrpc.RegionInfo
+	for _, keys := range [][2]string{{"", "b"}, {"b", "d"}, {"d", "f"}, {"f", ""}} {
+		regions = append(regions, region.NewInfo(0, nil, table,
+			[]byte("test,"+keys[0]+",1234567890042.56f833d5569a27."),
+			[]byte(keys[0]), []byte(keys[1])))
+	}
+	rows := []string{"a0", "a1", "b0", "c0", "d0", "e0", "f0", "g0"}
+
+	scan, err := hrpc.NewScan(context.Background(), table)
+	if err != nil {
+		t.Fatal(err)
+	}
+	scanner := newScanner(c, scan)
+	var lookups int
+	scanner.tableRegions = func(ctx context.Context, tbl []byte) ([]hrpc.RegionInfo, error) {
+		lookups++
+		return regions, nil
+	}
+
+	// every region is scanned with a single request
+	c.EXPECT().SendRPC(gomock.Any()).DoAndReturn(func(rpc hrpc.Call) (proto.Message, error) {
+		start := rpc.(*hrpc.Scan).StartRow()
+		var reg hrpc.RegionInfo
+		for _, r := range regions {
+			if bytes.Compare(start, r.StartKey()) >= 0 {
+				reg = r
+			}
+		}
+		rpc.SetRegion(reg)
+		var results []*pb.Result
+		for _, row := range rows {
+			if bytes.Compare([]byte(row), reg.StartKey()) >= 0 &&
+				(len(reg.StopKey()) == 0 || bytes.Compare([]byte(row), reg.StopKey()) < 0) {
+				results = append(results, &pb.Result{Cell: []*pb.Cell{{Row: []byte(row)}}})
+			}
+		}
+		return &pb.ScanResponse{Results: results, MoreResultsInRegion: proto.Bool(false)}, nil
+	}).Times(len(regions))
+
+	pos, err := scanner.Position()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pos.Region != nil || pos.Fraction != 0 {
+		t.Errorf("expected no position before scanning, got %+v", pos)
+	}
+
+	var got []string
+	for {
+		r, err := scanner.Next()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			t.Fatal(err)
+		}
+		pos, err := scanner.Position()
+		if err != nil {
+			t.Fatal(err)
+		}
+		got = append(got, fmt.Sprintf("%s %q %.2f",
+			r.Cells[0].Row, pos.Region.StartKey(), pos.Fraction))
+	}
+	exp := []string{
+		`a0 "" 0.00`, `a1 "" 0.00`,
+		`b0 "b" 0.25`, `c0 "b" 0.25`,
+		`d0 "d" 0.50`, `e0 "d" 0.50`,
+		// all the rows have been returned
+		`f0 "f" 0.75`, `g0 "f" 1.00`,
+	}
+	if !reflect.DeepEqual(exp, got) {
+		t.Errorf("expected positions %q, got %q", exp, got)
+	}
+	if lookups != 1 {
+		t.Errorf("expected the regions to be looked up once, got %d", lookups)
+	}
+}
+
+func TestScannerRowRangesAfterStopRow(t *testing.T) {
+	ctrl := test.NewController(t)
+	defer ctrl.Finish()
+	c := mock.NewMockRPCClient(ctrl)
+
+	// the only row range starts after the stop row, so nothing is scanned
+	scan, err := hrpc.NewScanRange(context.Background(), table, []byte("a"), []byte("c"),
+		hrpc.Filters(filter.NewMultiRowRangeFilter([]*filter.RowRange{
+			filter.NewRowRange([]byte("h2"), []byte("h4"), true, false),
+		})))
+	if err != nil {
+		t.Fatal(err)
+	}
+	scanner := newScanner(c, scan)
+	if _, err := scanner.Next(); err != io.EOF {
+		t.Errorf("expected EOF, got %v", err)
+	}
+}
+
+func TestScannerRegionGap(t *testing.T) {
+	ctrl := test.NewController(t)
+	defer ctrl.Finish()
+	c := mock.NewMockRPCClient(ctrl)
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+	defer wg.Wait()
+
+	scan, err := hrpc.NewScan(context.Background(), table)
+	if err != nil {
+		t.Fatal(err)
+	}
+	scanner := newScanner(c, scan)
+
+	s, err := hrpc.NewScanRange(scan.Context(), table, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.EXPECT().SendRPC(&scanMatcher{scan: s}).Do(func(rpc hrpc.Call) {
+		rpc.SetRegion(region1)
+	}).Return(&pb.ScanResponse{
+		ScannerId: cp(42),
+		Results:   dup(resultsPB[:2]),
+	}, nil).Times(1)
+	testCallClose(scan, c, 42, &wg, t)
+
+	// hbase:meta has a hole from "bar" to "foo"
+	s, err = hrpc.NewScanRange(scan.Context(), table, []byte("bar"), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.EXPECT().SendRPC(&scanMatcher{scan: s}).Do(func(rpc hrpc.Call) {
+		rpc.SetRegion(region3)
+	}).Return(&pb.ScanResponse{
+		ScannerId:           cp(43),
+		MoreResultsInRegion: proto.Bool(true),
+		Results:             dup(resultsPB[3:4]),
+	}, nil).Times(1)
+	testCallClose(scan, c, 43, &wg, t)
+
+	for _, r := range resultsPB[:2] {
+		res, err := scanner.Next()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if expected := hrpc.ToLocalResult(r); !reflect.DeepEqual(expected, res) {
+			t.Errorf("expected %v, got %v", expected, res)
+		}
+	}
+	// the rows of the next region aren't returned as if the ones
+	// in the hole didn't exist
+	if res, err := scanner.Next(); !errors.Is(err, ErrRegionGap) {
+		t.Fatalf("expected %v, got %v, %v", ErrRegionGap, res, err)
+	}
+}
+
+func TestScannerMemoryLimit(t *testing.T) {
+	ctrl := test.NewController(t)
+	defer ctrl.Finish()
+	c := mock.NewMockRPCClient(ctrl)
+
+	reg := region.NewInfo(0, nil, table, []byte("table,,whatever"), nil, nil)
+	var requests int32
+	c.EXPECT().SendRPC(gomock.Any()).DoAndReturn(func(rpc hrpc.Call) (proto.Message, error) {
+		atomic.AddInt32(&requests, 1)
+		rpc.SetRegion(reg)
+		return &pb.ScanResponse{
+			MoreResults: proto.Bool(false),
+			Results:     dup(resultsPB[:1]),
+		}, nil
+	}).AnyTimes()
+
+	// the budget holds a single batch, the reservation is capped by it
+	memory := newScanMemory(100)
+	newLimitedScanner := func(ctx context.Context) *scanner {
+		scan, err := hrpc.NewScan(ctx, table, hrpc.MaxResultSize(1000))
+		if err != nil {
+			t.Fatal(err)
+		}
+		s := newScanner(c, scan)
+		s.memory = memory
+		return s
+	}
+
+	first := newLimitedScanner(context.Background())
+	if _, err := first.Next(); err != nil {
+		t.Fatal(err)
+	}
+	if n := memory.inUse(); n != 100 {
+		t.Errorf("expected 100 bytes in use, got %d", n)
+	}
+
+	second := newLimitedScanner(context.Background())
+	done := make(chan error, 1)
+	go func() {
+		_, err := second.Next()
+		done <- err
+	}()
+	select {
+	case err := <-done:
+		t.Fatalf("expected the second scanner to wait for memory, got %v", err)
+	case <-time.After(50 * time.Millisecond):
+	}
+	if n := atomic.LoadInt32(&requests); n != 1 {
+		t.Errorf("expected a single request while memory is exhausted, got %d", n)
+	}
+
+	// consuming the whole batch of the first scanner releases its memory
+	if _, err := first.Next(); err != io.EOF {
+		t.Fatalf("expected EOF, got %v", err)
+	}
+	if err := <-done; err != nil {
+		t.Fatal(err)
+	}
+
+	// waiting for memory is bound by the context of the scan
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	third := newLimitedScanner(ctx)
+	if _, err := third.Next(); err != context.DeadlineExceeded {
+		t.Errorf("expected %v, got %v", context.DeadlineExceeded, err)
+	}
+
+	second.Close()
+	if n := memory.inUse(); n != 0 {
+		t.Errorf("expected no bytes in use after closing scanners, got %d", n)
+	}
+	if n := atomic.LoadInt32(&requests); n != 2 {
+		t.Errorf("expected 2 requests, got %d", n)
+	}
+}
+
+func TestScannerMaxResultSize(t *testing.T) {
+	ctrl := test.NewController(t)
+	defer ctrl.Finish()
+	c := mock.NewMockRPCClient(ctrl)
+
+	scan, err := hrpc.NewScan(context.Background(), table,
+		hrpc.NumberOfRows(10), hrpc.MaxResultSize(64))
+	if err != nil {
+		t.Fatal(err)
+	}
+	scanner := newScanner(c, scan)
+
+	reg := region.NewInfo(0, nil, table, []byte("table,,whatever"), nil, nil)
+	scannerID := uint64(42)
+
+	// the size limit is sent when opening the scanner
+	// and kept by the server for the following requests
+	s, err := hrpc.NewScan(context.Background(), table,
+		hrpc.NumberOfRows(10), hrpc.MaxResultSize(64))
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.EXPECT().SendRPC(&scanMatcher{scan: s}).DoAndReturn(
+		func(rpc hrpc.Call) (proto.Message, error) {
+			rpc.SetRegion(reg)
+			// the server returns fewer rows than requested once the limit is reached
+			return &pb.ScanResponse{
+				ScannerId:           cp(scannerID),
+				MoreResultsInRegion: proto.Bool(true),
+				Results:             dup(resultsPB[:1]),
+			}, nil
+		}).Times(1)
+
+	s, err = hrpc.NewScanRange(context.Background(), table, nil, nil,
+		hrpc.ScannerID(scannerID), hrpc.NumberOfRows(10))
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.EXPECT().SendRPC(&scanMatcher{scan: s}).DoAndReturn(
+		func(rpc hrpc.Call) (proto.Message, error) {
+			rpc.SetRegion(reg)
+			return &pb.ScanResponse{
+				MoreResultsInRegion: proto.Bool(false),
+				MoreResults:         proto.Bool(false),
+				Results:             dup(resultsPB[1:2]),
+			}, nil
+		}).Times(1)
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	testCallClose(scan, c, scannerID, &wg, t)
+
+	for i := 0; i < 2; i++ {
+		r, err := scanner.Next()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(hrpc.ToLocalResult(resultsPB[i]), r) {
+			t.Errorf("expected %v, got %v", resultsPB[i], r)
+		}
+	}
+	if _, err := scanner.Next(); err != io.EOF {
+		t.Fatalf("expected EOF, got %v", err)
+	}
+	wg.Wait()
+}
+
+func TestScanCallback(t *testing.T) {
+	ctrl := test.NewController(t)
+	defer ctrl.Finish()
+	c := mock.NewMockRPCClient(ctrl)
+
+	reg := region.NewInfo(0, nil, table, []byte("table,,whatever"), nil, nil)
+	var (
+		fetches int32
+		closed  = make(chan struct{})
+	)
+	c.EXPECT().SendRPC(gomock.Any()).DoAndReturn(func(rpc hrpc.Call) (proto.Message, error) {
+		rpc.SetRegion(reg)
+		if rpc.ToProto().(*pb.ScanRequest).GetCloseScanner() {
+			close(closed)
+			return &pb.ScanResponse{}, nil
+		}
+		// every fetch returns a single row, there are always more
+		n := atomic.AddInt32(&fetches, 1)
+		return &pb.ScanResponse{
+			ScannerId:           cp(42),
+			MoreResultsInRegion: proto.Bool(true),
+			Results: []*pb.Result{{Cell: []*pb.Cell{{
+				Row:       []byte(fmt.Sprintf("row%d", n)),
+				Family:    []byte("cf"),
+				Qualifier: []byte("q"),
+			}}}},
+		}, nil
+	}).AnyTimes()
+
+	scan, err := hrpc.NewScan(context.Background(), table)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var rows []string
+	err = scanCallback(newScanner(c, scan), func(res *hrpc.Result) bool {
+		rows = append(rows, string(res.Cells[0].Row))
+		return len(rows) < 2
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if exp := []string{"row1", "row2"}; !reflect.DeepEqual(exp, rows) {
+		t.Errorf("expected rows %q, got %q", exp, rows)
+	}
+	select {
+	case <-closed:
+	case <-time.After(time.Second):
+		t.Fatal("expected the scanner to be closed")
+	}
+	// no more rows are fetched once the callback stops the scan
+	if n := atomic.LoadInt32(&fetches); n != 2 {
+		t.Errorf("expected 2 fetches, got %d", n)
+	}
+
+	// the error the scan fails with is returned
+	errScan := errors.New("scan failed")
+	failing := mock.NewMockRPCClient(ctrl)
+	failing.EXPECT().SendRPC(gomock.Any()).Return(nil, errScan).Times(1)
+	scan, err = hrpc.NewScan(context.Background(), table)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = scanCallback(newScanner(failing, scan), func(res *hrpc.Result) bool {
+		t.Errorf("unexpected row %v", res)
+		return true
+	})
+	if err != errScan {
+		t.Errorf("expected %v, got %v", errScan, err)
+	}
+}
@@ -0,0 +1,69 @@
+// Copyright (C) 2026  The GoHBase Authors.  All rights reserved.
+// This file is part of GoHBase.
+// Use of this source code is governed by the Apache License 2.0
+// that can be found in the COPYING file.
+
+package gohbase
+
+import (
+	"fmt"
+	"net"
+
+	"github.com/baiweiguo/gohbase/pb"
+)
+
+// ServerLoad is the load of a live regionserver as reported by the master
+type ServerLoad struct {
+	// Addr is the address of the regionserver in the same "host:port"
+	// form as hrpc.RegionClient.Addr
+	Addr string
+	// Regions is the number of regions served by the regionserver
+	Regions int
+	// Requests is the number of requests per second served by the regionserver
+	Requests uint64
+}
+
+// ServerLoads returns the load of all live regionservers in the cluster
+// status returned by AdminClient.ClusterStatus
+func ServerLoads(status *pb.ClusterStatus) []ServerLoad {
+	loads := make([]ServerLoad, 0, len(status.GetLiveServers()))
+	for _, s := range status.GetLiveServers() {
+		server := s.GetServer()
+		loads = append(loads, ServerLoad{
+			Addr: net.JoinHostPort(server.GetHostName(),
+				fmt.Sprint(server.GetPort())),
+			Regions:  len(s.GetServerLoad().GetRegionLoads()),
+			Requests: s.GetServerLoad().GetNumberOfRequests(),
+		})
+	}
+	return loads
+}
+
+// LeastLoaded returns the least loaded of the given candidate addresses, e.g.
+// regionservers hosting replicas of a region. Servers are compared by the
+// number of requests and then by the number of regions. If no candidates
+// are given, all servers in loads are considered. Candidates missing from
+// loads are skipped, and false is returned if none of them has a load.
+func LeastLoaded(loads []ServerLoad, candidates ...string) (ServerLoad, bool) {
+	var best ServerLoad
+	var found bool
+	for _, l := range loads {
+		if len(candidates) > 0 && !containsString(candidates, l.Addr) {
+			continue
+		}
+		if !found || l.Requests < best.Requests ||
+			(l.Requests == best.Requests && l.Regions < best.Regions) {
+			best, found = l, true
+		}
+	}
+	return best, found
+}
+
+func containsString(ss []string, s string) bool {
+	for _, v := range ss {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
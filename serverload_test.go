@@ -0,0 +1,73 @@
+// Copyright (C) 2026  The GoHBase Authors.  All rights reserved.
+// This file is part of GoHBase.
+// Use of this source code is governed by the Apache License 2.0
+// that can be found in the COPYING file.
+
+package gohbase
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/baiweiguo/gohbase/pb"
+	"google.golang.org/protobuf/proto"
+)
+
+func liveServer(host string, port uint32, requests uint64, regions int) *pb.LiveServerInfo {
+	return &pb.LiveServerInfo{
+		Server: &pb.ServerName{HostName: proto.String(host), Port: proto.Uint32(port)},
+		ServerLoad: &pb.ServerLoad{
+			NumberOfRequests: proto.Uint64(requests),
+			RegionLoads:      make([]*pb.RegionLoad, regions),
+		},
+	}
+}
+
+func TestServerLoads(t *testing.T) {
+	status := &pb.ClusterStatus{
+		LiveServers: []*pb.LiveServerInfo{
+			liveServer("host1", 16020, 100, 3),
+			liveServer("host2", 16020, 10, 5),
+			liveServer("host3", 16020, 10, 2),
+			liveServer("host4", 16020, 500, 1),
+		},
+	}
+	loads := ServerLoads(status)
+	expected := []ServerLoad{
+		{Addr: "host1:16020", Regions: 3, Requests: 100},
+		{Addr: "host2:16020", Regions: 5, Requests: 10},
+		{Addr: "host3:16020", Regions: 2, Requests: 10},
+		{Addr: "host4:16020", Regions: 1, Requests: 500},
+	}
+	if !reflect.DeepEqual(expected, loads) {
+		t.Fatalf("expected %v, got %v", expected, loads)
+	}
+
+	tests := []struct {
+		candidates []string
+		expected   string
+		found      bool
+	}{
+		{expected: "host3:16020", found: true},
+		{candidates: []string{"host1:16020", "host4:16020"},
+			expected: "host1:16020", found: true},
+		{candidates: []string{"host2:16020", "unknown:16020"},
+			expected: "host2:16020", found: true},
+		{candidates: []string{"unknown:16020"}},
+	}
+	for _, tcase := range tests {
+		l, ok := LeastLoaded(loads, tcase.candidates...)
+		if ok != tcase.found {
+			t.Errorf("candidates %v: expected found %v, got %v",
+				tcase.candidates, tcase.found, ok)
+		}
+		if l.Addr != tcase.expected {
+			t.Errorf("candidates %v: expected %q, got %q",
+				tcase.candidates, tcase.expected, l.Addr)
+		}
+	}
+
+	if _, ok := LeastLoaded(nil); ok {
+		t.Error("expected no server without loads")
+	}
+}
@@ -0,0 +1,55 @@
+// Copyright (C) 2026  The GoHBase Authors.  All rights reserved.
+// This file is part of GoHBase.
+// Use of this source code is governed by the Apache License 2.0
+// that can be found in the COPYING file.
+
+package gohbase
+
+import (
+	"github.com/baiweiguo/gohbase/hrpc"
+	"github.com/baiweiguo/gohbase/pb"
+	"google.golang.org/protobuf/proto"
+)
+
+// StaleRead is a stale result of a timeline read along with
+// the result of the same read from the primary region.
+type StaleRead struct {
+	Stale   *hrpc.Result
+	Primary *hrpc.Result
+}
+
+// Diverged returns whether the cells of the stale result differ from the cells
+// of the primary one, i.e. whether the replica that served it was behind.
+func (s *StaleRead) Diverged() bool {
+	if len(s.Stale.Cells) != len(s.Primary.Cells) {
+		return true
+	}
+	for i, cell := range s.Stale.Cells {
+		if !proto.Equal((*pb.Cell)(cell), (*pb.Cell)(s.Primary.Cells[i])) {
+			return true
+		}
+	}
+	return false
+}
+
+// RefreshStale reads the row of g again from the primary region with strong
+// consistency if res, the result of g, is stale, so that callers can detect
+// that a replica was behind and use the up to date result. g is left as is:
+// the read is sent with a new Get created with the options of g. If res isn't
+// stale, it's returned as both results of StaleRead without reading again.
+func RefreshStale(c Client, g *hrpc.Get, res *hrpc.Result) (*StaleRead, error) {
+	if !res.Stale {
+		return &StaleRead{Stale: res, Primary: res}, nil
+	}
+	options := append(g.Options()[:len(g.Options()):len(g.Options())],
+		hrpc.ReplicaID(0), hrpc.Consistency(hrpc.StrongConsistency))
+	primary, err := hrpc.NewGet(g.Context(), g.Table(), g.Key(), options...)
+	if err != nil {
+		return nil, err
+	}
+	pres, err := c.Get(primary)
+	if err != nil {
+		return nil, err
+	}
+	return &StaleRead{Stale: res, Primary: pres}, nil
+}
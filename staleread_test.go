@@ -0,0 +1,120 @@
+// Copyright (C) 2026  The GoHBase Authors.  All rights reserved.
+// This file is part of GoHBase.
+// Use of this source code is governed by the Apache License 2.0
+// that can be found in the COPYING file.
+
+package gohbase
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/baiweiguo/gohbase/compression"
+	"github.com/baiweiguo/gohbase/hrpc"
+	"github.com/baiweiguo/gohbase/pb"
+	"github.com/baiweiguo/gohbase/region"
+	"github.com/baiweiguo/gohbase/test"
+	mockRegion "github.com/baiweiguo/gohbase/test/mock/region"
+	"github.com/golang/mock/gomock"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestRefreshStale(t *testing.T) {
+	ctrl := test.NewController(t)
+	defer ctrl.Finish()
+	c := newMockClient(nil)
+
+	row := hrpc.ToLocalResult(metaRow)
+	row.Cells = append(row.Cells, &hrpc.Cell{
+		Row:       row.Cells[0].Row,
+		Family:    []byte("info"),
+		Qualifier: []byte("server_0001"),
+		Value:     []byte("regionserver:3"),
+	})
+	reg, addr, err := region.ParseRegionInfo(row)
+	if err != nil {
+		t.Fatal(err)
+	}
+	result := func(value string, stale bool) *pb.GetResponse {
+		return &pb.GetResponse{Result: &pb.Result{
+			Cell: []*pb.Cell{{
+				Row:       []byte("key"),
+				Family:    []byte("cf"),
+				Qualifier: []byte("q"),
+				Value:     []byte(value),
+			}},
+			Stale: proto.Bool(stale),
+		}}
+	}
+
+	// the primary has the latest value
+	primary := mockRegion.NewMockRegionClient(ctrl)
+	primary.EXPECT().Addr().Return(addr).AnyTimes()
+	primary.EXPECT().String().Return(addr).AnyTimes()
+	primary.EXPECT().QueueRPC(gomock.Any()).Do(func(rpc hrpc.Call) {
+		get := rpc.(*hrpc.Get).ToProto().(*pb.GetRequest)
+		if !bytes.Equal(get.Region.Value, reg.Name()) {
+			t.Errorf("expected request to region %q, got %q", reg.Name(), get.Region.Value)
+		}
+		if get.Get.GetConsistency() != pb.Consistency_STRONG {
+			t.Errorf("expected strong consistency, got %v", get.Get.Consistency)
+		}
+		if len(get.Get.Column) != 1 || string(get.Get.Column[0].Family) != "cf" {
+			t.Errorf("expected the families of the stale read, got %v", get.Get.Column)
+		}
+		rpc.ResultChan() <- hrpc.RPCResult{Msg: result("new", false)}
+	})
+	c.regions.put(reg)
+	c.clients.put(addr, reg, func() hrpc.RegionClient { return primary })
+	reg.SetClient(primary)
+
+	// the replica is behind
+	c.newRegionClientFn = func(addr string, _ region.ClientType, _ int, _ time.Duration,
+		_ string, _ time.Duration, _ compression.Codec,
+		_ ...region.ClientOption) hrpc.RegionClient {
+		rc := mockRegion.NewMockRegionClient(ctrl)
+		rc.EXPECT().Dial(gomock.Any()).Return(nil)
+		rc.EXPECT().QueueRPC(gomock.Any()).Do(func(rpc hrpc.Call) {
+			rpc.ResultChan() <- hrpc.RPCResult{Msg: result("old", true)}
+		})
+		rc.EXPECT().Close()
+		return rc
+	}
+
+	get, err := hrpc.NewGetStr(context.Background(), "test", "key",
+		hrpc.ReplicaID(1), hrpc.Families(map[string][]string{"cf": nil}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := c.Get(get)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sr, err := RefreshStale(c, get, res)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !sr.Diverged() {
+		t.Error("expected the results to diverge")
+	}
+	if sr.Stale != res {
+		t.Errorf("expected the stale result %v, got %v", res, sr.Stale)
+	}
+	if v := string(sr.Primary.Cells[0].Value); v != "new" {
+		t.Errorf("expected the primary value %q, got %q", "new", v)
+	}
+	if get.ReplicaID() != 1 {
+		t.Errorf("expected the get to be left as is, got replica %d", get.ReplicaID())
+	}
+
+	// results that aren't stale aren't read again
+	sr, err = RefreshStale(c, get, sr.Primary)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sr.Diverged() {
+		t.Error("expected the results not to diverge")
+	}
+}
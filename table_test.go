@@ -116,6 +116,43 @@ func TestCreatePresplitTable(t *testing.T) {
 	}
 }
 
+func TestCreateTableSplitRegions(t *testing.T) {
+	testTableName := t.Name() + "_" + getTimestampString()
+	t.Log("testTableName=" + testTableName)
+
+	ac := gohbase.NewAdminClient(*host)
+	crt := hrpc.NewCreateTable(context.Background(), []byte(testTableName),
+		cFamilies, hrpc.SplitRegions(hrpc.HexStringSplit, 5))
+	if err := ac.CreateTable(crt); err != nil {
+		t.Fatalf("CreateTable returned an error: %v", err)
+	}
+
+	// 4 split keys make 5 regions in hbase:meta
+	c := gohbase.NewClient(*host)
+	defer c.Close()
+	metaKey := testTableName + ","
+	scan, err := hrpc.NewScanStr(context.Background(), metaTableName,
+		hrpc.Filters(filter.NewPrefixFilter([]byte(metaKey))))
+	if err != nil {
+		t.Fatalf("Failed to create Scan request: %s", err)
+	}
+	var regions int
+	scanner := c.Scan(scan)
+	for {
+		_, err := scanner.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		regions++
+	}
+	if regions != 5 {
+		t.Errorf("Meta returned %d regions for prefix '%s', want 5", regions, metaKey)
+	}
+}
+
 func TestCreateTableWithAttributes(t *testing.T) {
 	testTableName := t.Name() + "_" + getTimestampString()
 	t.Log("testTableName=" + testTableName)
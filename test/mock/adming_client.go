@@ -5,6 +5,7 @@
 package mock
 
 import (
+	context "context"
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
@@ -50,6 +51,48 @@ func (mr *MockAdminClientMockRecorder) ClusterStatus() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClusterStatus", reflect.TypeOf((*MockAdminClient)(nil).ClusterStatus))
 }
 
+// CompactRegion mocks base method.
+func (m *MockAdminClient) CompactRegion(arg0 context.Context, arg1 []byte, arg2 bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompactRegion", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CompactRegion indicates an expected call of CompactRegion.
+func (mr *MockAdminClientMockRecorder) CompactRegion(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompactRegion", reflect.TypeOf((*MockAdminClient)(nil).CompactRegion), arg0, arg1, arg2)
+}
+
+// CompactTable mocks base method.
+func (m *MockAdminClient) CompactTable(arg0 context.Context, arg1 string, arg2 bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompactTable", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CompactTable indicates an expected call of CompactTable.
+func (mr *MockAdminClientMockRecorder) CompactTable(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompactTable", reflect.TypeOf((*MockAdminClient)(nil).CompactTable), arg0, arg1, arg2)
+}
+
+// CreateNamespace mocks base method.
+func (m *MockAdminClient) CreateNamespace(arg0 *hrpc.CreateNamespace) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateNamespace", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateNamespace indicates an expected call of CreateNamespace.
+func (mr *MockAdminClientMockRecorder) CreateNamespace(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNamespace", reflect.TypeOf((*MockAdminClient)(nil).CreateNamespace), arg0)
+}
+
 // CreateSnapshot mocks base method.
 func (m *MockAdminClient) CreateSnapshot(arg0 *hrpc.Snapshot) error {
 	m.ctrl.T.Helper()
@@ -134,6 +177,21 @@ func (mr *MockAdminClientMockRecorder) EnableTable(arg0 interface{}) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableTable", reflect.TypeOf((*MockAdminClient)(nil).EnableTable), arg0)
 }
 
+// GetTableState mocks base method.
+func (m *MockAdminClient) GetTableState(arg0 string) (pb.Table_State, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTableState", arg0)
+	ret0, _ := ret[0].(pb.Table_State)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTableState indicates an expected call of GetTableState.
+func (mr *MockAdminClientMockRecorder) GetTableState(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTableState", reflect.TypeOf((*MockAdminClient)(nil).GetTableState), arg0)
+}
+
 // ListSnapshots mocks base method.
 func (m *MockAdminClient) ListSnapshots(arg0 *hrpc.ListSnapshots) ([]*pb.SnapshotDescription, error) {
 	m.ctrl.T.Helper()
@@ -164,6 +222,20 @@ func (mr *MockAdminClientMockRecorder) ListTableNames(arg0 interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTableNames", reflect.TypeOf((*MockAdminClient)(nil).ListTableNames), arg0)
 }
 
+// MergeRegions mocks base method.
+func (m *MockAdminClient) MergeRegions(arg0 context.Context, arg1, arg2 []byte, arg3 bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MergeRegions", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MergeRegions indicates an expected call of MergeRegions.
+func (mr *MockAdminClientMockRecorder) MergeRegions(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeRegions", reflect.TypeOf((*MockAdminClient)(nil).MergeRegions), arg0, arg1, arg2, arg3)
+}
+
 // MoveRegion mocks base method.
 func (m *MockAdminClient) MoveRegion(arg0 *hrpc.MoveRegion) error {
 	m.ctrl.T.Helper()
@@ -178,6 +250,36 @@ func (mr *MockAdminClientMockRecorder) MoveRegion(arg0 interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveRegion", reflect.TypeOf((*MockAdminClient)(nil).MoveRegion), arg0)
 }
 
+// RegionServerCoprocessorServiceAll mocks base method.
+func (m *MockAdminClient) RegionServerCoprocessorServiceAll(arg0 context.Context, arg1, arg2 string, arg3 []byte) (map[string]hrpc.CoprocessorResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegionServerCoprocessorServiceAll", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(map[string]hrpc.CoprocessorResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RegionServerCoprocessorServiceAll indicates an expected call of RegionServerCoprocessorServiceAll.
+func (mr *MockAdminClientMockRecorder) RegionServerCoprocessorServiceAll(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegionServerCoprocessorServiceAll", reflect.TypeOf((*MockAdminClient)(nil).RegionServerCoprocessorServiceAll), arg0, arg1, arg2, arg3)
+}
+
+// RegionServerMetrics mocks base method.
+func (m *MockAdminClient) RegionServerMetrics(arg0 context.Context, arg1 hrpc.MetricsDecoder) (*hrpc.CoprocessorMetrics, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegionServerMetrics", arg0, arg1)
+	ret0, _ := ret[0].(*hrpc.CoprocessorMetrics)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RegionServerMetrics indicates an expected call of RegionServerMetrics.
+func (mr *MockAdminClientMockRecorder) RegionServerMetrics(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegionServerMetrics", reflect.TypeOf((*MockAdminClient)(nil).RegionServerMetrics), arg0, arg1)
+}
+
 // RestoreSnapshot mocks base method.
 func (m *MockAdminClient) RestoreSnapshot(arg0 *hrpc.Snapshot) error {
 	m.ctrl.T.Helper()
@@ -192,6 +294,21 @@ func (mr *MockAdminClientMockRecorder) RestoreSnapshot(arg0 interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreSnapshot", reflect.TypeOf((*MockAdminClient)(nil).RestoreSnapshot), arg0)
 }
 
+// ServerVersion mocks base method.
+func (m *MockAdminClient) ServerVersion() (hrpc.Version, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ServerVersion")
+	ret0, _ := ret[0].(hrpc.Version)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ServerVersion indicates an expected call of ServerVersion.
+func (mr *MockAdminClientMockRecorder) ServerVersion() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ServerVersion", reflect.TypeOf((*MockAdminClient)(nil).ServerVersion))
+}
+
 // SetBalancer mocks base method.
 func (m *MockAdminClient) SetBalancer(arg0 *hrpc.SetBalancer) (bool, error) {
 	m.ctrl.T.Helper()
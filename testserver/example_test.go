@@ -0,0 +1,85 @@
+// Copyright (C) 2026  The GoHBase Authors.  All rights reserved.
+// This file is part of GoHBase.
+// Use of this source code is governed by the Apache License 2.0
+// that can be found in the COPYING file.
+
+package testserver_test
+
+import (
+	"context"
+	"fmt"
+	"net"
+
+	"github.com/baiweiguo/gohbase"
+	"github.com/baiweiguo/gohbase/hrpc"
+	"github.com/baiweiguo/gohbase/testserver"
+)
+
+func Example() {
+	srv := testserver.New()
+	defer srv.Close()
+	srv.CreateTable("test")
+
+	c := gohbase.NewClient("",
+		gohbase.ZookeeperClient(srv),
+		gohbase.Dialer(srv.Dial))
+	defer c.Close()
+
+	put, err := hrpc.NewPutStr(context.Background(), "test", "row",
+		map[string]map[string][]byte{"cf": {"a": []byte("hello")}})
+	if err != nil {
+		panic(err)
+	}
+	if _, err = c.Put(put); err != nil {
+		panic(err)
+	}
+
+	get, err := hrpc.NewGetStr(context.Background(), "test", "row")
+	if err != nil {
+		panic(err)
+	}
+	res, err := c.Get(get)
+	if err != nil {
+		panic(err)
+	}
+	for _, cell := range res.Cells {
+		fmt.Printf("%s %s:%s %s\n", cell.Row, cell.Family, cell.Qualifier, cell.Value)
+	}
+	// Output: row cf:a hello
+}
+
+func ExampleServer_Start() {
+	srv := testserver.New()
+	defer srv.Close()
+	srv.CreateTable("test")
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		panic(err)
+	}
+	srv.Start(ln)
+
+	// the client connects to the listener with the default dialer
+	c := gohbase.NewClient("", gohbase.ZookeeperClient(srv))
+	defer c.Close()
+
+	put, err := hrpc.NewPutStr(context.Background(), "test", "row",
+		map[string]map[string][]byte{"cf": {"a": []byte("hello")}})
+	if err != nil {
+		panic(err)
+	}
+	if _, err = c.Put(put); err != nil {
+		panic(err)
+	}
+
+	get, err := hrpc.NewGetStr(context.Background(), "test", "row")
+	if err != nil {
+		panic(err)
+	}
+	res, err := c.Get(get)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("%s\n", res.Cells[0].Value)
+	// Output: hello
+}
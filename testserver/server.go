@@ -0,0 +1,576 @@
+// Copyright (C) 2026  The GoHBase Authors.  All rights reserved.
+// This file is part of GoHBase.
+// Use of this source code is governed by the Apache License 2.0
+// that can be found in the COPYING file.
+
+// Package testserver provides an in-process fake of an HBase cluster that
+// speaks enough of the HBase RPC protocol to serve Get, Put, Delete, Increment,
+// Append and Scan requests as well as lookups of regions in hbase:meta.
+// It allows to test code using gohbase without a real cluster:
+//
+//	srv := testserver.New()
+//	defer srv.Close()
+//	srv.CreateTable("test")
+//	c := gohbase.NewClient("",
+//		gohbase.ZookeeperClient(srv),
+//		gohbase.Dialer(srv.Dial))
+//
+// Every table is served as a single region by a single RegionServer and
+// keeps all the data in memory. Filters and compression of cellblocks are
+// not supported, and conditional mutations, including atomic ones of several
+// mutations of a row, support only binary, regex and substring comparators.
+package testserver
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"sync"
+
+	"github.com/baiweiguo/gohbase/pb"
+	"github.com/baiweiguo/gohbase/zk"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+)
+
+// DefaultAddr is the address of the RegionServer returned
+// by a Server that isn't serving a listener.
+const DefaultAddr = "testserver:16020"
+
+// DefaultClusterID is the ID of the cluster of a Server
+// unless it's changed with SetClusterID.
+const DefaultClusterID = "testserver"
+
+const (
+	metaTableName  = "hbase:meta"
+	metaRegionName = "hbase:meta,,1"
+)
+
+// ErrServerClosed is returned when connecting to a closed Server.
+var ErrServerClosed = errors.New("testserver: server closed")
+
+// Server is an in-process fake of an HBase cluster.
+// It's safe for concurrent use.
+type Server struct {
+	m sync.Mutex
+
+	addr      string
+	clusterID string
+	// tables by fully qualified name
+	tables map[string]*table
+	// regions are the tables by name of their region
+	regions map[string]*table
+
+	scanners      map[uint64]*scanner
+	nextScannerID uint64
+
+	closed    bool
+	conns     map[net.Conn]struct{}
+	listeners []net.Listener
+}
+
+// New returns a Server without any tables.
+func New() *Server {
+	return &Server{
+		addr:      DefaultAddr,
+		clusterID: DefaultClusterID,
+		tables:    make(map[string]*table),
+		regions:   make(map[string]*table),
+		scanners:  make(map[uint64]*scanner),
+		conns:     make(map[net.Conn]struct{}),
+	}
+}
+
+// Addr returns the address of the RegionServer that serves all the regions.
+func (s *Server) Addr() string {
+	s.m.Lock()
+	defer s.m.Unlock()
+	return s.addr
+}
+
+// CreateTable creates an empty table with the given fully qualified name, if
+// it doesn't exist yet. Tables don't have a schema, any column family can be
+// written to.
+func (s *Server) CreateTable(name string) {
+	s.m.Lock()
+	defer s.m.Unlock()
+	if _, ok := s.tables[name]; ok {
+		return
+	}
+	// region names are table,start_key,region_id.md5_of_the_rest.
+	const regionID = 1
+	prefix := fmt.Sprintf("%s,,%d", name, regionID)
+	regionName := fmt.Sprintf("%s.%x.", prefix, md5.Sum([]byte(prefix)))
+	t := newTable(name, regionName, regionID)
+	s.tables[name] = t
+	s.regions[regionName] = t
+}
+
+// LocateResource implements zk.Client, so that the Server can be used
+// instead of ZooKeeper to locate hbase:meta and the master.
+func (s *Server) LocateResource(zk.ResourceName) (string, error) {
+	return s.Addr(), nil
+}
+
+// ReadClusterID implements zk.ClusterIDReader, so that the Server can be used
+// instead of ZooKeeper to read the ID of the cluster.
+func (s *Server) ReadClusterID(zk.ResourceName) (string, error) {
+	s.m.Lock()
+	defer s.m.Unlock()
+	return s.clusterID, nil
+}
+
+// SetClusterID changes the ID of the cluster of the Server.
+func (s *Server) SetClusterID(id string) {
+	s.m.Lock()
+	defer s.m.Unlock()
+	s.clusterID = id
+}
+
+// Dial connects to the Server over an in-memory connection. It has the
+// signature of net.Dialer.DialContext and ignores the network and address.
+func (s *Server) Dial(ctx context.Context, network, addr string) (net.Conn, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	client, server := net.Pipe()
+	if !s.addConn(server) {
+		client.Close()
+		server.Close()
+		return nil, ErrServerClosed
+	}
+	go s.serveConn(server)
+	return client, nil
+}
+
+// Start starts serving connections accepted from the listener in the
+// background, and makes lookups of hbase:meta return the address of it.
+func (s *Server) Start(ln net.Listener) {
+	s.m.Lock()
+	s.addr = ln.Addr().String()
+	s.listeners = append(s.listeners, ln)
+	s.m.Unlock()
+
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			if !s.addConn(conn) {
+				conn.Close()
+				return
+			}
+			go s.serveConn(conn)
+		}
+	}()
+}
+
+// ServeConn serves requests received over the connection until it's closed.
+func (s *Server) ServeConn(conn net.Conn) error {
+	if !s.addConn(conn) {
+		conn.Close()
+		return ErrServerClosed
+	}
+	return s.serveConn(conn)
+}
+
+// Close closes all the listeners and connections of the Server.
+func (s *Server) Close() error {
+	s.m.Lock()
+	defer s.m.Unlock()
+	if s.closed {
+		return nil
+	}
+	s.closed = true
+	for _, ln := range s.listeners {
+		ln.Close()
+	}
+	for conn := range s.conns {
+		conn.Close()
+	}
+	return nil
+}
+
+func (s *Server) addConn(conn net.Conn) bool {
+	s.m.Lock()
+	defer s.m.Unlock()
+	if s.closed {
+		return false
+	}
+	s.conns[conn] = struct{}{}
+	return true
+}
+
+func (s *Server) serveConn(conn net.Conn) error {
+	defer func() {
+		s.m.Lock()
+		delete(s.conns, conn)
+		s.m.Unlock()
+		conn.Close()
+	}()
+
+	if err := readHello(conn); err != nil {
+		return err
+	}
+	for {
+		id, method, param, cellblocks, err := readRequest(conn)
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		rsp, err := s.call(method, param, cellblocks)
+		if err = writeResponse(conn, id, rsp, err); err != nil {
+			return err
+		}
+	}
+}
+
+// readHello reads the preamble and the header of a new connection.
+func readHello(r io.Reader) error {
+	var preamble [10]byte
+	if _, err := io.ReadFull(r, preamble[:]); err != nil {
+		return err
+	}
+	if string(preamble[:4]) != "HBas" {
+		return fmt.Errorf("testserver: unexpected preamble %q", preamble[:4])
+	}
+	b := make([]byte, binary.BigEndian.Uint32(preamble[6:]))
+	if _, err := io.ReadFull(r, b); err != nil {
+		return err
+	}
+	var header pb.ConnectionHeader
+	if err := proto.Unmarshal(b, &header); err != nil {
+		return fmt.Errorf("testserver: failed to decode connection header: %s", err)
+	}
+	if header.CellBlockCompressorClass != nil {
+		return fmt.Errorf("testserver: compression %s is not supported",
+			header.GetCellBlockCompressorClass())
+	}
+	return nil
+}
+
+// readRequest reads a request from the connection.
+func readRequest(r io.Reader) (id uint32, method string, param, cellblocks []byte, err error) {
+	var sz [4]byte
+	if _, err = io.ReadFull(r, sz[:]); err != nil {
+		return
+	}
+	b := make([]byte, binary.BigEndian.Uint32(sz[:]))
+	if _, err = io.ReadFull(r, b); err != nil {
+		return
+	}
+
+	headerBytes, n := protowire.ConsumeBytes(b)
+	if n < 0 {
+		err = fmt.Errorf("testserver: failed to decode request header: %v",
+			protowire.ParseError(n))
+		return
+	}
+	var header pb.RequestHeader
+	if err = proto.Unmarshal(headerBytes, &header); err != nil {
+		err = fmt.Errorf("testserver: failed to decode request header: %s", err)
+		return
+	}
+	b = b[n:]
+	param, n = protowire.ConsumeBytes(b)
+	if n < 0 {
+		err = fmt.Errorf("testserver: failed to decode request: %v", protowire.ParseError(n))
+		return
+	}
+	return header.GetCallId(), header.GetMethodName(), param, b[n:], nil
+}
+
+// writeResponse writes the response to the call, or the exception if err isn't nil.
+func writeResponse(w io.Writer, id uint32, rsp proto.Message, err error) error {
+	header := &pb.ResponseHeader{CallId: &id}
+	if err != nil {
+		class := doNotRetryIOException
+		var e *exception
+		if errors.As(err, &e) {
+			class = e.class
+		}
+		header.Exception = &pb.ExceptionResponse{
+			ExceptionClassName: proto.String(class),
+			StackTrace:         proto.String(err.Error()),
+		}
+		rsp = nil
+	}
+
+	b := make([]byte, 4)
+	for _, m := range []proto.Message{header, rsp} {
+		if m == nil {
+			continue
+		}
+		b = protowire.AppendVarint(b, uint64(proto.Size(m)))
+		if b, err = (proto.MarshalOptions{UseCachedSize: true}).MarshalAppend(b, m); err != nil {
+			return err
+		}
+	}
+	binary.BigEndian.PutUint32(b, uint32(len(b)-4))
+	_, err = w.Write(b)
+	return err
+}
+
+const doNotRetryIOException = "org.apache.hadoop.hbase.DoNotRetryIOException"
+
+// exception is an error that is returned to the client as the Java exception class.
+type exception struct {
+	class string
+	msg   string
+}
+
+func (e *exception) Error() string {
+	return e.msg
+}
+
+func errorf(format string, args ...interface{}) error {
+	return &exception{class: doNotRetryIOException, msg: fmt.Sprintf(format, args...)}
+}
+
+// call executes the request of the method.
+func (s *Server) call(method string, param, cellblocks []byte) (proto.Message, error) {
+	var req proto.Message
+	switch method {
+	case "Get":
+		req = &pb.GetRequest{}
+	case "Mutate":
+		req = &pb.MutateRequest{}
+	case "Scan":
+		req = &pb.ScanRequest{}
+	case "Multi":
+		req = &pb.MultiRequest{}
+	default:
+		return nil, errorf("testserver: method %s is not supported", method)
+	}
+	if err := proto.Unmarshal(param, req); err != nil {
+		return nil, errorf("testserver: failed to decode %s request: %s", method, err)
+	}
+	cells := &cellReader{b: cellblocks}
+
+	s.m.Lock()
+	defer s.m.Unlock()
+	switch req := req.(type) {
+	case *pb.GetRequest:
+		t, err := s.region(req.Region)
+		if err != nil {
+			return nil, err
+		}
+		r, err := t.get(req.Get)
+		if err != nil {
+			return nil, err
+		}
+		return &pb.GetResponse{Result: r}, nil
+	case *pb.MutateRequest:
+		t, err := s.region(req.Region)
+		if err != nil {
+			return nil, err
+		}
+		if req.Condition != nil {
+			ok, err := t.check(req.Condition)
+			if err != nil {
+				return nil, err
+			}
+			if !ok {
+				return &pb.MutateResponse{Processed: proto.Bool(false)}, nil
+			}
+		}
+		r, err := t.mutate(req.Mutation, cells)
+		if err != nil {
+			return nil, err
+		}
+		return &pb.MutateResponse{Result: r, Processed: proto.Bool(true)}, nil
+	case *pb.ScanRequest:
+		return s.scan(req)
+	default:
+		return s.multi(req.(*pb.MultiRequest), cells)
+	}
+}
+
+// region returns the table of the region.
+func (s *Server) region(spec *pb.RegionSpecifier) (*table, error) {
+	if spec.GetType() != pb.RegionSpecifier_REGION_NAME {
+		return nil, errorf("testserver: region specifier %s is not supported", spec.GetType())
+	}
+	name := string(spec.GetValue())
+	if name == metaRegionName {
+		return nil, errorf("testserver: only scans of %s are supported", metaTableName)
+	}
+	t, ok := s.regions[name]
+	if !ok {
+		return nil, errorf("testserver: region %q is not online", name)
+	}
+	return t, nil
+}
+
+func (s *Server) multi(req *pb.MultiRequest, cells *cellReader) (*pb.MultiResponse, error) {
+	if req.Condition != nil {
+		return s.checkAndMutate(req, cells)
+	}
+	rsp := &pb.MultiResponse{RegionActionResult: make([]*pb.RegionActionResult,
+		len(req.RegionAction))}
+	for i, ra := range req.RegionAction {
+		rar := &pb.RegionActionResult{}
+		rsp.RegionActionResult[i] = rar
+		t, err := s.region(ra.Region)
+		if err != nil {
+			rar.Exception = exceptionToProto(err)
+			// skip cells of mutations of the region
+			for _, a := range ra.Action {
+				if _, err := cells.next(int(a.GetMutation().GetAssociatedCellCount())); err != nil {
+					return nil, err
+				}
+			}
+			continue
+		}
+		for _, a := range ra.Action {
+			roe := &pb.ResultOrException{Index: a.Index}
+			var r *pb.Result
+			if a.Mutation != nil {
+				r, err = t.mutate(a.Mutation, cells)
+			} else {
+				r, err = t.get(a.Get)
+			}
+			if err != nil {
+				roe.Exception = exceptionToProto(err)
+			} else {
+				roe.Result = r
+			}
+			rar.ResultOrException = append(rar.ResultOrException, roe)
+		}
+	}
+	return rsp, nil
+}
+
+// checkAndMutate performs the mutations of the atomic action of the region
+// of the row of the condition if it holds.
+func (s *Server) checkAndMutate(req *pb.MultiRequest,
+	cells *cellReader) (*pb.MultiResponse, error) {
+	if len(req.RegionAction) != 1 || !req.RegionAction[0].GetAtomic() {
+		return nil, errorf("testserver: conditional mutations must be a single atomic action")
+	}
+	ra := req.RegionAction[0]
+	t, err := s.region(ra.Region)
+	if err != nil {
+		return nil, err
+	}
+	ok, err := t.check(req.Condition)
+	if err != nil {
+		return nil, err
+	}
+	rar := &pb.RegionActionResult{}
+	rsp := &pb.MultiResponse{
+		RegionActionResult: []*pb.RegionActionResult{rar},
+		Processed:          proto.Bool(ok),
+	}
+	if !ok {
+		return rsp, nil
+	}
+	for _, a := range ra.Action {
+		if a.Mutation == nil || !bytes.Equal(a.Mutation.Row, req.Condition.Row) {
+			return nil, errorf("testserver: conditional actions must be mutations of the row")
+		}
+	}
+	for _, a := range ra.Action {
+		r, err := t.mutate(a.Mutation, cells)
+		if err != nil {
+			return nil, err
+		}
+		rar.ResultOrException = append(rar.ResultOrException,
+			&pb.ResultOrException{Index: a.Index, Result: r})
+	}
+	return rsp, nil
+}
+
+func exceptionToProto(err error) *pb.NameBytesPair {
+	class := doNotRetryIOException
+	var e *exception
+	if errors.As(err, &e) {
+		class = e.class
+	}
+	return &pb.NameBytesPair{Name: proto.String(class), Value: []byte(err.Error())}
+}
+
+// scanner is an open scanner that has results left to return.
+type scanner struct {
+	results []*pb.Result
+}
+
+func (s *Server) scan(req *pb.ScanRequest) (*pb.ScanResponse, error) {
+	var id uint64
+	var sc *scanner
+	if req.ScannerId != nil {
+		id = req.GetScannerId()
+		var ok bool
+		if sc, ok = s.scanners[id]; !ok && req.GetCloseScanner() {
+			// the scanner was closed once it ran out of results
+			return &pb.ScanResponse{ScannerId: &id, MoreResultsInRegion: proto.Bool(false)}, nil
+		} else if !ok {
+			return nil, &exception{
+				class: "org.apache.hadoop.hbase.UnknownScannerException",
+				msg:   fmt.Sprintf("testserver: unknown scanner %d", id),
+			}
+		}
+	} else {
+		results, err := s.open(req)
+		if err != nil {
+			return nil, err
+		}
+		s.nextScannerID++
+		id = s.nextScannerID
+		sc = &scanner{results: results}
+		s.scanners[id] = sc
+	}
+
+	n := len(sc.results)
+	if req.NumberOfRows != nil && int(req.GetNumberOfRows()) < n {
+		n = int(req.GetNumberOfRows())
+	}
+	rsp := &pb.ScanResponse{ScannerId: &id, Results: sc.results[:n]}
+	sc.results = sc.results[n:]
+	more := len(sc.results) > 0 && !req.GetCloseScanner()
+	if !more {
+		delete(s.scanners, id)
+	}
+	rsp.MoreResultsInRegion = proto.Bool(more)
+	return rsp, nil
+}
+
+// open returns all the results of a new scan.
+func (s *Server) open(req *pb.ScanRequest) ([]*pb.Result, error) {
+	if string(req.GetRegion().GetValue()) == metaRegionName {
+		return s.lookupRegion(req.Scan)
+	}
+	t, err := s.region(req.Region)
+	if err != nil {
+		return nil, err
+	}
+	return t.scan(req.Scan)
+}
+
+// lookupRegion returns the row of hbase:meta with the region that contains
+// the key of the table the scan looks up.
+func (s *Server) lookupRegion(scan *pb.Scan) ([]*pb.Result, error) {
+	if !scan.GetReversed() {
+		return nil, errorf("testserver: only lookups of regions in %s are supported",
+			metaTableName)
+	}
+	// the start row is table,key,:
+	i := strings.IndexByte(string(scan.StartRow), ',')
+	if i < 0 {
+		return nil, errorf("testserver: unexpected start row of %s lookup %q",
+			metaTableName, scan.StartRow)
+	}
+	t, ok := s.tables[string(scan.StartRow[:i])]
+	if !ok {
+		return nil, nil
+	}
+	return []*pb.Result{t.metaRow(s.addr)}, nil
+}
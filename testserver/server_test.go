@@ -0,0 +1,508 @@
+// Copyright (C) 2026  The GoHBase Authors.  All rights reserved.
+// This file is part of GoHBase.
+// Use of this source code is governed by the Apache License 2.0
+// that can be found in the COPYING file.
+
+package testserver_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"testing"
+
+	"github.com/baiweiguo/gohbase"
+	"github.com/baiweiguo/gohbase/filter"
+	"github.com/baiweiguo/gohbase/hrpc"
+	"github.com/baiweiguo/gohbase/pb"
+	"github.com/baiweiguo/gohbase/testserver"
+)
+
+const table = "test"
+
+func newClient(t *testing.T) gohbase.Client {
+	srv := testserver.New()
+	srv.CreateTable(table)
+	c := gohbase.NewClient("",
+		gohbase.ZookeeperClient(srv),
+		gohbase.Dialer(srv.Dial))
+	t.Cleanup(func() {
+		c.Close()
+		srv.Close()
+	})
+	return c
+}
+
+func put(t *testing.T, c gohbase.Client, key string, values map[string]map[string][]byte,
+	options ...func(hrpc.Call) error) {
+	t.Helper()
+	p, err := hrpc.NewPutStr(context.Background(), table, key, values, options...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.Put(p); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// get returns the cells of the row formatted as family:qualifier=value.
+func get(t *testing.T, c gohbase.Client, key string, options ...func(hrpc.Call) error) []string {
+	t.Helper()
+	g, err := hrpc.NewGetStr(context.Background(), table, key, options...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := c.Get(g)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return format(res)
+}
+
+func format(res *hrpc.Result) []string {
+	var cells []string
+	for _, cell := range res.Cells {
+		cells = append(cells,
+			fmt.Sprintf("%s:%s=%s", cell.Family, cell.Qualifier, cell.Value))
+	}
+	return cells
+}
+
+// scan returns the rows of the scan formatted as row:cells.
+func scan(t *testing.T, c gohbase.Client, start, stop string,
+	options ...func(hrpc.Call) error) []string {
+	t.Helper()
+	s, err := hrpc.NewScanRangeStr(context.Background(), table, start, stop, options...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	scanner := c.Scan(s)
+	defer scanner.Close()
+	var rows []string
+	for {
+		res, err := scanner.Next()
+		if err == io.EOF {
+			return rows
+		} else if err != nil {
+			t.Fatal(err)
+		}
+		rows = append(rows, fmt.Sprintf("%s:%v", res.Cells[0].Row, format(res)))
+	}
+}
+
+func TestPutGet(t *testing.T) {
+	c := newClient(t)
+	put(t, c, "row", map[string]map[string][]byte{
+		"cf":  {"b": []byte("2"), "a": []byte("1")},
+		"cf2": {"a": []byte("3")},
+	})
+
+	for _, tcase := range []struct {
+		options  []func(hrpc.Call) error
+		expected []string
+	}{
+		{expected: []string{"cf:a=1", "cf:b=2", "cf2:a=3"}},
+		{
+			options:  []func(hrpc.Call) error{hrpc.Families(map[string][]string{"cf2": nil})},
+			expected: []string{"cf2:a=3"},
+		},
+		{
+			options: []func(hrpc.Call) error{
+				hrpc.Families(map[string][]string{"cf": {"b"}, "cf2": nil}),
+			},
+			expected: []string{"cf:b=2", "cf2:a=3"},
+		},
+		{
+			options: []func(hrpc.Call) error{
+				hrpc.MaxResultsPerColumnFamily(1), hrpc.ResultOffset(1)},
+			expected: []string{"cf:b=2"},
+		},
+	} {
+		if got := get(t, c, "row", tcase.options...); !reflect.DeepEqual(tcase.expected, got) {
+			t.Errorf("expected %v, got %v", tcase.expected, got)
+		}
+	}
+
+	if got := get(t, c, "missing"); got != nil {
+		t.Errorf("expected no cells for missing row, got %v", got)
+	}
+
+	g, err := hrpc.NewGetStr(context.Background(), table, "row", hrpc.ExistsOnly(true))
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := c.Get(g)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Exists == nil || !*res.Exists {
+		t.Errorf("expected row to exist")
+	}
+}
+
+func TestGetPartial(t *testing.T) {
+	c := newClient(t)
+	values := make(map[string][]byte)
+	for i := 0; i < 10; i++ {
+		values[fmt.Sprintf("q%d", i)] = []byte("v")
+	}
+	put(t, c, "row", map[string]map[string][]byte{"cf": values})
+
+	// page through the row 3 cells at a time, the last page has a single cell
+	var cells int
+	for page := uint32(0); page < 4; page++ {
+		g, err := hrpc.NewGetStr(context.Background(), table, "row",
+			hrpc.MaxResultsPerColumnFamily(3), hrpc.ResultOffset(page*3))
+		if err != nil {
+			t.Fatal(err)
+		}
+		res, err := c.Get(g)
+		if err != nil {
+			t.Fatal(err)
+		}
+		cells += len(res.Cells)
+		if partial := page < 3; res.Partial != partial {
+			t.Errorf("expected partial %v for page %d, got %v", partial, page, res.Partial)
+		}
+	}
+	if cells != len(values) {
+		t.Errorf("expected %d cells, got %d", len(values), cells)
+	}
+}
+
+func TestVersions(t *testing.T) {
+	c := newClient(t)
+	for ts := uint64(1); ts <= 3; ts++ {
+		put(t, c, "row", map[string]map[string][]byte{
+			"cf": {"a": []byte(fmt.Sprint(ts))},
+		}, hrpc.TimestampUint64(ts))
+	}
+
+	for _, tcase := range []struct {
+		options  []func(hrpc.Call) error
+		expected []string
+	}{
+		{expected: []string{"cf:a=3"}},
+		{
+			options:  []func(hrpc.Call) error{hrpc.MaxVersions(2)},
+			expected: []string{"cf:a=3", "cf:a=2"},
+		},
+		{
+			options:  []func(hrpc.Call) error{hrpc.TimeRangeUint64(1, 3), hrpc.MaxVersions(5)},
+			expected: []string{"cf:a=2", "cf:a=1"},
+		},
+		{
+			options: []func(hrpc.Call) error{
+				hrpc.ColumnFamilyTimeRangeUint64("cf", 1, 2),
+			},
+			expected: []string{"cf:a=1"},
+		},
+	} {
+		if got := get(t, c, "row", tcase.options...); !reflect.DeepEqual(tcase.expected, got) {
+			t.Errorf("expected %v, got %v", tcase.expected, got)
+		}
+	}
+}
+
+func TestDelete(t *testing.T) {
+	c := newClient(t)
+	values := map[string]map[string][]byte{
+		"cf":  {"a": []byte("1"), "b": []byte("2")},
+		"cf2": {"a": []byte("3")},
+	}
+	del := func(key string, values map[string]map[string][]byte,
+		options ...func(hrpc.Call) error) {
+		d, err := hrpc.NewDelStr(context.Background(), table, key, values, options...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := c.Delete(d); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	put(t, c, "row", values)
+	del("row", map[string]map[string][]byte{"cf": {"a": nil}})
+	if expected, got := []string{"cf:b=2", "cf2:a=3"}, get(t, c, "row"); !reflect.DeepEqual(
+		expected, got) {
+		t.Errorf("expected %v after deleting column, got %v", expected, got)
+	}
+
+	del("row", map[string]map[string][]byte{"cf": nil})
+	if expected, got := []string{"cf2:a=3"}, get(t, c, "row"); !reflect.DeepEqual(
+		expected, got) {
+		t.Errorf("expected %v after deleting family, got %v", expected, got)
+	}
+
+	put(t, c, "row", values)
+	del("row", nil, hrpc.DeleteMarkers(
+		hrpc.DeleteColumnVersionMarker("cf", "a", hrpc.MaxTimestamp),
+		hrpc.DeleteFamilyMarker("cf2", hrpc.MaxTimestamp)))
+	if expected, got := []string{"cf:b=2"}, get(t, c, "row"); !reflect.DeepEqual(
+		expected, got) {
+		t.Errorf("expected %v after deleting with markers, got %v", expected, got)
+	}
+
+	put(t, c, "row", values)
+	del("row", nil)
+	if got := get(t, c, "row"); got != nil {
+		t.Errorf("expected no cells after deleting row, got %v", got)
+	}
+}
+
+func TestIncrementAppend(t *testing.T) {
+	c := newClient(t)
+	for i := int64(1); i <= 3; i++ {
+		inc, err := hrpc.NewIncStrSingle(context.Background(), table, "row", "cf", "n", 2)
+		if err != nil {
+			t.Fatal(err)
+		}
+		v, err := c.Increment(inc)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if v != 2*i {
+			t.Errorf("expected %d, got %d", 2*i, v)
+		}
+	}
+
+	for _, s := range []string{"foo", "bar"} {
+		app, err := hrpc.NewAppStr(context.Background(), table, "row",
+			map[string]map[string][]byte{"cf": {"s": []byte(s)}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := c.Append(app); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if expected, got := []string{"cf:s=foobar"}, get(t, c, "row",
+		hrpc.Families(map[string][]string{"cf": {"s"}})); !reflect.DeepEqual(expected, got) {
+		t.Errorf("expected %v, got %v", expected, got)
+	}
+}
+
+func TestScan(t *testing.T) {
+	c := newClient(t)
+	for _, key := range []string{"c", "a", "d", "b"} {
+		put(t, c, key, map[string]map[string][]byte{"cf": {"q": []byte(key)}})
+	}
+
+	for _, tcase := range []struct {
+		start, stop string
+		options     []func(hrpc.Call) error
+		expected    []string
+	}{
+		{expected: []string{"a:[cf:q=a]", "b:[cf:q=b]", "c:[cf:q=c]", "d:[cf:q=d]"}},
+		{start: "b", stop: "d", expected: []string{"b:[cf:q=b]", "c:[cf:q=c]"}},
+		{
+			// fetch the rows one by one
+			options:  []func(hrpc.Call) error{hrpc.NumberOfRows(1)},
+			expected: []string{"a:[cf:q=a]", "b:[cf:q=b]", "c:[cf:q=c]", "d:[cf:q=d]"},
+		},
+		{
+			start:    "c",
+			stop:     "a",
+			options:  []func(hrpc.Call) error{hrpc.Reversed()},
+			expected: []string{"c:[cf:q=c]", "b:[cf:q=b]"},
+		},
+		{
+			options:  []func(hrpc.Call) error{hrpc.Families(map[string][]string{"cf2": nil})},
+			expected: nil,
+		},
+	} {
+		got := scan(t, c, tcase.start, tcase.stop, tcase.options...)
+		if !reflect.DeepEqual(tcase.expected, got) {
+			t.Errorf("expected %v, got %v", tcase.expected, got)
+		}
+	}
+}
+
+func TestSendBatch(t *testing.T) {
+	c := newClient(t)
+	var batch []hrpc.Call
+	for _, key := range []string{"a", "b"} {
+		p, err := hrpc.NewPutStr(context.Background(), table, key,
+			map[string]map[string][]byte{"cf": {"q": []byte(key)}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		batch = append(batch, p)
+	}
+	g, err := hrpc.NewGetStr(context.Background(), table, "a")
+	if err != nil {
+		t.Fatal(err)
+	}
+	batch = append(batch, g)
+
+	res, ok := c.SendBatch(context.Background(), batch)
+	if !ok {
+		t.Fatalf("expected batch to succeed, got %v", res)
+	}
+	got := format(hrpc.ToLocalResult(res[2].Msg.(*pb.GetResponse).Result))
+	if expected := []string{"cf:q=a"}; !reflect.DeepEqual(expected, got) {
+		t.Errorf("expected %v, got %v", expected, got)
+	}
+}
+
+func TestErrors(t *testing.T) {
+	c := newClient(t)
+
+	g, err := hrpc.NewGetStr(context.Background(), "missing", "row")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.Get(g); !errors.Is(err, gohbase.TableNotFound) {
+		t.Errorf("expected %v for missing table, got %v", gohbase.TableNotFound, err)
+	}
+
+	g, err = hrpc.NewGetStr(context.Background(), table, "row",
+		hrpc.Filters(filter.NewFirstKeyOnlyFilter()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.Get(g); err == nil {
+		t.Error("expected filters not to be supported")
+	}
+}
+
+func TestCheckAndPutCompare(t *testing.T) {
+	c := newClient(t)
+	put(t, c, "row", map[string]map[string][]byte{"cf": {"a": []byte("5")}})
+
+	for _, tcase := range []struct {
+		name       string
+		op         filter.CompareType
+		comparator filter.Comparator
+		values     map[string][]byte
+		processed  bool
+		exp        []string
+	}{{
+		name: "greater than cell",
+		op:   filter.Greater,
+		comparator: filter.NewBinaryComparator(
+			filter.NewByteArrayComparable([]byte("4"))),
+		values: map[string][]byte{"a": []byte("4")},
+		exp:    []string{"cf:a=5"},
+	}, {
+		name: "less than cell",
+		op:   filter.Greater,
+		comparator: filter.NewBinaryComparator(
+			filter.NewByteArrayComparable([]byte("6"))),
+		values:    map[string][]byte{"a": []byte("6")},
+		processed: true,
+		exp:       []string{"cf:a=6"},
+	}, {
+		name:       "regex matches",
+		op:         filter.Equal,
+		comparator: filter.NewRegexStringComparator("^[0-9]+$", 0, "UTF-8", ""),
+		values:     map[string][]byte{"b": []byte("digits")},
+		processed:  true,
+		exp:        []string{"cf:a=6", "cf:b=digits"},
+	}, {
+		name:       "regex doesn't match",
+		op:         filter.Equal,
+		comparator: filter.NewRegexStringComparator("^[a-z]+$", 0, "UTF-8", ""),
+		values:     map[string][]byte{"b": []byte("letters")},
+		exp:        []string{"cf:a=6", "cf:b=digits"},
+	}, {
+		name:       "regex doesn't match, not equal",
+		op:         filter.NotEqual,
+		comparator: filter.NewRegexStringComparator("^[a-z]+$", 0, "UTF-8", ""),
+		values:     map[string][]byte{"b": []byte("not letters")},
+		processed:  true,
+		exp:        []string{"cf:a=6", "cf:b=not letters"},
+	}} {
+		t.Run(tcase.name, func(t *testing.T) {
+			p, err := hrpc.NewPutStr(context.Background(), table, "row",
+				map[string]map[string][]byte{"cf": tcase.values})
+			if err != nil {
+				t.Fatal(err)
+			}
+			processed, err := c.(gohbase.CheckAndMutateClient).CheckAndPutCompare(
+				p, "cf", "a", tcase.op, tcase.comparator)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if processed != tcase.processed {
+				t.Errorf("expected processed %v, got %v", tcase.processed, processed)
+			}
+			if got := get(t, c, "row"); !reflect.DeepEqual(tcase.exp, got) {
+				t.Errorf("expected %v, got %v", tcase.exp, got)
+			}
+		})
+	}
+
+	// the put is performed if the cell is missing and the expected value is empty
+	p, err := hrpc.NewPutStr(context.Background(), table, "new",
+		map[string]map[string][]byte{"cf": {"a": []byte("1")}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if processed, err := c.CheckAndPut(p, "cf", "a", nil); err != nil || !processed {
+		t.Errorf("expected put of missing cell to be processed, got %v, %v", processed, err)
+	}
+}
+
+func TestCheckAndMutate(t *testing.T) {
+	c := newClient(t)
+	cm := c.(gohbase.CheckAndMutateClient)
+	put(t, c, "row", map[string]map[string][]byte{"cf": {"a": []byte("1"), "b": []byte("x")}})
+
+	mutations := func(value string) []*hrpc.Mutate {
+		p, err := hrpc.NewPutStr(context.Background(), table, "row",
+			map[string]map[string][]byte{"cf": {"a": []byte(value)}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		d, err := hrpc.NewDelStr(context.Background(), table, "row",
+			map[string]map[string][]byte{"cf": {"b": nil}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return []*hrpc.Mutate{p, d}
+	}
+	equal := func(value string) filter.Comparator {
+		return filter.NewBinaryComparator(filter.NewByteArrayComparable([]byte(value)))
+	}
+
+	// the condition doesn't hold: neither the put nor the delete is performed
+	processed, err := cm.CheckAndMutate(mutations("2"), "cf", "a", filter.Equal, equal("0"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if processed {
+		t.Error("expected the mutations not to be processed")
+	}
+	if exp, got := []string{"cf:a=1", "cf:b=x"}, get(t, c, "row"); !reflect.DeepEqual(exp, got) {
+		t.Errorf("expected %v, got %v", exp, got)
+	}
+
+	// the condition holds: both the put and the delete are performed
+	processed, err = cm.CheckAndMutate(mutations("2"), "cf", "a", filter.Equal, equal("1"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !processed {
+		t.Error("expected the mutations to be processed")
+	}
+	if exp, got := []string{"cf:a=2"}, get(t, c, "row"); !reflect.DeepEqual(exp, got) {
+		t.Errorf("expected %v, got %v", exp, got)
+	}
+
+	// mutations of another row are rejected
+	other, err := hrpc.NewPutStr(context.Background(), table, "other",
+		map[string]map[string][]byte{"cf": {"a": []byte("3")}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := cm.CheckAndMutate(append(mutations("3"), other), "cf", "a",
+		filter.Equal, equal("2")); err == nil {
+		t.Error("expected mutations of different rows to be rejected")
+	}
+	if exp, got := []string{"cf:a=2"}, get(t, c, "row"); !reflect.DeepEqual(exp, got) {
+		t.Errorf("expected %v, got %v", exp, got)
+	}
+}
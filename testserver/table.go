@@ -0,0 +1,608 @@
+// Copyright (C) 2026  The GoHBase Authors.  All rights reserved.
+// This file is part of GoHBase.
+// Use of this source code is governed by the Apache License 2.0
+// that can be found in the COPYING file.
+
+package testserver
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/baiweiguo/gohbase/pb"
+	"google.golang.org/protobuf/proto"
+)
+
+// latestTimestamp is HBase's LATEST_TIMESTAMP, it's replaced
+// with the current time when the cell is written.
+const latestTimestamp = math.MaxInt64
+
+// types of cells in cellblocks
+const (
+	putType                 = 4
+	deleteType              = 8
+	deleteFamilyVersionType = 10
+	deleteColumnType        = 12
+	deleteFamilyType        = 14
+)
+
+// table is a table served as a single region.
+type table struct {
+	name       string
+	regionName string
+	regionID   uint64
+
+	rows map[string]row
+}
+
+// row maps families to qualifiers to versions of the cell.
+type row map[string]map[string][]version
+
+// version is a version of a cell. Versions are ordered from the newest.
+type version struct {
+	ts    uint64
+	value []byte
+}
+
+func newTable(name, regionName string, regionID uint64) *table {
+	return &table{
+		name:       name,
+		regionName: regionName,
+		regionID:   regionID,
+		rows:       make(map[string]row),
+	}
+}
+
+// metaRow returns the row of hbase:meta describing the region of the table.
+func (t *table) metaRow(addr string) *pb.Result {
+	namespace, qualifier := "default", t.name
+	if i := strings.IndexByte(t.name, ':'); i >= 0 {
+		namespace, qualifier = t.name[:i], t.name[i+1:]
+	}
+	info, err := proto.Marshal(&pb.RegionInfo{
+		RegionId: proto.Uint64(t.regionID),
+		TableName: &pb.TableName{
+			Namespace: []byte(namespace),
+			Qualifier: []byte(qualifier),
+		},
+	})
+	if err != nil {
+		panic(err)
+	}
+	return &pb.Result{Cell: []*pb.Cell{
+		{
+			Row:       []byte(t.regionName),
+			Family:    []byte("info"),
+			Qualifier: []byte("regioninfo"),
+			Value:     append([]byte("PBUF"), info...),
+			CellType:  pb.CellType_PUT.Enum(),
+		},
+		{
+			Row:       []byte(t.regionName),
+			Family:    []byte("info"),
+			Qualifier: []byte("server"),
+			Value:     []byte(addr),
+			CellType:  pb.CellType_PUT.Enum(),
+		},
+	}}
+}
+
+// query are the parameters of a Get or Scan selecting cells of a row.
+type query struct {
+	columns      []*pb.Column
+	timeRange    *pb.TimeRange
+	cfTimeRanges []*pb.ColumnFamilyTimeRange
+	maxVersions  uint32
+	storeLimit   *uint32
+	storeOffset  uint32
+}
+
+func (t *table) get(g *pb.Get) (*pb.Result, error) {
+	if g.Filter != nil {
+		return nil, errorf("testserver: filters are not supported")
+	}
+	cells, more := t.rows[string(g.Row)].cells(g.Row, query{
+		columns:      g.Column,
+		timeRange:    g.TimeRange,
+		cfTimeRanges: g.CfTimeRange,
+		maxVersions:  g.GetMaxVersions(),
+		storeLimit:   g.StoreLimit,
+		storeOffset:  g.GetStoreOffset(),
+	})
+	if g.GetExistenceOnly() {
+		return &pb.Result{Exists: proto.Bool(len(cells) > 0)}, nil
+	}
+	r := &pb.Result{Cell: cells}
+	if more {
+		// like HBase, tell the row may have more cells than the store limit
+		r.Partial = proto.Bool(true)
+	}
+	return r, nil
+}
+
+func (t *table) scan(s *pb.Scan) ([]*pb.Result, error) {
+	if s.Filter != nil {
+		return nil, errorf("testserver: filters are not supported")
+	}
+	keys := make([]string, 0, len(t.rows))
+	for key := range t.rows {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	if s.GetReversed() {
+		for i, j := 0, len(keys)-1; i < j; i, j = i+1, j-1 {
+			keys[i], keys[j] = keys[j], keys[i]
+		}
+	}
+
+	q := query{
+		columns:      s.Column,
+		timeRange:    s.TimeRange,
+		cfTimeRanges: s.CfTimeRange,
+		maxVersions:  s.GetMaxVersions(),
+		storeLimit:   s.StoreLimit,
+		storeOffset:  s.GetStoreOffset(),
+	}
+	var results []*pb.Result
+	for _, key := range keys {
+		k := []byte(key)
+		if !inScan(s, k) {
+			continue
+		}
+		if cells, _ := t.rows[key].cells(k, q); len(cells) > 0 {
+			results = append(results, &pb.Result{Cell: cells})
+		}
+	}
+	return results, nil
+}
+
+// inScan returns true if the key is in the range of rows of the scan.
+func inScan(s *pb.Scan, key []byte) bool {
+	start, stop := s.StartRow, s.StopRow
+	if s.GetReversed() {
+		// the start row is inclusive upper bound
+		// and the stop row is exclusive lower bound
+		return (len(start) == 0 || bytes.Compare(key, start) <= 0) &&
+			(len(stop) == 0 || bytes.Compare(key, stop) > 0)
+	}
+	return bytes.Compare(key, start) >= 0 &&
+		(len(stop) == 0 || bytes.Compare(key, stop) < 0)
+}
+
+// cells returns the cells of the row selected by the query, sorted by
+// family, qualifier and from the newest version, and whether cells of
+// a family were left out because of the store limit.
+func (r row) cells(key []byte, q query) ([]*pb.Cell, bool) {
+	if len(r) == 0 {
+		return nil, false
+	}
+	// selected qualifiers by family, nil selects all the qualifiers
+	selected := make(map[string]map[string]bool, len(q.columns))
+	for _, col := range q.columns {
+		var quals map[string]bool
+		if len(col.Qualifier) > 0 {
+			quals = make(map[string]bool, len(col.Qualifier))
+			for _, qual := range col.Qualifier {
+				quals[string(qual)] = true
+			}
+		}
+		selected[string(col.Family)] = quals
+	}
+
+	var (
+		cells []*pb.Cell
+		more  bool
+	)
+	for _, family := range sortedKeys(r) {
+		quals, ok := selected[family]
+		if len(selected) > 0 && !ok {
+			continue
+		}
+		tr := q.timeRange
+		for _, cftr := range q.cfTimeRanges {
+			if string(cftr.ColumnFamily) == family {
+				tr = cftr.TimeRange
+			}
+		}
+
+		// number of cells of the family selected so far, including the skipped ones
+		var selectedCells uint32
+	qualifiers:
+		for _, qualifier := range sortedKeys(r[family]) {
+			if quals != nil && !quals[qualifier] {
+				continue
+			}
+			var n uint32
+			for _, v := range r[family][qualifier] {
+				if n == q.maxVersions {
+					break
+				}
+				if !inTimeRange(tr, v.ts) {
+					continue
+				}
+				n++
+				selectedCells++
+				if selectedCells <= q.storeOffset {
+					continue
+				}
+				if q.storeLimit != nil && selectedCells-q.storeOffset > *q.storeLimit {
+					more = true
+					break qualifiers
+				}
+				cells = append(cells, &pb.Cell{
+					Row:       key,
+					Family:    []byte(family),
+					Qualifier: []byte(qualifier),
+					Timestamp: proto.Uint64(v.ts),
+					CellType:  pb.CellType_PUT.Enum(),
+					Value:     v.value,
+				})
+			}
+		}
+	}
+	return cells, more
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// inTimeRange returns true if the timestamp is in [from, to[ of the time range.
+func inTimeRange(tr *pb.TimeRange, ts uint64) bool {
+	if ts < tr.GetFrom() {
+		return false
+	}
+	return tr.GetTo() == 0 || ts < tr.GetTo()
+}
+
+func now() uint64 {
+	return uint64(time.Now().UnixNano() / 1e6)
+}
+
+// mutate applies the mutation to the table. The cells of the mutation are
+// read from the cellblocks if it has any associated with it.
+func (t *table) mutate(mut *pb.MutationProto, cr *cellReader) (*pb.Result, error) {
+	cells, err := mutationCells(mut, cr)
+	if err != nil {
+		return nil, err
+	}
+	key := string(mut.Row)
+	r := t.rows[key]
+	if r == nil {
+		r = make(row)
+		t.rows[key] = r
+	}
+	defer func() {
+		if len(r) == 0 {
+			delete(t.rows, key)
+		}
+	}()
+
+	var result []*pb.Cell
+	switch mut.GetMutateType() {
+	case pb.MutationProto_PUT:
+		for _, c := range cells {
+			ts := c.GetTimestamp()
+			if ts == latestTimestamp {
+				ts = now()
+			}
+			r.put(string(c.Family), string(c.Qualifier), ts, c.Value)
+		}
+	case pb.MutationProto_DELETE:
+		if len(cells) == 0 {
+			ts := uint64(latestTimestamp)
+			if mut.Timestamp != nil {
+				ts = mut.GetTimestamp()
+			}
+			for family := range r {
+				r.deleteFamily(family, func(v uint64) bool { return v <= ts })
+			}
+		}
+		for _, c := range cells {
+			r.delete(c)
+		}
+	case pb.MutationProto_INCREMENT, pb.MutationProto_APPEND:
+		ts := now()
+		for _, c := range cells {
+			family, qualifier := string(c.Family), string(c.Qualifier)
+			var value []byte
+			if vs := r[family][qualifier]; len(vs) > 0 {
+				value = vs[0].value
+			}
+			if mut.GetMutateType() == pb.MutationProto_APPEND {
+				value = append(append([]byte(nil), value...), c.Value...)
+			} else {
+				if len(c.Value) != 8 || len(value) != 0 && len(value) != 8 {
+					return nil, errorf(
+						"testserver: attempted to increment field that isn't 64 bits wide")
+				}
+				var sum uint64
+				if len(value) == 8 {
+					sum = binary.BigEndian.Uint64(value)
+				}
+				value = make([]byte, 8)
+				binary.BigEndian.PutUint64(value, sum+binary.BigEndian.Uint64(c.Value))
+			}
+			r.put(family, qualifier, ts, value)
+			result = append(result, &pb.Cell{
+				Row:       mut.Row,
+				Family:    c.Family,
+				Qualifier: c.Qualifier,
+				Timestamp: proto.Uint64(ts),
+				CellType:  pb.CellType_PUT.Enum(),
+				Value:     value,
+			})
+		}
+	default:
+		return nil, errorf("testserver: mutation %s is not supported", mut.GetMutateType())
+	}
+	return &pb.Result{Cell: result}, nil
+}
+
+// check returns whether the condition of a conditional mutation holds.
+// Like in HBase, the value of the comparator is on the left side of the
+// comparison and an empty value matches a missing or empty cell.
+func (t *table) check(c *pb.Condition) (bool, error) {
+	compare, empty, err := comparator(c.Comparator)
+	if err != nil {
+		return false, err
+	}
+	var value []byte
+	if vs := t.rows[string(c.Row)][string(c.Family)][string(c.Qualifier)]; len(vs) > 0 {
+		value = vs[0].value
+	} else if !empty {
+		return false, nil
+	}
+	if empty && len(value) == 0 {
+		return true, nil
+	}
+	res := compare(value)
+	switch c.GetCompareType() {
+	case pb.CompareType_LESS:
+		return res < 0, nil
+	case pb.CompareType_LESS_OR_EQUAL:
+		return res <= 0, nil
+	case pb.CompareType_EQUAL:
+		return res == 0, nil
+	case pb.CompareType_NOT_EQUAL:
+		return res != 0, nil
+	case pb.CompareType_GREATER_OR_EQUAL:
+		return res >= 0, nil
+	case pb.CompareType_GREATER:
+		return res > 0, nil
+	default:
+		return false, nil
+	}
+}
+
+// comparator returns a function comparing the comparator to a value,
+// and whether the value of the comparator is empty. Comparators that don't
+// order values return 0 if the value matches and 1 otherwise.
+func comparator(c *pb.Comparator) (func(value []byte) int, bool, error) {
+	switch strings.TrimPrefix(c.GetName(), "org.apache.hadoop.hbase.filter.") {
+	case "BinaryComparator":
+		var bc pb.BinaryComparator
+		if err := proto.Unmarshal(c.SerializedComparator, &bc); err != nil {
+			return nil, false, err
+		}
+		cv := bc.GetComparable().GetValue()
+		return func(value []byte) int {
+			return bytes.Compare(cv, value)
+		}, len(cv) == 0, nil
+	case "RegexStringComparator":
+		var rc pb.RegexStringComparator
+		if err := proto.Unmarshal(c.SerializedComparator, &rc); err != nil {
+			return nil, false, err
+		}
+		pattern := rc.GetPattern()
+		// java.util.regex.Pattern.CASE_INSENSITIVE
+		if rc.GetPatternFlags()&2 != 0 {
+			pattern = "(?i)" + pattern
+		}
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, false, err
+		}
+		return func(value []byte) int {
+			if re.Match(value) {
+				return 0
+			}
+			return 1
+		}, len(rc.GetPattern()) == 0, nil
+	case "SubstringComparator":
+		var sc pb.SubstringComparator
+		if err := proto.Unmarshal(c.SerializedComparator, &sc); err != nil {
+			return nil, false, err
+		}
+		substr := strings.ToLower(sc.GetSubstr())
+		return func(value []byte) int {
+			if strings.Contains(strings.ToLower(string(value)), substr) {
+				return 0
+			}
+			return 1
+		}, len(substr) == 0, nil
+	default:
+		return nil, false, errorf("testserver: comparator %s is not supported", c.GetName())
+	}
+}
+
+// mutationCells returns the cells of the mutation, either from the cellblocks
+// or converted from the values of the mutation.
+func mutationCells(mut *pb.MutationProto, cr *cellReader) ([]*pb.Cell, error) {
+	if mut.AssociatedCellCount != nil {
+		return cr.next(int(mut.GetAssociatedCellCount()))
+	}
+	var cells []*pb.Cell
+	for _, cv := range mut.ColumnValue {
+		for _, qv := range cv.QualifierValue {
+			ts := qv.Timestamp
+			if ts == nil {
+				ts = mut.Timestamp
+			}
+			if ts == nil {
+				ts = proto.Uint64(latestTimestamp)
+			}
+			typ := pb.CellType(putType)
+			if mut.GetMutateType() == pb.MutationProto_DELETE {
+				switch qv.GetDeleteType() {
+				case pb.MutationProto_DELETE_ONE_VERSION:
+					typ = deleteType
+				case pb.MutationProto_DELETE_MULTIPLE_VERSIONS:
+					typ = deleteColumnType
+				case pb.MutationProto_DELETE_FAMILY:
+					typ = deleteFamilyType
+				case pb.MutationProto_DELETE_FAMILY_VERSION:
+					typ = deleteFamilyVersionType
+				}
+			}
+			cells = append(cells, &pb.Cell{
+				Row:       mut.Row,
+				Family:    cv.Family,
+				Qualifier: qv.Qualifier,
+				Timestamp: ts,
+				CellType:  &typ,
+				Value:     qv.Value,
+			})
+		}
+	}
+	return cells, nil
+}
+
+// put writes a version of the cell, overwriting the version with the same timestamp.
+func (r row) put(family, qualifier string, ts uint64, value []byte) {
+	qualifiers := r[family]
+	if qualifiers == nil {
+		qualifiers = make(map[string][]version)
+		r[family] = qualifiers
+	}
+	vs := qualifiers[qualifier]
+	i := sort.Search(len(vs), func(i int) bool { return vs[i].ts <= ts })
+	if i < len(vs) && vs[i].ts == ts {
+		vs[i].value = value
+		return
+	}
+	vs = append(vs, version{})
+	copy(vs[i+1:], vs[i:])
+	vs[i] = version{ts: ts, value: value}
+	qualifiers[qualifier] = vs
+}
+
+// delete deletes the versions the delete marker applies to.
+func (r row) delete(c *pb.Cell) {
+	family, qualifier := string(c.Family), string(c.Qualifier)
+	ts := c.GetTimestamp()
+	switch c.GetCellType() {
+	case deleteType:
+		if ts == latestTimestamp {
+			// delete the latest version
+			if vs := r[family][qualifier]; len(vs) > 0 {
+				ts = vs[0].ts
+			}
+		}
+		r.deleteColumn(family, qualifier, func(v uint64) bool { return v == ts })
+	case deleteColumnType:
+		r.deleteColumn(family, qualifier, func(v uint64) bool { return v <= ts })
+	case deleteFamilyType:
+		r.deleteFamily(family, func(v uint64) bool { return v <= ts })
+	case deleteFamilyVersionType:
+		r.deleteFamily(family, func(v uint64) bool { return v == ts })
+	}
+}
+
+func (r row) deleteFamily(family string, match func(ts uint64) bool) {
+	for qualifier := range r[family] {
+		r.deleteColumn(family, qualifier, match)
+	}
+}
+
+// deleteColumn deletes the versions of the cell with timestamps matching.
+func (r row) deleteColumn(family, qualifier string, match func(ts uint64) bool) {
+	qualifiers := r[family]
+	vs := qualifiers[qualifier]
+	kept := vs[:0]
+	for _, v := range vs {
+		if !match(v.ts) {
+			kept = append(kept, v)
+		}
+	}
+	if len(kept) > 0 {
+		qualifiers[qualifier] = kept
+		return
+	}
+	delete(qualifiers, qualifier)
+	if len(qualifiers) == 0 {
+		delete(r, family)
+	}
+}
+
+// cellReader reads cells from cellblocks encoded with KeyValueCodec.
+type cellReader struct {
+	b []byte
+}
+
+// next reads the next n cells.
+func (cr *cellReader) next(n int) ([]*pb.Cell, error) {
+	cells := make([]*pb.Cell, 0, n)
+	for i := 0; i < n; i++ {
+		c, err := cr.readCell()
+		if err != nil {
+			return nil, err
+		}
+		cells = append(cells, c)
+	}
+	return cells, nil
+}
+
+// readCell reads a cell laid out as:
+//
+//	4 byte length of the cell
+//	4 byte length of the key
+//	4 byte length of the value
+//	2 byte length of the row, row,
+//	1 byte length of the family, family,
+//	qualifier, 8 byte timestamp, 1 byte type
+//	value
+func (cr *cellReader) readCell() (*pb.Cell, error) {
+	b := cr.b
+	if len(b) < 12 {
+		return nil, errorf("testserver: short cellblocks")
+	}
+	length := binary.BigEndian.Uint32(b)
+	keyLength := binary.BigEndian.Uint32(b[4:])
+	valueLength := binary.BigEndian.Uint32(b[8:])
+	if uint64(len(b)) < 4+uint64(length) || length != 8+keyLength+valueLength ||
+		keyLength < 2+1+8+1 {
+		return nil, errorf("testserver: invalid cell in cellblocks")
+	}
+	key := b[12 : 12+keyLength]
+	rowLength := uint32(binary.BigEndian.Uint16(key))
+	if 2+rowLength+1 > keyLength {
+		return nil, errorf("testserver: invalid cell in cellblocks")
+	}
+	familyLength := uint32(key[2+rowLength])
+	qualifierStart := 2 + rowLength + 1 + familyLength
+	if qualifierStart+8+1 > keyLength {
+		return nil, errorf("testserver: invalid cell in cellblocks")
+	}
+	typ := pb.CellType(key[keyLength-1])
+	c := &pb.Cell{
+		Row:       key[2 : 2+rowLength],
+		Family:    key[2+rowLength+1 : qualifierStart],
+		Qualifier: key[qualifierStart : keyLength-9],
+		Timestamp: proto.Uint64(binary.BigEndian.Uint64(key[keyLength-9:])),
+		CellType:  &typ,
+		Value:     b[12+keyLength : 4+length],
+	}
+	cr.b = b[4+length:]
+	return c, nil
+}
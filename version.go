@@ -0,0 +1,47 @@
+// Copyright (C) 2026  The GoHBase Authors.  All rights reserved.
+// This file is part of GoHBase.
+// Use of this source code is governed by the Apache License 2.0
+// that can be found in the COPYING file.
+
+package gohbase
+
+import (
+	"fmt"
+
+	"github.com/baiweiguo/gohbase/hrpc"
+)
+
+// ServerVersion will return an option that tells the client which version of
+// HBase the cluster runs, e.g. as returned by AdminClient.ServerVersion, to
+// disable the features that version doesn't support: nonces aren't sent with
+// increments and appends, and rpcs explicitly requesting other features fail
+// with an error matching ErrUnsupportedFeature rather than being sent.
+// By default, every feature is assumed to be supported.
+func ServerVersion(version hrpc.Version) Option {
+	return func(c *client) {
+		c.serverVersion = &version
+	}
+}
+
+// checkFeatures returns an error matching ErrUnsupportedFeature if rpc requests
+// a feature the version set with ServerVersion option doesn't support, and
+// disables the unsupported features rpc uses implicitly.
+func (c *client) checkFeatures(rpc hrpc.Call) error {
+	if c.serverVersion == nil {
+		return nil
+	}
+	for _, f := range hrpc.RequestedFeatures(rpc) {
+		if !c.serverVersion.Supports(f) {
+			return fmt.Errorf("%w: %s aren't supported by HBase %s",
+				ErrUnsupportedFeature, f, c.serverVersion)
+		}
+	}
+	if m, ok := rpc.(*hrpc.Mutate); ok && m.Nonce() != 0 &&
+		!c.serverVersion.Supports(hrpc.FeatureNonces) {
+		// don't send nonces the regionserver doesn't know about
+		if err := hrpc.Nonce(0)(m); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,97 @@
+// Copyright (C) 2026  The GoHBase Authors.  All rights reserved.
+// This file is part of GoHBase.
+// Use of this source code is governed by the Apache License 2.0
+// that can be found in the COPYING file.
+
+package gohbase
+
+import (
+	"context"
+	"time"
+
+	"github.com/baiweiguo/gohbase/hrpc"
+	log "github.com/sirupsen/logrus"
+)
+
+const defaultRegionWatchInterval = time.Minute
+
+// RegionWatchInterval will return an option that sets how often
+// WatchTableRegions polls the regions of tables. The default is a minute.
+func RegionWatchInterval(interval time.Duration) Option {
+	return func(c *client) {
+		if interval > 0 {
+			c.regionWatchInterval = interval
+		}
+	}
+}
+
+// WatchTableRegions polls the regions of table in hbase:meta every
+// RegionWatchInterval and sends an event to the returned channel with
+// the regions of the table first, and then whenever they change, e.g. because
+// regions were split or merged. Failures to poll the regions are logged and
+// the next poll is attempted after the interval. The channel is closed once
+// ctx is done or the client is closed, events must be received until then.
+func (c *client) WatchTableRegions(ctx context.Context,
+	table []byte) <-chan hrpc.TableRegionsEvent {
+	events := make(chan hrpc.TableRegionsEvent)
+	go func() {
+		defer close(events)
+		ticker := time.NewTicker(c.regionWatchInterval)
+		defer ticker.Stop()
+		var previous []hrpc.RegionInfo
+		polled := false
+		for {
+			regions, err := c.tableRegions(ctx, table)
+			if err != nil {
+				if ctx.Err() == nil {
+					log.WithFields(log.Fields{
+						"table": string(table),
+						"err":   err,
+					}).Warn("failed to poll regions of table")
+				}
+			} else if event, changed := diffRegions(previous, regions); !polled || changed {
+				select {
+				case events <- event:
+				case <-ctx.Done():
+					return
+				case <-c.done:
+					return
+				}
+				previous, polled = regions, true
+			}
+
+			select {
+			case <-ticker.C:
+			case <-ctx.Done():
+				return
+			case <-c.done:
+				return
+			}
+		}
+	}()
+	return events
+}
+
+// diffRegions returns the event of the regions of a table changing from
+// previous to regions, and whether they changed. Regions are told apart by
+// their names, which change when they're split or merged.
+func diffRegions(previous, regions []hrpc.RegionInfo) (hrpc.TableRegionsEvent, bool) {
+	event := hrpc.TableRegionsEvent{Regions: regions}
+	names := make(map[string]struct{}, len(previous))
+	for _, reg := range previous {
+		names[string(reg.Name())] = struct{}{}
+	}
+	for _, reg := range regions {
+		if _, ok := names[string(reg.Name())]; ok {
+			delete(names, string(reg.Name()))
+		} else {
+			event.Added = append(event.Added, reg)
+		}
+	}
+	for _, reg := range previous {
+		if _, ok := names[string(reg.Name())]; ok {
+			event.Removed = append(event.Removed, reg)
+		}
+	}
+	return event, len(event.Added) != 0 || len(event.Removed) != 0
+}
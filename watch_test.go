@@ -0,0 +1,114 @@
+// Copyright (C) 2026  The GoHBase Authors.  All rights reserved.
+// This file is part of GoHBase.
+// Use of this source code is governed by the Apache License 2.0
+// that can be found in the COPYING file.
+
+package gohbase
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/baiweiguo/gohbase/hrpc"
+	"github.com/baiweiguo/gohbase/region"
+)
+
+// splittingResolver serves a table from a single region until it's split at "m".
+type splittingResolver struct {
+	m     sync.Mutex
+	split bool
+}
+
+func (r *splittingResolver) setSplit() {
+	r.m.Lock()
+	r.split = true
+	r.m.Unlock()
+}
+
+func (r *splittingResolver) ResolveRegion(ctx context.Context,
+	table, key []byte) (hrpc.RegionInfo, string, error) {
+	r.m.Lock()
+	defer r.m.Unlock()
+	switch {
+	case !r.split:
+		return region.NewInfo(0, nil, table, []byte("test,,1"), nil, nil), "regionserver:1", nil
+	case bytes.Compare(key, []byte("m")) < 0:
+		return region.NewInfo(0, nil, table, []byte("test,,2"), nil, []byte("m")),
+			"regionserver:1", nil
+	default:
+		return region.NewInfo(0, nil, table, []byte("test,m,2"), []byte("m"), nil),
+			"regionserver:1", nil
+	}
+}
+
+// namesOf returns the names of regions, nil if there are none.
+func namesOf(regions []hrpc.RegionInfo) []string {
+	var names []string
+	for _, reg := range regions {
+		names = append(names, string(reg.Name()))
+	}
+	return names
+}
+
+func TestWatchTableRegions(t *testing.T) {
+	const interval = 20 * time.Millisecond
+	resolver := &splittingResolver{}
+	c := NewClientWithResolver(resolver, nil, RegionWatchInterval(interval)).(*client)
+	defer c.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	events := c.WatchTableRegions(ctx, []byte("test"))
+	receive := func() hrpc.TableRegionsEvent {
+		select {
+		case event, ok := <-events:
+			if !ok {
+				t.Fatal("unexpected close of events")
+			}
+			return event
+		case <-time.After(5 * time.Second):
+			t.Fatal("timed out waiting for an event")
+		}
+		return hrpc.TableRegionsEvent{}
+	}
+	check := func(name string, expected []string, regions []hrpc.RegionInfo) {
+		t.Helper()
+		if got := namesOf(regions); !reflect.DeepEqual(expected, got) {
+			t.Errorf("expected %s regions %q, got %q", name, expected, got)
+		}
+	}
+
+	// the regions of the table first
+	event := receive()
+	check("current", []string{"test,,1"}, event.Regions)
+	check("added", []string{"test,,1"}, event.Added)
+	check("removed", nil, event.Removed)
+
+	// nothing changes between polls
+	select {
+	case event := <-events:
+		t.Fatalf("unexpected event %v", event)
+	case <-time.After(3 * interval):
+	}
+
+	// the region is split between polls
+	resolver.setSplit()
+	event = receive()
+	check("current", []string{"test,,2", "test,m,2"}, event.Regions)
+	check("added", []string{"test,,2", "test,m,2"}, event.Added)
+	check("removed", []string{"test,,1"}, event.Removed)
+
+	cancel()
+	select {
+	case event, ok := <-events:
+		if ok {
+			t.Fatalf("unexpected event %v", event)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected events to be closed once the context is done")
+	}
+}
@@ -48,6 +48,10 @@ const (
 	// Master is a ResourceName that indicates that the location of the Master
 	// server is what will be fetched
 	Master = ResourceName("/master")
+
+	// ClusterID is a ResourceName that indicates that the ID of the cluster
+	// is what will be fetched
+	ClusterID = ResourceName("/hbaseid")
 )
 
 // Client is an interface of client that retrieves meta infomation from zookeeper
@@ -55,6 +59,12 @@ type Client interface {
 	LocateResource(ResourceName) (string, error)
 }
 
+// ClusterIDReader is implemented by clients that can also read the ID of the
+// cluster from zookeeper, such as the one returned by NewClient.
+type ClusterIDReader interface {
+	ReadClusterID(ResourceName) (string, error)
+}
+
 type client struct {
 	zks            []string
 	sessionTimeout time.Duration
@@ -68,34 +78,55 @@ func NewClient(zkquorum string, st time.Duration) Client {
 	}
 }
 
-// LocateResource returns address of the server for the specified resource.
-func (c *client) LocateResource(resource ResourceName) (string, error) {
+// read returns the protobuf stored in the znode of the specified resource.
+func (c *client) read(resource ResourceName) ([]byte, error) {
 	conn, _, err := zk.Connect(c.zks, c.sessionTimeout)
 	if err != nil {
-		return "", fmt.Errorf("error connecting to ZooKeeper at %v: %s", c.zks, err)
+		return nil, fmt.Errorf("error connecting to ZooKeeper at %v: %s", c.zks, err)
 	}
 	defer conn.Close()
 
 	buf, _, err := conn.Get(string(resource))
 	if err != nil {
-		return "", fmt.Errorf("failed to read the %s znode: %s", resource, err)
+		return nil, fmt.Errorf("failed to read the %s znode: %s", resource, err)
 	}
 	if len(buf) == 0 {
 		log.Fatalf("%s was empty!", resource)
 	} else if buf[0] != 0xFF {
-		return "", fmt.Errorf("the first byte of %s was 0x%x, not 0xFF", resource, buf[0])
+		return nil, fmt.Errorf("the first byte of %s was 0x%x, not 0xFF", resource, buf[0])
 	}
 	metadataLen := binary.BigEndian.Uint32(buf[1:])
 	if metadataLen < 1 || metadataLen > 65000 {
-		return "", fmt.Errorf("invalid metadata length for %s: %d", resource, metadataLen)
+		return nil, fmt.Errorf("invalid metadata length for %s: %d", resource, metadataLen)
 	}
 	buf = buf[1+4+metadataLen:]
 	magic := binary.BigEndian.Uint32(buf)
 	const pbufMagic = 1346524486 // 4 bytes: "PBUF"
 	if magic != pbufMagic {
-		return "", fmt.Errorf("invalid magic number for %s: %d", resource, magic)
+		return nil, fmt.Errorf("invalid magic number for %s: %d", resource, magic)
+	}
+	return buf[4:], nil
+}
+
+// ReadClusterID returns the ID of the cluster stored in the specified resource.
+func (c *client) ReadClusterID(resource ResourceName) (string, error) {
+	buf, err := c.read(resource)
+	if err != nil {
+		return "", err
+	}
+	id := &pb.ClusterId{}
+	if err := proto.Unmarshal(buf, id); err != nil {
+		return "", fmt.Errorf("failed to deserialize the ClusterId entry from ZK: %s", err)
+	}
+	return id.GetClusterId(), nil
+}
+
+// LocateResource returns address of the server for the specified resource.
+func (c *client) LocateResource(resource ResourceName) (string, error) {
+	buf, err := c.read(resource)
+	if err != nil {
+		return "", err
 	}
-	buf = buf[4:]
 	var server *pb.ServerName
 	if resource == Meta {
 		meta := &pb.MetaRegionServer{}